@@ -3,3 +3,12 @@ package example.authz
 default allowed = false
 
 allowed if input.user.preferred_username == "user"
+
+# Batch decisions, one per input.checks item in the same order
+batch := [allowed | some _ in input.checks]
+
+# Row level authorization used with compile API (partial evaluation).
+# input.resource is unknown and contains row columns, residual conditions are transformed into database filters.
+rows_allowed if input.user.preferred_username == "user"
+
+rows_allowed if input.resource.done == false
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+)
+
+var emailOutboxDaemon = &daemonDefinition{
+	Run: emailOutboxDaemonRun,
+}
+
+func emailOutboxDaemonRun(ctx context.Context, targets []string, sv *services) {
+	// Emails are delivered only by server instances
+	if !lo.Contains(targets, "all") && !lo.Contains(targets, "server") {
+		return
+	}
+
+	sv.logger.Info("Starting email outbox delivery daemon")
+	// Run delivery until context is cancelled
+	err := sv.mailSvc.RunOutboxDelivery(ctx)
+	// Check error
+	if err != nil {
+		sv.logger.Fatal(err)
+	}
+
+	sv.logger.Info("Email outbox delivery daemon stopped")
+}
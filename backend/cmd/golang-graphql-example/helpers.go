@@ -8,6 +8,8 @@ import (
 
 func GenerateInternalServer(sv *services) (*server.InternalServer, error) {
 	intSvr := server.NewInternalServer(sv.logger, sv.cfgManager, sv.metricsSvc, sv.signalHandlerSvc)
+	// Set email service for email templates preview
+	intSvr.SetEmailService(sv.mailSvc)
 
 	// Add checker for database
 	intSvr.AddChecker(&server.CheckerInput{
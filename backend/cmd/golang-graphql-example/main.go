@@ -20,8 +20,10 @@ import (
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
 	amqpbusmessage "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/messagebus/amqp"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/metrics"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/ratelimit"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/signalhandler"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/tracing"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/trusteddocuments"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/version"
 )
 
@@ -40,6 +42,8 @@ type services struct {
 	amqpSvc           amqpbusmessage.Service
 	authorizationSvc  authorization.Service
 	authenticationSvc authentication.Service
+	rateLimitSvc      ratelimit.Service
+	trustedDocsSvc    trusteddocuments.Service
 	// Extra
 	// Business
 	busServices *business.Services
@@ -47,13 +51,16 @@ type services struct {
 
 var targetDefinitionsMap = map[string]*targetDefinition{
 	// Basics
-	"migrate-db": migrateDBTarget,
-	"server":     serverTarget,
+	"migrate-db":               migrateDBTarget,
+	"server":                   serverTarget,
+	"import-trusted-documents": importTrustedDocumentsTarget,
 	// Extra
 }
 
 // Those definitions are saving daemon definitions that will be launched with every target.
-var daemonDefinitions = []*daemonDefinition{}
+var daemonDefinitions = []*daemonDefinition{
+	emailOutboxDaemon,
+}
 
 // WaitGroup is used to wait for the program to finish goroutines.
 var (
@@ -15,8 +15,10 @@ import (
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
 	amqpbusmessage "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/messagebus/amqp"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/metrics"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/ratelimit"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/signalhandler"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/tracing"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/trusteddocuments"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/version"
 )
 
@@ -24,9 +26,16 @@ func setupExtraServices(_ []string, _ *services) {}
 
 func setupBusinessServices(_ []string, sv *services) {
 	// Create business services
-	busServices := business.NewServices(sv.logger, sv.db, sv.authorizationSvc)
+	busServices := business.NewServices(sv.logger, sv.cfgManager, sv.db, sv.authorizationSvc)
 	// Save
 	sv.busServices = busServices
+
+	// Allow personal access tokens in authentication
+	sv.authenticationSvc.SetAPITokenService(busServices.APITokenSvc)
+	// Allow server-side sessions in authentication
+	sv.authenticationSvc.SetSessionService(busServices.SessionSvc)
+	// Allow impersonation in authentication
+	sv.authenticationSvc.SetImpersonationService(busServices.ImpersonationSvc)
 }
 
 func setupBasicsServices(_ []string, sv *services) {
@@ -106,20 +115,11 @@ func setupBasicsServices(_ []string, sv *services) {
 	// Save
 	sv.db = db
 
-	// Create new mail service
-	mailSvc := email.NewService(cfgManager, logger)
-	// Try to connect
-	err = mailSvc.InitializeAndReload()
-	// Check error
-	if err != nil {
-		logger.Fatal(err)
-	}
-	// Add configuration reload hook
-	cfgManager.AddOnChangeHook(&config.HookDefinition{
-		Hook: mailSvc.InitializeAndReload,
-	})
-	// Save
-	sv.mailSvc = mailSvc
+	// Create rate limit service
+	sv.rateLimitSvc = ratelimit.NewService(db)
+
+	// Create trusted documents service
+	sv.trustedDocsSvc = trusteddocuments.NewService(db)
 
 	// Create lock distributor service
 	ld := lockdistributor.NewService(cfgManager, db)
@@ -138,6 +138,21 @@ func setupBasicsServices(_ []string, sv *services) {
 	// Save
 	sv.ldSvc = ld
 
+	// Create new mail service
+	mailSvc := email.NewService(cfgManager, logger, db, ld, metricsSvc)
+	// Try to connect
+	err = mailSvc.InitializeAndReload()
+	// Check error
+	if err != nil {
+		logger.Fatal(err)
+	}
+	// Add configuration reload hook
+	cfgManager.AddOnChangeHook(&config.HookDefinition{
+		Hook: mailSvc.InitializeAndReload,
+	})
+	// Save
+	sv.mailSvc = mailSvc
+
 	// Get config
 	cfg := cfgManager.GetConfig()
 	// Initialize
@@ -191,6 +206,16 @@ func setupBasicsServices(_ []string, sv *services) {
 
 	// Create authentication service
 	authoSvc := authorization.NewService(cfgManager)
+	// Initialize built-in rbac engine
+	err = authoSvc.InitializeAndReload()
+	// Check error
+	if err != nil {
+		logger.Fatal(err)
+	}
+	// Add configuration reload hook
+	cfgManager.AddOnChangeHook(&config.HookDefinition{
+		Hook: authoSvc.InitializeAndReload,
+	})
 	// Save
 	sv.authorizationSvc = authoSvc
 
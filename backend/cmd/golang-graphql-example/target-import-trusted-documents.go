@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+)
+
+var importTrustedDocumentsTarget = &targetDefinition{
+	Run:         importTrustedDocumentsTargetRun,
+	Primary:     true,
+	InAllTarget: false,
+}
+
+func importTrustedDocumentsTargetRun(_ []string, sv *services) {
+	// Add trace
+	ctx, trace := sv.tracingSvc.StartTrace(context.TODO(), "import-trusted-documents")
+	// Defer
+	defer trace.Finish()
+
+	// Get configuration
+	cfg := sv.cfgManager.GetConfig().Server.TrustedDocuments
+	// Check if trusted documents are configured
+	if cfg == nil || cfg.ManifestPath == "" {
+		err := errors.New("trusted documents manifest path must be configured to import documents")
+		// trace
+		trace.AddAndMarkError(err)
+
+		sv.logger.Fatal(err)
+	}
+
+	sv.logger.Infof("Importing trusted documents from %s", cfg.ManifestPath)
+	// Import manifest
+	err := sv.trustedDocsSvc.ImportManifestFile(ctx, cfg.ManifestPath)
+	// Check error
+	if err != nil {
+		// trace
+		trace.AddAndMarkError(err)
+
+		sv.logger.Fatal(err)
+	}
+}
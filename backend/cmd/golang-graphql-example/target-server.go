@@ -2,6 +2,7 @@ package main
 
 import (
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/grpc"
 )
 
 var serverTarget = &targetDefinition{
@@ -11,6 +12,27 @@ var serverTarget = &targetDefinition{
 }
 
 func serverTargetRun(_ []string, sv *services) {
+	// Generate internal server
+	// Internal server is started first as it may serve the fake oidc provider used during server generation
+	intSvr, err := GenerateInternalServer(sv)
+	if err != nil {
+		sv.logger.Fatal(err)
+	}
+	// Bind internal server
+	err = intSvr.Bind()
+	if err != nil {
+		sv.logger.Fatal(err)
+	}
+
+	// Start internal server in routine
+	go func() {
+		err2 := intSvr.Listen()
+		// Check error
+		if err2 != nil {
+			sv.logger.Fatal(err2)
+		}
+	}()
+
 	// Create servers
 	svr := server.NewServer(
 		sv.logger,
@@ -21,30 +43,46 @@ func serverTargetRun(_ []string, sv *services) {
 		sv.authenticationSvc,
 		sv.authorizationSvc,
 		sv.signalHandlerSvc,
+		sv.rateLimitSvc,
+		sv.trustedDocsSvc,
 	)
 
 	// Generate server
-	err := svr.GenerateServer()
+	err = svr.GenerateServer()
 	if err != nil {
 		sv.logger.Fatal(err)
 	}
-	// Generate internal server
-	intSvr, err := GenerateInternalServer(sv)
+
+	// Create gRPC server
+	// It is generated after server as authentication needs OIDC endpoints to be initialized
+	grpcSvr := grpc.NewServer(
+		sv.logger,
+		sv.cfgManager,
+		sv.metricsSvc,
+		sv.tracingSvc,
+		sv.busServices,
+		sv.authenticationSvc,
+		sv.signalHandlerSvc,
+		sv.rateLimitSvc,
+	)
+
+	// Generate gRPC server
+	err = grpcSvr.GenerateServer()
 	if err != nil {
 		sv.logger.Fatal(err)
 	}
 
-	// Start server in routine
+	// Start gRPC server in routine
 	go func() {
-		err2 := svr.Listen()
+		err2 := grpcSvr.Listen()
 		// Check error
 		if err2 != nil {
 			sv.logger.Fatal(err2)
 		}
 	}()
 
-	// Start internal server
-	err = intSvr.Listen()
+	// Start server
+	err = svr.Listen()
 	// Check error
 	if err != nil {
 		sv.logger.Fatal(err)
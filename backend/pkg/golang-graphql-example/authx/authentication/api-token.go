@@ -0,0 +1,84 @@
+package authentication
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+const (
+	// APITokenHeaderName is the dedicated header to send personal access tokens.
+	APITokenHeaderName = "X-API-Key"
+	bearerPrefix       = "Bearer "
+)
+
+func (s *service) SetAPITokenService(apiTokenSvc APITokenService) {
+	s.apiTokenSvc = apiTokenSvc
+}
+
+// Get personal access token from dedicated header or from Authorization header with token prefix.
+func getAPIToken(r *http.Request) string {
+	// Check dedicated header
+	if v := r.Header.Get(APITokenHeaderName); v != "" {
+		return v
+	}
+
+	// Get Authorization header
+	authHd := r.Header.Get("Authorization")
+	// Check if it is a bearer with a personal access token
+	if strings.HasPrefix(authHd, bearerPrefix+models.APITokenPrefix) {
+		return strings.TrimPrefix(authHd, bearerPrefix)
+	}
+
+	return ""
+}
+
+func (s *service) manageAPITokenAuthentication(c *gin.Context, token string) {
+	// Get logger
+	logger := log.GetLoggerFromGin(c)
+
+	// Check if service is available
+	if s.apiTokenSvc == nil {
+		err := cerrors.NewUnauthorizedError("api token authentication not available")
+
+		logger.Error(err)
+		utils.AnswerWithError(c, err)
+
+		return
+	}
+
+	// Authenticate
+	ouser, err := s.apiTokenSvc.AuthenticateAPIToken(c.Request.Context(), token)
+	// Check error
+	if err != nil {
+		logger.Error(err)
+		utils.AnswerWithError(c, err)
+
+		return
+	}
+
+	// Log before impersonation as impersonated user isn't linked to api token
+	logger.Infof("API token %s authenticated for user: %s", ouser.APIToken.ID, ouser.GetIdentifier())
+
+	// Manage impersonation
+	ouser, ok := s.manageImpersonation(c, ouser)
+	// Check if request have been answered
+	if !ok {
+		return
+	}
+
+	// Create new request with new context
+	c.Request = c.Request.WithContext(
+		SetAuthenticatedUserToContext(c.Request.Context(), ouser),
+	)
+	// Add it to gin context
+	SetAuthenticatedUserToGin(c, ouser)
+
+	c.Next()
+}
@@ -0,0 +1,56 @@
+//go:build unit
+
+package authentication
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getAPIToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    string
+	}{
+		{
+			name: "no header",
+			want: "",
+		},
+		{
+			name:    "dedicated header",
+			headers: map[string]string{APITokenHeaderName: "ggepat_TOKEN"},
+			want:    "ggepat_TOKEN",
+		},
+		{
+			name:    "bearer with api token prefix",
+			headers: map[string]string{"Authorization": "Bearer ggepat_TOKEN"},
+			want:    "ggepat_TOKEN",
+		},
+		{
+			name:    "bearer with jwt token",
+			headers: map[string]string{"Authorization": "Bearer TOKEN"},
+			want:    "",
+		},
+		{
+			name: "dedicated header is preferred",
+			headers: map[string]string{
+				APITokenHeaderName: "ggepat_TOKEN1",
+				"Authorization":    "Bearer ggepat_TOKEN2",
+			},
+			want: "ggepat_TOKEN1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &http.Request{Header: http.Header{}}
+			for k, v := range tt.headers {
+				r.Header.Set(k, v)
+			}
+
+			assert.Equal(t, tt.want, getAPIToken(r))
+		})
+	}
+}
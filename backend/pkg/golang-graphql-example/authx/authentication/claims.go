@@ -0,0 +1,214 @@
+package authentication
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+const (
+	givenNameClaim      = "given_name"
+	familyNameClaim     = "family_name"
+	emailVerifiedClaim  = "email_verified"
+	claimPathRootPrefix = "$"
+	claimPathSeparator  = '.'
+	claimPathOpenIndex  = '['
+	claimPathCloseIndex = ']'
+)
+
+// Default claim mapping used when none is configured.
+var defaultClaimMapping = &config.OIDCClaimMappingConfig{
+	Username: config.DefaultOIDCClaimMappingUsername,
+	Email:    config.DefaultOIDCClaimMappingEmail,
+	Name:     config.DefaultOIDCClaimMappingName,
+	ClientID: config.DefaultOIDCClaimMappingClientID,
+}
+
+// Build user from claims with claim mapping.
+func mapClaims(claims map[string]any, mapping *config.OIDCClaimMappingConfig) *models.OIDCUser {
+	// Check if mapping exists
+	if mapping == nil {
+		mapping = defaultClaimMapping
+	}
+
+	ouser := &models.OIDCUser{}
+
+	// Standard claims
+	ouser.GivenName, _ = getStringClaim(claims, givenNameClaim)
+	ouser.FamilyName, _ = getStringClaim(claims, familyNameClaim)
+	ouser.EmailVerified, _ = claims[emailVerifiedClaim].(bool)
+
+	// Mapped claims
+	ouser.PreferredUsername, _ = getStringClaim(claims, mapping.Username)
+	ouser.Email, _ = getStringClaim(claims, mapping.Email)
+	ouser.Name, _ = getStringClaim(claims, mapping.Name)
+	ouser.ClientID, _ = getStringClaim(claims, mapping.ClientID)
+	ouser.Tenant, _ = getStringClaim(claims, mapping.Tenant)
+	ouser.Roles = getStringListClaim(claims, mapping.Roles)
+	ouser.Groups = getStringListClaim(claims, mapping.Groups)
+
+	// Extra claims
+	for name, path := range mapping.Extra {
+		// Resolve
+		v, ok := resolveClaimPath(claims, path)
+		// Check if it exists
+		if !ok {
+			continue
+		}
+		// Initialize map if needed
+		if ouser.ExtraClaims == nil {
+			ouser.ExtraClaims = map[string]any{}
+		}
+		// Save
+		ouser.ExtraClaims[name] = v
+	}
+
+	return ouser
+}
+
+// Resolve a JSONPath-like expression in claims.
+// Supported syntaxes are "claim", "$.a.b", "a.b[0]" and "a['key.with.dots']".
+// An exact top level claim name is always tried first to support namespaced claims like "https://example.com/roles".
+func resolveClaimPath(claims map[string]any, path string) (any, bool) {
+	// Check if path is empty
+	if path == "" {
+		return nil, false
+	}
+
+	// Try exact top level claim first
+	if v, ok := claims[path]; ok {
+		return v, true
+	}
+
+	// Parse path
+	segments, err := parseClaimPath(path)
+	// Check error
+	if err != nil {
+		return nil, false
+	}
+
+	// Initialize current value
+	var cur any = claims
+
+	// Loop over segments
+	for _, seg := range segments {
+		switch v := cur.(type) {
+		case map[string]any:
+			res, ok := v[seg]
+			// Check if it exists
+			if !ok {
+				return nil, false
+			}
+
+			cur = res
+		case []any:
+			idx, err := strconv.Atoi(seg)
+			// Check if index is valid
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil, false
+			}
+
+			cur = v[idx]
+		default:
+			return nil, false
+		}
+	}
+
+	return cur, true
+}
+
+func parseClaimPath(path string) ([]string, error) {
+	// Remove root prefix
+	path = strings.TrimPrefix(path, claimPathRootPrefix)
+
+	// Initialize
+	res := []string{}
+	cur := strings.Builder{}
+
+	// Flush current segment
+	flush := func() {
+		if cur.Len() != 0 {
+			res = append(res, cur.String())
+			cur.Reset()
+		}
+	}
+
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case claimPathSeparator:
+			flush()
+		case claimPathOpenIndex:
+			flush()
+			// Find closing bracket
+			end := strings.IndexByte(path[i:], claimPathCloseIndex)
+			// Check if it exists
+			if end == -1 {
+				return nil, errors.Errorf("unclosed bracket in claim path %s", path)
+			}
+			// Get content and remove quotes
+			content := strings.Trim(path[i+1:i+end], `'"`)
+			res = append(res, content)
+			// Move cursor
+			i += end
+		default:
+			cur.WriteByte(path[i])
+		}
+	}
+
+	flush()
+
+	return res, nil
+}
+
+// Get a string claim from path.
+func getStringClaim(claims map[string]any, path string) (string, bool) {
+	// Resolve
+	v, ok := resolveClaimPath(claims, path)
+	// Check if it exists
+	if !ok || v == nil {
+		return "", false
+	}
+
+	switch t := v.(type) {
+	case string:
+		return t, true
+	case float64, bool:
+		return fmt.Sprint(t), true
+	default:
+		return "", false
+	}
+}
+
+// Get a string list claim from path.
+// A single string value will be considered as a list with one element.
+func getStringListClaim(claims map[string]any, path string) []string {
+	// Resolve
+	v, ok := resolveClaimPath(claims, path)
+	// Check if it exists
+	if !ok {
+		return nil
+	}
+
+	switch t := v.(type) {
+	case string:
+		return []string{t}
+	case []any:
+		res := make([]string, 0, len(t))
+		// Loop over values
+		for _, it := range t {
+			// Ignore non string values
+			if s, ok := it.(string); ok {
+				res = append(res, s)
+			}
+		}
+
+		return res
+	default:
+		return nil
+	}
+}
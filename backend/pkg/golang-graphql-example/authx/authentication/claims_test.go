@@ -0,0 +1,127 @@
+//go:build unit
+
+package authentication
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+const testClaims = `{
+  "sub": "subject",
+  "preferred_username": "user",
+  "email": "user@example.com",
+  "email_verified": true,
+  "given_name": "Sample",
+  "family_name": "User",
+  "name": "Sample User",
+  "azp": "client",
+  "tenant_id": 42,
+  "https://example.com/roles": ["namespaced"],
+  "realm_access": {"roles": ["admin", "user"]},
+  "resource_access": {"my.app": {"roles": ["editor"]}},
+  "groups": "single-group",
+  "orgs": [{"name": "org1"}, {"name": "org2"}]
+}`
+
+func parseTestClaims(t *testing.T) map[string]any {
+	t.Helper()
+
+	res := map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(testClaims), &res))
+
+	return res
+}
+
+func Test_resolveClaimPath(t *testing.T) {
+	claims := parseTestClaims(t)
+
+	tests := []struct {
+		name   string
+		path   string
+		want   any
+		wantOk bool
+	}{
+		{name: "empty path", path: ""},
+		{name: "top level", path: "email", want: "user@example.com", wantOk: true},
+		{name: "namespaced top level", path: "https://example.com/roles", want: []any{"namespaced"}, wantOk: true},
+		{name: "root prefix", path: "$.realm_access.roles", want: []any{"admin", "user"}, wantOk: true},
+		{name: "without root prefix", path: "realm_access.roles", want: []any{"admin", "user"}, wantOk: true},
+		{name: "quoted key", path: "$.resource_access['my.app'].roles", want: []any{"editor"}, wantOk: true},
+		{name: "index", path: "$.orgs[1].name", want: "org2", wantOk: true},
+		{name: "index out of range", path: "$.orgs[2].name"},
+		{name: "not found", path: "$.realm_access.groups"},
+		{name: "not an object", path: "$.email.value"},
+		{name: "unclosed bracket", path: "$.orgs[1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := resolveClaimPath(claims, tt.path)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_mapClaims(t *testing.T) {
+	claims := parseTestClaims(t)
+
+	tests := []struct {
+		name    string
+		mapping *config.OIDCClaimMappingConfig
+		want    *models.OIDCUser
+	}{
+		{
+			name: "default mapping",
+			want: &models.OIDCUser{
+				PreferredUsername: "user",
+				Email:             "user@example.com",
+				EmailVerified:     true,
+				GivenName:         "Sample",
+				FamilyName:        "User",
+				Name:              "Sample User",
+				ClientID:          "client",
+			},
+		},
+		{
+			name: "full mapping",
+			mapping: &config.OIDCClaimMappingConfig{
+				Username: "email",
+				Email:    "email",
+				Name:     "$.orgs[0].name",
+				ClientID: "azp",
+				Roles:    "$.realm_access.roles",
+				Groups:   "groups",
+				Tenant:   "tenant_id",
+				Extra: map[string]string{
+					"appRoles": "$.resource_access['my.app'].roles",
+					"missing":  "$.missing",
+				},
+			},
+			want: &models.OIDCUser{
+				PreferredUsername: "user@example.com",
+				Email:             "user@example.com",
+				EmailVerified:     true,
+				GivenName:         "Sample",
+				FamilyName:        "User",
+				Name:              "org1",
+				ClientID:          "client",
+				Tenant:            "42",
+				Roles:             []string{"admin", "user"},
+				Groups:            []string{"single-group"},
+				ExtraClaims:       map[string]any{"appRoles": []any{"editor"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mapClaims(claims, tt.mapping))
+		})
+	}
+}
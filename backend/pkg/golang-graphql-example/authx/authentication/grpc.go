@@ -0,0 +1,198 @@
+package authentication
+
+import (
+	"context"
+	"strings"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+// Method used in impersonation audit log for gRPC calls.
+const grpcImpersonatedRequestMethod = "GRPC"
+
+// gRPC metadata keys are lower case headers.
+var (
+	grpcAuthorizationMetadataKey = "authorization"
+	grpcAPITokenMetadataKey      = strings.ToLower(APITokenHeaderName)
+	grpcImpersonationMetadataKey = strings.ToLower(ImpersonationHeaderName)
+)
+
+func (s *service) GRPCUnaryInterceptor(ignoredMethodList []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		// Check if method is ignored
+		if lo.Contains(ignoredMethodList, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		// Authenticate
+		ctx2, err := s.authenticateGRPC(ctx, info.FullMethod)
+		// Check error
+		if err != nil {
+			// Get logger
+			logger := log.GetLoggerFromContext(ctx)
+			if logger != nil {
+				logger.Error(err)
+			}
+
+			return nil, utils.ToGRPCError(err)
+		}
+
+		return handler(ctx2, req)
+	}
+}
+
+func (s *service) GRPCStreamInterceptor(ignoredMethodList []string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		// Check if method is ignored
+		if lo.Contains(ignoredMethodList, info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		// Authenticate
+		ctx, err := s.authenticateGRPC(ss.Context(), info.FullMethod)
+		// Check error
+		if err != nil {
+			// Get logger
+			logger := log.GetLoggerFromContext(ss.Context())
+			if logger != nil {
+				logger.Error(err)
+			}
+
+			return utils.ToGRPCError(err)
+		}
+
+		return handler(srv, utils.WrapServerStreamWithContext(ss, ctx))
+	}
+}
+
+// authenticateGRPC will authenticate gRPC call with personal access token or OIDC bearer token from metadata
+// and return a context containing authenticated user.
+func (s *service) authenticateGRPC(ctx context.Context, fullMethod string) (context.Context, error) {
+	// Get logger
+	logger := log.GetLoggerFromContext(ctx)
+	// Get configuration
+	cfg := s.cfgManager.GetConfig()
+	// Get metadata
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var (
+		ouser *models.OIDCUser
+		err   error
+	)
+
+	// Check if a personal access token is provided
+	if apiToken := getGRPCAPIToken(md); apiToken != "" {
+		// Check if service is available
+		if s.apiTokenSvc == nil {
+			return nil, cerrors.NewUnauthorizedError("api token authentication not available")
+		}
+
+		// Authenticate
+		ouser, err = s.apiTokenSvc.AuthenticateAPIToken(ctx, apiToken)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Get bearer token
+		token, err2 := getGRPCBearerToken(md)
+		// Check error
+		if err2 != nil {
+			return nil, err2
+		}
+
+		// Opaque session tokens are linked to the login cookie and aren't accepted on gRPC calls
+		if isSessionEnabled(cfg) && isSessionToken(token) {
+			return nil, cerrors.NewUnauthorizedError("session tokens aren't accepted on gRPC calls")
+		}
+
+		// Check if verifier is available
+		// It is initialized with OIDC endpoints
+		if s.verifier == nil {
+			return nil, cerrors.NewUnauthorizedError("oidc authentication not initialized")
+		}
+
+		// Verify token and build principal
+		ouser, err = s.verifyToken(ctx, cfg.OIDCAuthentication, token)
+		// Check error
+		if err != nil {
+			return nil, cerrors.NewUnauthorizedErrorWithError(err)
+		}
+	}
+
+	// Check if impersonation is requested
+	if target := getFirstMetadataValue(md, grpcImpersonationMetadataKey); target != "" {
+		ouser, logger, err = s.impersonate(ctx, logger, ouser, target, &models.ImpersonatedRequest{
+			Method: grpcImpersonatedRequestMethod,
+			URI:    fullMethod,
+		})
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		ctx = log.SetLoggerToContext(ctx, logger)
+	}
+
+	// Check if it is a service principal
+	if ouser.IsServicePrincipal() {
+		logger.Infof("OIDC service principal authenticated: %s (issuer: %s)", ouser.GetIdentifier(), ouser.Issuer)
+	} else {
+		logger.Infof("OIDC User authenticated: %s", ouser.GetIdentifier())
+	}
+
+	return SetAuthenticatedUserToContext(ctx, ouser), nil
+}
+
+// Get personal access token from dedicated metadata or from authorization metadata with token prefix.
+func getGRPCAPIToken(md metadata.MD) string {
+	// Check dedicated metadata
+	if v := getFirstMetadataValue(md, grpcAPITokenMetadataKey); v != "" {
+		return v
+	}
+
+	// Get authorization metadata
+	authHd := getFirstMetadataValue(md, grpcAuthorizationMetadataKey)
+	// Check if it is a bearer with a personal access token
+	if strings.HasPrefix(authHd, bearerPrefix+models.APITokenPrefix) {
+		return strings.TrimPrefix(authHd, bearerPrefix)
+	}
+
+	return ""
+}
+
+// Get token from authorization metadata with "Bearer TOKEN" format.
+func getGRPCBearerToken(md metadata.MD) (string, error) {
+	// Get authorization metadata
+	authHd := getFirstMetadataValue(md, grpcAuthorizationMetadataKey)
+	// Check if it is set
+	if authHd == "" {
+		return "", cerrors.NewUnauthorizedError("no authorization metadata detected")
+	}
+
+	// Split header to get token => Format "Bearer TOKEN"
+	sp := strings.Split(authHd, " ")
+	if len(sp) != 2 || sp[0] != "Bearer" || sp[1] == "" {
+		return "", cerrors.NewUnauthorizedError("authorization metadata doesn't follow bearer format")
+	}
+
+	return sp[1], nil
+}
+
+func getFirstMetadataValue(md metadata.MD, key string) string {
+	// Get values
+	values := md.Get(key)
+	// Check if values exist
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,275 @@
+//go:build unit
+
+package authentication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+type fakeAPITokenService struct {
+	err   error
+	user  *models.OIDCUser
+	token string
+}
+
+func (f *fakeAPITokenService) AuthenticateAPIToken(_ context.Context, token string) (*models.OIDCUser, error) {
+	f.token = token
+	// Check if error must be returned
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return f.user, nil
+}
+
+func Test_service_GRPCUnaryInterceptor(t *testing.T) {
+	const method = "/todos.v1.TodoService/ListTodos"
+
+	tokenUser := &models.OIDCUser{
+		PrincipalType:     models.PrincipalTypeUser,
+		PreferredUsername: "admin",
+		APIToken:          &models.APITokenInfo{ID: "token-id"},
+	}
+
+	tests := []struct {
+		name             string
+		fullMethod       string
+		md               metadata.MD
+		session          bool
+		impSvc           *fakeImpersonationService
+		sessionSvc       *fakeSessionService
+		apiTokenSvc      *fakeAPITokenService
+		expectedCode     codes.Code
+		expectedUser     string
+		expectedRecorded *models.ImpersonatedRequest
+	}{
+		{
+			name:         "ignored method",
+			fullMethod:   "/grpc.health.v1.Health/Check",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "no metadata",
+			fullMethod:   method,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "authorization without bearer format",
+			fullMethod:   method,
+			md:           metadata.Pairs("authorization", "Basic fake"),
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "session token",
+			fullMethod:   method,
+			md:           metadata.Pairs("authorization", "Bearer "+models.SessionTokenPrefix+"fake"),
+			session:      true,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "oidc not initialized",
+			fullMethod:   method,
+			md:           metadata.Pairs("authorization", "Bearer fake"),
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "api token in dedicated metadata",
+			fullMethod:   method,
+			md:           metadata.Pairs("x-api-key", "ggepat_fake"),
+			apiTokenSvc:  &fakeAPITokenService{user: tokenUser},
+			expectedCode: codes.OK,
+			expectedUser: "admin",
+		},
+		{
+			name:         "api token rejected",
+			fullMethod:   method,
+			md:           metadata.Pairs("authorization", "Bearer ggepat_fake"),
+			apiTokenSvc:  &fakeAPITokenService{err: cerrors.NewUnauthorizedError("invalid token")},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:       "api token with impersonation",
+			fullMethod: method,
+			md:         metadata.Pairs("authorization", "Bearer ggepat_fake", "x-impersonate-user", "user"),
+			session:    true,
+			impSvc:     &fakeImpersonationService{},
+			sessionSvc: &fakeSessionService{
+				session: newTargetSession(t, map[string]any{"preferred_username": "user", "roles": []string{"reader"}}),
+			},
+			apiTokenSvc:      &fakeAPITokenService{user: tokenUser},
+			expectedCode:     codes.OK,
+			expectedUser:     "user",
+			expectedRecorded: &models.ImpersonatedRequest{Method: "GRPC", URI: method},
+		},
+		{
+			name:         "impersonation not allowed",
+			fullMethod:   method,
+			md:           metadata.Pairs("x-api-key", "ggepat_fake", "x-impersonate-user", "user"),
+			impSvc:       &fakeImpersonationService{err: cerrors.NewForbiddenError("forbidden")},
+			apiTokenSvc:  &fakeAPITokenService{user: tokenUser},
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfg := &config.Config{OIDCAuthentication: &config.OIDCAuthConfig{}}
+			if tt.session {
+				cfg.OIDCAuthentication.Session = &config.OIDCSessionConfig{}
+			}
+
+			cfgManager := cmocks.NewMockManager(ctrl)
+			cfgManager.EXPECT().GetConfig().AnyTimes().Return(cfg)
+
+			s := &service{cfgManager: cfgManager}
+			// Avoid typed nil interfaces
+			if tt.apiTokenSvc != nil {
+				s.SetAPITokenService(tt.apiTokenSvc)
+			}
+
+			if tt.impSvc != nil {
+				s.SetImpersonationService(tt.impSvc)
+			}
+
+			if tt.sessionSvc != nil {
+				s.SetSessionService(tt.sessionSvc)
+			}
+
+			ctx := log.SetLoggerToContext(context.Background(), log.NewLogger())
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var user *models.OIDCUser
+
+			_, err := s.GRPCUnaryInterceptor([]string{"/grpc.health.v1.Health/Check"})(
+				ctx,
+				nil,
+				&grpc.UnaryServerInfo{FullMethod: tt.fullMethod},
+				func(ctx context.Context, _ any) (any, error) {
+					user = GetAuthenticatedUserFromContext(ctx)
+
+					return nil, nil
+				},
+			)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+
+			if tt.expectedUser == "" {
+				assert.Nil(t, user)
+			} else {
+				assert.Equal(t, tt.expectedUser, user.GetIdentifier())
+			}
+
+			if tt.apiTokenSvc != nil {
+				assert.Equal(t, "ggepat_fake", tt.apiTokenSvc.token)
+			}
+
+			if tt.expectedRecorded != nil {
+				assert.Equal(t, tt.expectedRecorded, tt.impSvc.recorded)
+			}
+		})
+	}
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context //nolint:containedctx // Fake stream context
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+func Test_service_GRPCStreamInterceptor(t *testing.T) {
+	tokenUser := &models.OIDCUser{
+		PrincipalType:     models.PrincipalTypeUser,
+		PreferredUsername: "admin",
+		APIToken:          &models.APITokenInfo{ID: "token-id"},
+	}
+
+	tests := []struct {
+		name         string
+		fullMethod   string
+		md           metadata.MD
+		expectedCode codes.Code
+		expectedUser string
+	}{
+		{
+			name:         "ignored method",
+			fullMethod:   "/grpc.health.v1.Health/Check",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "health watch without metadata",
+			fullMethod:   "/grpc.health.v1.Health/Watch",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "reflection without metadata",
+			fullMethod:   "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "reflection with api token",
+			fullMethod:   "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+			md:           metadata.Pairs("x-api-key", "ggepat_fake"),
+			expectedCode: codes.OK,
+			expectedUser: "admin",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfgManager := cmocks.NewMockManager(ctrl)
+			cfgManager.EXPECT().GetConfig().AnyTimes().Return(&config.Config{OIDCAuthentication: &config.OIDCAuthConfig{}})
+
+			s := &service{cfgManager: cfgManager}
+			s.SetAPITokenService(&fakeAPITokenService{user: tokenUser})
+
+			ctx := log.SetLoggerToContext(context.Background(), log.NewLogger())
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var user *models.OIDCUser
+
+			err := s.GRPCStreamInterceptor([]string{"/grpc.health.v1.Health/Check"})(
+				nil,
+				&fakeServerStream{ctx: ctx},
+				&grpc.StreamServerInfo{FullMethod: tt.fullMethod},
+				func(_ any, ss grpc.ServerStream) error {
+					user = GetAuthenticatedUserFromContext(ss.Context())
+
+					return nil
+				},
+			)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+
+			if tt.expectedUser == "" {
+				assert.Nil(t, user)
+			} else {
+				assert.Equal(t, tt.expectedUser, user.GetIdentifier())
+			}
+		})
+	}
+}
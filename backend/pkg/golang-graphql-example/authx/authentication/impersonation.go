@@ -0,0 +1,158 @@
+package authentication
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+// ImpersonationHeaderName is the header used by an allowed user to act as another user.
+const ImpersonationHeaderName = "X-Impersonate-User"
+
+func (s *service) SetImpersonationService(impersonationSvc ImpersonationService) {
+	s.impersonationSvc = impersonationSvc
+}
+
+// manageImpersonation will replace authenticated actor by impersonated user when requested.
+// Boolean is false when request have been answered.
+func (s *service) manageImpersonation(c *gin.Context, actor *models.OIDCUser) (*models.OIDCUser, bool) {
+	// Get target
+	target := c.GetHeader(ImpersonationHeaderName)
+	// Check if impersonation is requested
+	if target == "" {
+		return actor, true
+	}
+
+	// Get logger
+	logger := log.GetLoggerFromGin(c)
+
+	// Impersonate
+	ouser, logger, err := s.impersonate(c.Request.Context(), logger, actor, target, &models.ImpersonatedRequest{
+		Method: c.Request.Method,
+		URI:    c.Request.URL.RequestURI(),
+	})
+	// Check error
+	if err != nil {
+		logger.Error(err)
+		utils.AnswerWithError(c, err)
+
+		return nil, false
+	}
+
+	// Save logger and user
+	log.SetLoggerToGin(c, logger)
+	c.Request = c.Request.WithContext(
+		SetAuthenticatedUserToContext(log.SetLoggerToContext(c.Request.Context(), logger), ouser),
+	)
+
+	return ouser, true
+}
+
+// impersonate will check that actor is allowed to impersonate target, record the request in audit log
+// and return the impersonated user with a logger containing both identities.
+// Impersonated user have the same claims as the target in order to be authorized exactly like it.
+func (s *service) impersonate(
+	ctx context.Context,
+	logger log.Logger,
+	actor *models.OIDCUser,
+	target string,
+	req *models.ImpersonatedRequest,
+) (*models.OIDCUser, log.Logger, error) {
+	// Check if service is available
+	if s.impersonationSvc == nil {
+		return nil, logger, cerrors.NewForbiddenError("impersonation not available")
+	}
+
+	// Get or start impersonation
+	// Actor is put in context to check if impersonation is allowed
+	imp, err := s.impersonationSvc.GetOrStartImpersonation(SetAuthenticatedUserToContext(ctx, actor), target)
+	// Check error
+	if err != nil {
+		return nil, logger, err
+	}
+
+	// Resolve impersonated user claims
+	ouser, err := s.resolveImpersonatedUser(ctx, imp.Target)
+	// Check error
+	if err != nil {
+		return nil, logger, err
+	}
+
+	// Add impersonation information
+	ouser.SessionID = actor.SessionID
+	ouser.Impersonator = actor
+	ouser.ImpersonationID = imp.ID
+
+	// Add impersonation to logger
+	logger = logger.WithFields(map[string]any{
+		log.LogImpersonatorField:     actor.GetIdentifier(),
+		log.LogImpersonatedUserField: imp.Target,
+		log.LogImpersonationIDField:  imp.ID,
+	})
+
+	// Record request in audit log
+	err = s.impersonationSvc.RecordImpersonatedRequest(
+		SetAuthenticatedUserToContext(log.SetLoggerToContext(ctx, logger), ouser),
+		req,
+	)
+	// Check error
+	if err != nil {
+		return nil, logger, err
+	}
+
+	logger.Infof("User %s impersonated by %s", imp.Target, actor.GetIdentifier())
+
+	return ouser, logger, nil
+}
+
+// resolveImpersonatedUser will build target user from the id token stored in its latest server-side session.
+// Impersonation is refused when those claims cannot be resolved because the impersonated user
+// wouldn't be authorized like the target.
+func (s *service) resolveImpersonatedUser(ctx context.Context, target string) (*models.OIDCUser, error) {
+	// Get configuration
+	cfg := s.cfgManager.GetConfig()
+	// Check if sessions are available
+	if !isSessionEnabled(cfg) || s.sessionSvc == nil {
+		return nil, cerrors.NewForbiddenError("impersonation target claims cannot be resolved without server-side sessions")
+	}
+
+	// Get latest target session
+	sess, err := s.sessionSvc.GetLatestSessionForOwner(ctx, target)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if target have an active session
+	if sess == nil || sess.Tokens == nil || sess.Tokens.IDToken == "" {
+		return nil, cerrors.NewForbiddenError("impersonation target claims cannot be resolved: target doesn't have any active session")
+	}
+
+	// Get claims
+	// Token have been verified before being stored in session and it is only read from server-side storage.
+	// Expiry isn't checked because claims are only used to describe the target.
+	claims, err := getUnverifiedClaims(sess.Tokens.IDToken)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Build user from claims
+	// Sessions are only created by the login flow which is using the main issuer
+	ouser := mapClaims(claims, cfg.OIDCAuthentication.ClaimMapping)
+	ouser.PrincipalType = models.PrincipalTypeUser
+	ouser.Issuer, _ = claims["iss"].(string)
+	ouser.Subject, _ = claims["sub"].(string)
+
+	// Check that claims are corresponding to target
+	if ouser.GetIdentifier() != target {
+		return nil, errors.Errorf("impersonation target session claims are corresponding to %s", ouser.GetIdentifier())
+	}
+
+	return ouser, nil
+}
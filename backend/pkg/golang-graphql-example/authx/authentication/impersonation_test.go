@@ -0,0 +1,244 @@
+//go:build unit
+
+package authentication
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+type fakeImpersonationService struct {
+	err      error
+	actor    *models.OIDCUser
+	recorded *models.ImpersonatedRequest
+	user     *models.OIDCUser
+}
+
+func (f *fakeImpersonationService) GetOrStartImpersonation(
+	ctx context.Context,
+	target string,
+) (*models.Impersonation, error) {
+	f.actor = GetAuthenticatedUserFromContext(ctx)
+	// Check if error must be returned
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &models.Impersonation{ID: "imp-id", Target: target}, nil
+}
+
+func (f *fakeImpersonationService) RecordImpersonatedRequest(
+	ctx context.Context,
+	req *models.ImpersonatedRequest,
+) error {
+	f.user = GetAuthenticatedUserFromContext(ctx)
+	f.recorded = req
+
+	return nil
+}
+
+// Build a session containing an id token for impersonation target.
+// Signature isn't needed as claims are read from server-side storage.
+func newTargetSession(t *testing.T, claims map[string]any) *models.Session {
+	t.Helper()
+
+	b, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	return &models.Session{
+		ID: "target-sess",
+		Tokens: &models.SessionTokens{
+			IDToken: "e30." + base64.RawURLEncoding.EncodeToString(b) + ".sig",
+		},
+	}
+}
+
+// Configuration with server-side sessions and claim mapping used by impersonation tests.
+func newImpersonationTestConfig(session bool) *config.Config {
+	cfg := &config.Config{
+		OIDCAuthentication: &config.OIDCAuthConfig{
+			ClaimMapping: &config.OIDCClaimMappingConfig{
+				Username: config.DefaultOIDCClaimMappingUsername,
+				Email:    config.DefaultOIDCClaimMappingEmail,
+				Name:     config.DefaultOIDCClaimMappingName,
+				Roles:    "roles",
+				Groups:   "groups",
+				Tenant:   "tenant",
+			},
+		},
+	}
+	if session {
+		cfg.OIDCAuthentication.Session = &config.OIDCSessionConfig{}
+	}
+
+	return cfg
+}
+
+func Test_service_manageImpersonation(t *testing.T) {
+	actor := &models.OIDCUser{
+		PrincipalType:     models.PrincipalTypeUser,
+		Issuer:            "https://issuer",
+		PreferredUsername: "admin",
+		Roles:             []string{"admin"},
+		SessionID:         "sess",
+	}
+
+	targetSession := newTargetSession(t, map[string]any{
+		"iss":                "https://issuer",
+		"sub":                "user-sub",
+		"preferred_username": "user",
+		"email":              "user@example.com",
+		"name":               "User",
+		"tenant":             "tenant1",
+		"roles":              []string{"reader"},
+		"groups":             []string{"team"},
+	})
+
+	tests := []struct {
+		name         string
+		header       string
+		session      bool
+		impSvc       *fakeImpersonationService
+		sessionSvc   *fakeSessionService
+		wantOk       bool
+		wantUser     string
+		expectedCode int
+	}{
+		{
+			name:     "no header",
+			impSvc:   &fakeImpersonationService{},
+			wantOk:   true,
+			wantUser: "admin",
+		},
+		{
+			name:         "service not available",
+			header:       "user",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "not allowed",
+			header:       "user",
+			impSvc:       &fakeImpersonationService{err: cerrors.NewForbiddenError("forbidden")},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "sessions disabled",
+			header:       "user",
+			impSvc:       &fakeImpersonationService{},
+			sessionSvc:   &fakeSessionService{session: targetSession},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "target without active session",
+			header:       "user",
+			session:      true,
+			impSvc:       &fakeImpersonationService{},
+			sessionSvc:   &fakeSessionService{},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "target session claims of another user",
+			header:       "other",
+			session:      true,
+			impSvc:       &fakeImpersonationService{},
+			sessionSvc:   &fakeSessionService{session: targetSession},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:       "impersonated",
+			header:     "user",
+			session:    true,
+			impSvc:     &fakeImpersonationService{},
+			sessionSvc: &fakeSessionService{session: targetSession},
+			wantOk:     true,
+			wantUser:   "user",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfgManager := cmocks.NewMockManager(ctrl)
+			cfgManager.EXPECT().GetConfig().AnyTimes().Return(newImpersonationTestConfig(tt.session))
+
+			s := &service{cfgManager: cfgManager}
+			// Avoid typed nil interfaces
+			if tt.impSvc != nil {
+				s.SetImpersonationService(tt.impSvc)
+			}
+
+			if tt.sessionSvc != nil {
+				s.SetSessionService(tt.sessionSvc)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/api/graphql?q=1", nil)
+			if tt.header != "" {
+				c.Request.Header.Set(ImpersonationHeaderName, tt.header)
+			}
+
+			log.SetLoggerToGin(c, log.NewLogger())
+
+			got, ok := s.manageImpersonation(c, actor)
+			assert.Equal(t, tt.wantOk, ok)
+
+			if !tt.wantOk {
+				assert.Nil(t, got)
+				assert.Equal(t, tt.expectedCode, w.Code)
+				// Nothing must be recorded when impersonation is refused
+				if tt.impSvc != nil {
+					assert.Nil(t, tt.impSvc.recorded)
+				}
+
+				return
+			}
+
+			require.NotNil(t, got)
+			assert.Equal(t, tt.wantUser, got.GetIdentifier())
+
+			// Check impersonation specific results
+			if tt.header == "" {
+				assert.Same(t, actor, got)
+
+				return
+			}
+
+			assert.Same(t, actor, tt.impSvc.actor)
+			assert.Same(t, actor, got.Impersonator)
+			assert.Same(t, actor, got.GetActor())
+			assert.Equal(t, "imp-id", got.ImpersonationID)
+			assert.Equal(t, "sess", got.SessionID)
+			assert.Equal(t, "user", tt.sessionSvc.owner)
+			// Target claims must be used
+			assert.Equal(t, models.PrincipalTypeUser, got.PrincipalType)
+			assert.Equal(t, "https://issuer", got.Issuer)
+			assert.Equal(t, "user-sub", got.Subject)
+			assert.Equal(t, "user@example.com", got.Email)
+			assert.Equal(t, "User", got.Name)
+			assert.Equal(t, "tenant1", got.Tenant)
+			assert.Equal(t, []string{"reader"}, got.Roles)
+			assert.Equal(t, []string{"team"}, got.Groups)
+			assert.Nil(t, got.APIToken)
+			assert.Same(t, got, tt.impSvc.user)
+			assert.Equal(t, &models.ImpersonatedRequest{Method: http.MethodPost, URI: "/api/graphql?q=1"}, tt.impSvc.recorded)
+			assert.Same(t, got, GetAuthenticatedUserFromContext(c.Request.Context()))
+		})
+	}
+}
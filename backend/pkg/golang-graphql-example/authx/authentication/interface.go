@@ -1,10 +1,13 @@
 package authentication
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
 )
 
@@ -14,6 +17,24 @@ type Service interface {
 	Middleware(unauthorizedPathRegexList []*regexp.Regexp) gin.HandlerFunc
 	// OIDCEndpoints will set OpenID Connect endpoints for authentication and callback.
 	OIDCEndpoints(router gin.IRouter) error
+	// GRPCUnaryInterceptor will authenticate gRPC calls with the same tokens as the HTTP middleware
+	// (personal access tokens or OIDC bearer tokens in metadata, impersonation included) except for ignored methods.
+	// OIDC endpoints must have been initialized before.
+	GRPCUnaryInterceptor(ignoredMethodList []string) grpc.UnaryServerInterceptor
+	// GRPCStreamInterceptor is the stream equivalent of GRPCUnaryInterceptor.
+	// Stream context must contain a logger.
+	GRPCStreamInterceptor(ignoredMethodList []string) grpc.StreamServerInterceptor
+	// SetAPITokenService will set the service used to authenticate personal access tokens.
+	// Those are accepted in the "X-API-Key" header or in the "Authorization" header with a "Bearer" prefix.
+	SetAPITokenService(apiTokenSvc APITokenService)
+	// SetSessionService will set the service used to manage server-side sessions.
+	// It is only used when sessions are enabled in configuration.
+	SetSessionService(sessionSvc SessionService)
+	// SetImpersonationService will set the service used to audit impersonations.
+	// Impersonation is requested with the "X-Impersonate-User" header.
+	// Impersonated user claims are resolved from its latest server-side session,
+	// so impersonation is refused when sessions are disabled or when target doesn't have any active session.
+	SetImpersonationService(impersonationSvc ImpersonationService)
 }
 
 func NewService(cfgManager config.Manager) Service {
@@ -21,3 +42,34 @@ func NewService(cfgManager config.Manager) Service {
 		cfgManager: cfgManager,
 	}
 }
+
+//go:generate mockgen -destination=./mocks/mock_APITokenService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication APITokenService
+type APITokenService interface {
+	// AuthenticateAPIToken will validate a personal access token and return the associated principal.
+	AuthenticateAPIToken(ctx context.Context, token string) (*models.OIDCUser, error)
+}
+
+//go:generate mockgen -destination=./mocks/mock_SessionService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication SessionService
+type SessionService interface {
+	// CreateSession will create a server-side session and return the opaque session token.
+	CreateSession(ctx context.Context, inp *models.SessionInput) (string, error)
+	// GetSession will return the session linked to an opaque session token.
+	// Nil is returned when session doesn't exist or is expired.
+	GetSession(ctx context.Context, token string) (*models.Session, error)
+	// GetLatestSessionForOwner will return the latest active session of an owner.
+	// Nil is returned when owner doesn't have any active session.
+	GetLatestSessionForOwner(ctx context.Context, owner string) (*models.Session, error)
+	// UpdateSessionTokens will save refreshed tokens in session.
+	UpdateSessionTokens(ctx context.Context, id string, tokens *models.SessionTokens) error
+	// DeleteSession will delete the session linked to an opaque session token.
+	DeleteSession(ctx context.Context, token string) error
+}
+
+//go:generate mockgen -destination=./mocks/mock_ImpersonationService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication ImpersonationService
+type ImpersonationService interface {
+	// GetOrStartImpersonation will check that authenticated user in context is allowed to impersonate target
+	// and return its active impersonation session or start a new audited one.
+	GetOrStartImpersonation(ctx context.Context, target string) (*models.Impersonation, error)
+	// RecordImpersonatedRequest will record a request done by the impersonated user in context in audit log.
+	RecordImpersonatedRequest(ctx context.Context, req *models.ImpersonatedRequest) error
+}
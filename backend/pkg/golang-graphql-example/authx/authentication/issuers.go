@@ -0,0 +1,181 @@
+package authentication
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/samber/lo"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+const jwtPartsLength = 3
+
+type trustedIssuer struct {
+	verifier *oidc.IDTokenVerifier
+	cfg      *config.OIDCTrustedIssuerConfig
+}
+
+// Create verifiers for all additional trusted issuers.
+func (s *service) loadTrustedIssuers(ctx context.Context, cfg *config.OIDCAuthConfig) error {
+	// Initialize result
+	res := map[string]*trustedIssuer{}
+
+	// Loop over trusted issuers
+	for _, it := range cfg.TrustedIssuers {
+		// Create provider
+		provider, err := oidc.NewProvider(ctx, it.IssuerURL)
+		// Check error
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		// Save verifier
+		// Audiences are checked after verification because multiple ones are supported
+		res[it.IssuerURL] = &trustedIssuer{
+			verifier: provider.Verifier(&oidc.Config{SkipClientIDCheck: true}),
+			cfg:      it,
+		}
+	}
+
+	// Save
+	s.trustedIssuers = res
+
+	return nil
+}
+
+// Verify token with the verifier corresponding to its issuer and build the authenticated principal.
+func (s *service) verifyToken(
+	ctx context.Context,
+	cfg *config.OIDCAuthConfig,
+	token string,
+) (*models.OIDCUser, error) {
+	// Check if token is coming from a trusted issuer
+	if ti := s.trustedIssuers[getUnverifiedIssuer(token)]; ti != nil {
+		return ti.verify(ctx, token)
+	}
+
+	// Verify token with main issuer
+	idToken, err := s.verifier.Verify(ctx, token)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Main issuer is used for the login flow, so only human users are coming from it
+	return buildOIDCUser(idToken, cfg.ClaimMapping, config.OIDCPrincipalTypeUser)
+}
+
+func (ti *trustedIssuer) verify(ctx context.Context, token string) (*models.OIDCUser, error) {
+	// Verify token
+	idToken, err := ti.verifier.Verify(ctx, token)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Check audience
+	if !lo.Some(idToken.Audience, ti.cfg.Audiences) {
+		return nil, errors.Errorf(
+			"token audience %v not trusted for issuer %s",
+			idToken.Audience,
+			ti.cfg.IssuerURL,
+		)
+	}
+
+	// Build principal
+	ouser, err := buildOIDCUser(idToken, ti.cfg.ClaimMapping, ti.cfg.PrincipalType)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Qualify identifier with issuer
+	ouser.TrustedIssuer = true
+
+	return ouser, nil
+}
+
+func buildOIDCUser(
+	idToken *oidc.IDToken,
+	mapping *config.OIDCClaimMappingConfig,
+	principalType string,
+) (*models.OIDCUser, error) {
+	// Get all claims
+	claims := map[string]any{}
+
+	err := idToken.Claims(&claims)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Build user from claims
+	ouser := mapClaims(claims, mapping)
+
+	// Save token information
+	ouser.Issuer = idToken.Issuer
+	ouser.Subject = idToken.Subject
+
+	// Resolve principal type
+	switch principalType {
+	case config.OIDCPrincipalTypeService:
+		ouser.PrincipalType = models.PrincipalTypeService
+	case config.OIDCPrincipalTypeAuto:
+		// Client credentials tokens don't have any email
+		ouser.PrincipalType = lo.Ternary(ouser.Email == "", models.PrincipalTypeService, models.PrincipalTypeUser)
+	default:
+		ouser.PrincipalType = models.PrincipalTypeUser
+	}
+
+	return ouser, nil
+}
+
+// Get issuer from token without any verification.
+// This is only used to select the right verifier.
+func getUnverifiedIssuer(token string) string {
+	// Get claims
+	claims, err := getUnverifiedClaims(token)
+	// Check error
+	if err != nil {
+		return ""
+	}
+
+	// Get issuer
+	iss, _ := claims["iss"].(string)
+
+	return iss
+}
+
+// Get claims from token payload without any verification.
+func getUnverifiedClaims(token string) (map[string]any, error) {
+	// Split token
+	parts := strings.Split(token, ".")
+	// Check if it is a jwt
+	if len(parts) != jwtPartsLength {
+		return nil, errors.New("token isn't a jwt")
+	}
+
+	// Decode payload
+	b, err := base64.RawURLEncoding.DecodeString(parts[1])
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Parse claims
+	claims := map[string]any{}
+
+	err = json.Unmarshal(b, &claims)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,163 @@
+//go:build unit
+
+package authentication
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+const testIssuer = "https://issuer.example.com"
+
+func signTestToken(t *testing.T, key *rsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, nil)
+	require.NoError(t, err)
+
+	b, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	jws, err := signer.Sign(b)
+	require.NoError(t, err)
+
+	res, err := jws.CompactSerialize()
+	require.NoError(t, err)
+
+	return res
+}
+
+func Test_getUnverifiedIssuer(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	assert.Equal(t, testIssuer, getUnverifiedIssuer(signTestToken(t, key, map[string]any{"iss": testIssuer})))
+	assert.Empty(t, getUnverifiedIssuer("not-a-jwt"))
+	assert.Empty(t, getUnverifiedIssuer("a.b.c"))
+}
+
+func Test_trustedIssuer_verify(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	verifier := oidc.NewVerifier(
+		testIssuer,
+		&oidc.StaticKeySet{PublicKeys: []crypto.PublicKey{key.Public()}},
+		&oidc.Config{SkipClientIDCheck: true},
+	)
+	mapping := &config.OIDCClaimMappingConfig{
+		Username: "upn",
+		Email:    "email",
+		Name:     "name",
+		ClientID: "client_id",
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name          string
+		principalType string
+		claims        map[string]any
+		want          *models.OIDCUser
+		wantErr       bool
+	}{
+		{
+			name:          "audience not trusted",
+			principalType: config.OIDCPrincipalTypeAuto,
+			claims:        map[string]any{"iss": testIssuer, "sub": "s", "aud": "other", "exp": exp},
+			wantErr:       true,
+		},
+		{
+			name:          "human user with mapped username",
+			principalType: config.OIDCPrincipalTypeAuto,
+			claims: map[string]any{
+				"iss":                testIssuer,
+				"sub":                "s",
+				"aud":                []string{"other", "api"},
+				"exp":                exp,
+				"upn":                "partner-user",
+				"preferred_username": "ignored",
+				"email":              "user@partner.com",
+				"api_token":          map[string]any{"id": "fake"},
+			},
+			want: &models.OIDCUser{
+				PrincipalType:     models.PrincipalTypeUser,
+				Issuer:            testIssuer,
+				TrustedIssuer:     true,
+				Subject:           "s",
+				PreferredUsername: "partner-user",
+				Email:             "user@partner.com",
+			},
+		},
+		{
+			name:          "client credentials token detected as service principal",
+			principalType: config.OIDCPrincipalTypeAuto,
+			claims:        map[string]any{"iss": testIssuer, "sub": "s", "aud": "api", "exp": exp, "client_id": "ci-job"},
+			want: &models.OIDCUser{
+				PrincipalType: models.PrincipalTypeService,
+				Issuer:        testIssuer,
+				Subject:       "s",
+				TrustedIssuer: true,
+				ClientID:      "ci-job",
+			},
+		},
+		{
+			name:          "forced service principal",
+			principalType: config.OIDCPrincipalTypeService,
+			claims:        map[string]any{"iss": testIssuer, "sub": "s", "aud": "api", "exp": exp, "email": "bot@example.com"},
+			want: &models.OIDCUser{
+				PrincipalType: models.PrincipalTypeService,
+				Issuer:        testIssuer,
+				Subject:       "s",
+				TrustedIssuer: true,
+				Email:         "bot@example.com",
+			},
+		},
+		{
+			name:          "forced user",
+			principalType: config.OIDCPrincipalTypeUser,
+			claims:        map[string]any{"iss": testIssuer, "sub": "s", "aud": "api", "exp": exp},
+			want: &models.OIDCUser{
+				PrincipalType: models.PrincipalTypeUser,
+				Issuer:        testIssuer,
+				Subject:       "s",
+				TrustedIssuer: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := &trustedIssuer{
+				verifier: verifier,
+				cfg: &config.OIDCTrustedIssuerConfig{
+					IssuerURL:     testIssuer,
+					Audiences:     []string{"api"},
+					PrincipalType: tt.principalType,
+					ClaimMapping:  mapping,
+				},
+			}
+
+			got, err := ti.verify(context.TODO(), signTestToken(t, key, tt.claims))
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
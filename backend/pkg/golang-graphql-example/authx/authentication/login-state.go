@@ -0,0 +1,173 @@
+package authentication
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+const (
+	loginStateCookieSuffix  = "_login"
+	loginStateDuration      = 10 * time.Minute
+	loginStateRandomLength  = 32
+	loginStateSignSeparator = "."
+)
+
+// ErrInvalidLoginState is returned when login state cookie is missing, tampered or expired.
+var ErrInvalidLoginState = errors.Sentinel("invalid login state")
+
+// loginState is stored in a signed short-lived cookie during OIDC login flow.
+type loginState struct {
+	ExpiresAt    time.Time `json:"exp"`
+	State        string    `json:"state"`
+	Nonce        string    `json:"nonce"`
+	CodeVerifier string    `json:"cv"`
+	RedirectURL  string    `json:"rd,omitempty"`
+}
+
+// newLoginState will generate a new login state with random state, nonce and PKCE code verifier.
+func newLoginState(rdVal string) (*loginState, error) {
+	// Generate state
+	state, err := generateRandomString()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate nonce
+	nonce, err := generateRandomString()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &loginState{
+		ExpiresAt:    time.Now().Add(loginStateDuration),
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: oauth2.GenerateVerifier(),
+		RedirectURL:  rdVal,
+	}, nil
+}
+
+// encode will serialize and sign login state.
+func (ls *loginState) encode(secret string) (string, error) {
+	// Json encode
+	b, err := json.Marshal(ls)
+	// Check error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	// Encode payload
+	payload := base64.RawURLEncoding.EncodeToString(b)
+
+	return payload + loginStateSignSeparator + signLoginState(secret, payload), nil
+}
+
+// decodeLoginState will verify signature and expiration and deserialize login state.
+func decodeLoginState(secret, value string) (*loginState, error) {
+	// Split payload and signature
+	payload, signature, ok := strings.Cut(value, loginStateSignSeparator)
+	// Check format
+	if !ok {
+		return nil, errors.WithStack(ErrInvalidLoginState)
+	}
+
+	// Check signature
+	if !hmac.Equal([]byte(signature), []byte(signLoginState(secret, payload))) {
+		return nil, errors.WithStack(ErrInvalidLoginState)
+	}
+
+	// Decode payload
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(ErrInvalidLoginState)
+	}
+
+	var res loginState
+	// Json decode
+	err = json.Unmarshal(b, &res)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(ErrInvalidLoginState)
+	}
+
+	// Check expiration
+	if !res.ExpiresAt.After(time.Now()) {
+		return nil, errors.Wrap(ErrInvalidLoginState, "login state expired")
+	}
+
+	return &res, nil
+}
+
+// matchState will check that state returned by provider is the one generated for this login.
+func (ls *loginState) matchState(state string) bool {
+	return subtle.ConstantTimeCompare([]byte(ls.State), []byte(state)) == 1
+}
+
+// matchNonce will check that nonce found in ID token is the one generated for this login.
+func (ls *loginState) matchNonce(nonce string) bool {
+	return subtle.ConstantTimeCompare([]byte(ls.Nonce), []byte(nonce)) == 1
+}
+
+// getLoginStateSecret will return secret used to sign login state cookie.
+// Deprecated state secret is only used when dedicated login state secret isn't set.
+func getLoginStateSecret(cfg *config.OIDCAuthConfig) string {
+	// Check if dedicated secret is set
+	if cfg.LoginStateSecret != nil {
+		return cfg.LoginStateSecret.Value
+	}
+
+	return cfg.State
+}
+
+func signLoginState(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	// Write never returns an error
+	_, _ = mac.Write([]byte(payload))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func generateRandomString() (string, error) {
+	b := make([]byte, loginStateRandomLength)
+
+	_, err := rand.Read(b)
+	// Check error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func loginStateCookieName(cookieName string) string {
+	return cookieName + loginStateCookieSuffix
+}
+
+// Build login state cookie.
+// Lax same site policy is needed as the callback is a top level navigation coming from provider.
+func buildLoginStateCookie(name, value, cookiePath string, secure bool, expires time.Time) *http.Cookie {
+	return &http.Cookie{
+		Expires:  expires,
+		Name:     name,
+		Value:    value,
+		HttpOnly: true,
+		Secure:   secure,
+		Path:     cookiePath,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
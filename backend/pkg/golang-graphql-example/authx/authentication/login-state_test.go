@@ -0,0 +1,95 @@
+//go:build unit
+
+package authentication
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+func Test_loginState(t *testing.T) {
+	ls, err := newLoginState("http://example.com/fake")
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, ls.State)
+	assert.NotEmpty(t, ls.Nonce)
+	assert.NotEmpty(t, ls.CodeVerifier)
+	assert.NotEqual(t, ls.State, ls.Nonce)
+
+	// Each login must have its own values
+	ls2, err := newLoginState("")
+	require.NoError(t, err)
+	assert.NotEqual(t, ls.State, ls2.State)
+	assert.NotEqual(t, ls.Nonce, ls2.Nonce)
+	assert.NotEqual(t, ls.CodeVerifier, ls2.CodeVerifier)
+
+	// Encode and decode
+	v, err := ls.encode("secret")
+	require.NoError(t, err)
+
+	res, err := decodeLoginState("secret", v)
+	require.NoError(t, err)
+	assert.Equal(t, ls.State, res.State)
+	assert.Equal(t, ls.Nonce, res.Nonce)
+	assert.Equal(t, ls.CodeVerifier, res.CodeVerifier)
+	assert.Equal(t, "http://example.com/fake", res.RedirectURL)
+	assert.True(t, res.matchState(ls.State))
+	assert.False(t, res.matchState(ls2.State))
+	assert.True(t, res.matchNonce(ls.Nonce))
+	assert.False(t, res.matchNonce(""))
+
+	// Wrong secret
+	_, err = decodeLoginState("other", v)
+	assert.True(t, errors.Is(err, ErrInvalidLoginState))
+
+	// Tampered payload
+	payload, signature, _ := strings.Cut(v, loginStateSignSeparator)
+	_, err = decodeLoginState("secret", payload+"a"+loginStateSignSeparator+signature)
+	assert.True(t, errors.Is(err, ErrInvalidLoginState))
+
+	// Invalid format
+	_, err = decodeLoginState("secret", "fake")
+	assert.True(t, errors.Is(err, ErrInvalidLoginState))
+
+	// Expired
+	ls.ExpiresAt = time.Now().Add(-time.Second)
+	v, err = ls.encode("secret")
+	require.NoError(t, err)
+
+	_, err = decodeLoginState("secret", v)
+	assert.True(t, errors.Is(err, ErrInvalidLoginState))
+}
+
+func Test_getLoginStateSecret(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *config.OIDCAuthConfig
+		want string
+	}{
+		{
+			name: "dedicated secret",
+			cfg: &config.OIDCAuthConfig{
+				LoginStateSecret: &config.CredentialConfig{Value: "dedicated"},
+				State:            "deprecated",
+			},
+			want: "dedicated",
+		},
+		{
+			name: "deprecated state secret",
+			cfg:  &config.OIDCAuthConfig{State: "deprecated"},
+			want: "deprecated",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getLoginStateSecret(tt.cfg))
+		})
+	}
+}
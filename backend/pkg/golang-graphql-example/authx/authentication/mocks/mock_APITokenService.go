@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication (interfaces: APITokenService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_APITokenService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication APITokenService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAPITokenService is a mock of APITokenService interface.
+type MockAPITokenService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPITokenServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAPITokenServiceMockRecorder is the mock recorder for MockAPITokenService.
+type MockAPITokenServiceMockRecorder struct {
+	mock *MockAPITokenService
+}
+
+// NewMockAPITokenService creates a new mock instance.
+func NewMockAPITokenService(ctrl *gomock.Controller) *MockAPITokenService {
+	mock := &MockAPITokenService{ctrl: ctrl}
+	mock.recorder = &MockAPITokenServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPITokenService) EXPECT() *MockAPITokenServiceMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateAPIToken mocks base method.
+func (m *MockAPITokenService) AuthenticateAPIToken(ctx context.Context, token string) (*models.OIDCUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIToken", ctx, token)
+	ret0, _ := ret[0].(*models.OIDCUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIToken indicates an expected call of AuthenticateAPIToken.
+func (mr *MockAPITokenServiceMockRecorder) AuthenticateAPIToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIToken", reflect.TypeOf((*MockAPITokenService)(nil).AuthenticateAPIToken), ctx, token)
+}
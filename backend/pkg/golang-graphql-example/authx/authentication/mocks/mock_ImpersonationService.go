@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication (interfaces: ImpersonationService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_ImpersonationService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication ImpersonationService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockImpersonationService is a mock of ImpersonationService interface.
+type MockImpersonationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImpersonationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockImpersonationServiceMockRecorder is the mock recorder for MockImpersonationService.
+type MockImpersonationServiceMockRecorder struct {
+	mock *MockImpersonationService
+}
+
+// NewMockImpersonationService creates a new mock instance.
+func NewMockImpersonationService(ctrl *gomock.Controller) *MockImpersonationService {
+	mock := &MockImpersonationService{ctrl: ctrl}
+	mock.recorder = &MockImpersonationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImpersonationService) EXPECT() *MockImpersonationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetOrStartImpersonation mocks base method.
+func (m *MockImpersonationService) GetOrStartImpersonation(ctx context.Context, target string) (*models.Impersonation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrStartImpersonation", ctx, target)
+	ret0, _ := ret[0].(*models.Impersonation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrStartImpersonation indicates an expected call of GetOrStartImpersonation.
+func (mr *MockImpersonationServiceMockRecorder) GetOrStartImpersonation(ctx, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrStartImpersonation", reflect.TypeOf((*MockImpersonationService)(nil).GetOrStartImpersonation), ctx, target)
+}
+
+// RecordImpersonatedRequest mocks base method.
+func (m *MockImpersonationService) RecordImpersonatedRequest(ctx context.Context, req *models.ImpersonatedRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordImpersonatedRequest", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordImpersonatedRequest indicates an expected call of RecordImpersonatedRequest.
+func (mr *MockImpersonationServiceMockRecorder) RecordImpersonatedRequest(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordImpersonatedRequest", reflect.TypeOf((*MockImpersonationService)(nil).RecordImpersonatedRequest), ctx, req)
+}
@@ -14,7 +14,9 @@ import (
 	regexp "regexp"
 
 	gin "github.com/gin-gonic/gin"
+	authentication "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
 	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
 )
 
 // MockService is a mock of Service interface.
@@ -41,6 +43,34 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// GRPCStreamInterceptor mocks base method.
+func (m *MockService) GRPCStreamInterceptor(ignoredMethodList []string) grpc.StreamServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCStreamInterceptor", ignoredMethodList)
+	ret0, _ := ret[0].(grpc.StreamServerInterceptor)
+	return ret0
+}
+
+// GRPCStreamInterceptor indicates an expected call of GRPCStreamInterceptor.
+func (mr *MockServiceMockRecorder) GRPCStreamInterceptor(ignoredMethodList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCStreamInterceptor", reflect.TypeOf((*MockService)(nil).GRPCStreamInterceptor), ignoredMethodList)
+}
+
+// GRPCUnaryInterceptor mocks base method.
+func (m *MockService) GRPCUnaryInterceptor(ignoredMethodList []string) grpc.UnaryServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCUnaryInterceptor", ignoredMethodList)
+	ret0, _ := ret[0].(grpc.UnaryServerInterceptor)
+	return ret0
+}
+
+// GRPCUnaryInterceptor indicates an expected call of GRPCUnaryInterceptor.
+func (mr *MockServiceMockRecorder) GRPCUnaryInterceptor(ignoredMethodList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCUnaryInterceptor", reflect.TypeOf((*MockService)(nil).GRPCUnaryInterceptor), ignoredMethodList)
+}
+
 // Middleware mocks base method.
 func (m *MockService) Middleware(unauthorizedPathRegexList []*regexp.Regexp) gin.HandlerFunc {
 	m.ctrl.T.Helper()
@@ -68,3 +98,39 @@ func (mr *MockServiceMockRecorder) OIDCEndpoints(router any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OIDCEndpoints", reflect.TypeOf((*MockService)(nil).OIDCEndpoints), router)
 }
+
+// SetAPITokenService mocks base method.
+func (m *MockService) SetAPITokenService(apiTokenSvc authentication.APITokenService) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAPITokenService", apiTokenSvc)
+}
+
+// SetAPITokenService indicates an expected call of SetAPITokenService.
+func (mr *MockServiceMockRecorder) SetAPITokenService(apiTokenSvc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAPITokenService", reflect.TypeOf((*MockService)(nil).SetAPITokenService), apiTokenSvc)
+}
+
+// SetImpersonationService mocks base method.
+func (m *MockService) SetImpersonationService(impersonationSvc authentication.ImpersonationService) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetImpersonationService", impersonationSvc)
+}
+
+// SetImpersonationService indicates an expected call of SetImpersonationService.
+func (mr *MockServiceMockRecorder) SetImpersonationService(impersonationSvc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImpersonationService", reflect.TypeOf((*MockService)(nil).SetImpersonationService), impersonationSvc)
+}
+
+// SetSessionService mocks base method.
+func (m *MockService) SetSessionService(sessionSvc authentication.SessionService) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSessionService", sessionSvc)
+}
+
+// SetSessionService indicates an expected call of SetSessionService.
+func (mr *MockServiceMockRecorder) SetSessionService(sessionSvc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSessionService", reflect.TypeOf((*MockService)(nil).SetSessionService), sessionSvc)
+}
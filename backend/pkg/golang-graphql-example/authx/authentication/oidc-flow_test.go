@@ -0,0 +1,309 @@
+//go:build unit
+
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+type fakeAuthorizationRequest struct {
+	challenge string
+	nonce     string
+}
+
+// fakeOIDCProvider is a minimal local OpenID Connect provider supporting
+// authorization code flow with PKCE S256 and nonce.
+type fakeOIDCProvider struct {
+	t *testing.T
+	*httptest.Server
+	key   *rsa.PrivateKey
+	codes map[string]*fakeAuthorizationRequest
+	// Nonce override used to simulate a replayed ID token
+	forcedNonce string
+	mutex       sync.Mutex
+}
+
+func newFakeOIDCProvider(t *testing.T) *fakeOIDCProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p := &fakeOIDCProvider{t: t, key: key, codes: map[string]*fakeAuthorizationRequest{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("/jwks", p.jwks)
+	mux.HandleFunc("/authorize", p.authorize)
+	mux.HandleFunc("/token", p.token)
+
+	p.Server = httptest.NewServer(mux)
+
+	return p
+}
+
+func (p *fakeOIDCProvider) discovery(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	assert.NoError(p.t, json.NewEncoder(w).Encode(map[string]any{
+		"issuer":                                p.URL,
+		"authorization_endpoint":                p.URL + "/authorize",
+		"token_endpoint":                        p.URL + "/token",
+		"jwks_uri":                              p.URL + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	}))
+}
+
+func (p *fakeOIDCProvider) jwks(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	assert.NoError(p.t, json.NewEncoder(w).Encode(jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{{Key: p.key.Public(), Algorithm: "RS256", Use: "sig"}},
+	}))
+}
+
+// authorize will directly redirect to client with a code (user is considered as logged).
+func (p *fakeOIDCProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	// Only S256 is accepted
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "pkce required", http.StatusBadRequest)
+
+		return
+	}
+
+	code, err := generateRandomString()
+	assert.NoError(p.t, err)
+
+	p.mutex.Lock()
+	p.codes[code] = &fakeAuthorizationRequest{challenge: q.Get("code_challenge"), nonce: q.Get("nonce")}
+	p.mutex.Unlock()
+
+	rd, err := url.Parse(q.Get("redirect_uri"))
+	assert.NoError(p.t, err)
+
+	rq := rd.Query()
+	rq.Set("code", code)
+	rq.Set("state", q.Get("state"))
+	rd.RawQuery = rq.Encode()
+
+	http.Redirect(w, r, rd.String(), http.StatusFound)
+}
+
+func (p *fakeOIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	assert.NoError(p.t, r.ParseForm())
+
+	p.mutex.Lock()
+	req := p.codes[r.Form.Get("code")]
+	delete(p.codes, r.Form.Get("code"))
+	p.mutex.Unlock()
+
+	// Check code
+	if req == nil {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+
+		return
+	}
+
+	// Check PKCE code verifier
+	h := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(h[:]) != req.challenge {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+
+		return
+	}
+
+	nonce := req.nonce
+	if p.forcedNonce != "" {
+		nonce = p.forcedNonce
+	}
+
+	idToken := signTestToken(p.t, p.key, map[string]any{
+		"iss":                p.URL,
+		"sub":                "s",
+		"aud":                "client",
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"nonce":              nonce,
+		"preferred_username": "user",
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	assert.NoError(p.t, json.NewEncoder(w).Encode(map[string]any{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	}))
+}
+
+func Test_service_OIDCEndpoints_loginFlow(t *testing.T) {
+	const loginStateSecret = "01234567890123456789012345678901"
+
+	invalidInputBody := `{"error":"invalid input","extensions":{"code":"INVALID_INPUT"}}`
+
+	tests := []struct {
+		name               string
+		forcedNonce        string
+		alterState         bool
+		alterVerifier      bool
+		removeStateCookie  bool
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "valid flow",
+			expectedStatusCode: http.StatusTemporaryRedirect,
+		},
+		{
+			name:               "state mismatch",
+			alterState:         true,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       invalidInputBody,
+		},
+		{
+			name:               "missing state cookie",
+			removeStateCookie:  true,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       invalidInputBody,
+		},
+		{
+			name:               "nonce mismatch",
+			forcedNonce:        "replayed",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       invalidInputBody,
+		},
+		{
+			name:               "wrong code verifier",
+			alterVerifier:      true,
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newFakeOIDCProvider(t)
+			defer provider.Close()
+
+			provider.forcedNonce = tt.forcedNonce
+
+			ctrl := gomock.NewController(t)
+			cfgManager := cmocks.NewMockManager(ctrl)
+			cfgManager.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+				OIDCAuthentication: &config.OIDCAuthConfig{
+					ClientID:         "client",
+					IssuerURL:        provider.URL,
+					RedirectURL:      "http://example.com/",
+					LoginStateSecret: &config.CredentialConfig{Value: loginStateSecret},
+					CookieName:       "oidc",
+					Scopes:           []string{"openid"},
+					ClaimMapping:     &config.OIDCClaimMappingConfig{Username: "preferred_username"},
+				},
+			})
+
+			s := &service{cfgManager: cfgManager}
+
+			// Create router
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				log.SetLoggerToGin(c, log.NewLogger())
+			})
+			require.NoError(t, s.OIDCEndpoints(router))
+
+			// Start login
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://example.com/auth/oidc?rd=http%3A%2F%2Fexample.com%2Ffake", nil))
+			require.Equal(t, http.StatusFound, w.Code)
+
+			// Check authorization request
+			authURL, err := url.Parse(w.Header().Get("Location"))
+			require.NoError(t, err)
+			assert.Equal(t, "S256", authURL.Query().Get("code_challenge_method"))
+			assert.NotEmpty(t, authURL.Query().Get("code_challenge"))
+			assert.NotEmpty(t, authURL.Query().Get("nonce"))
+			assert.NotEmpty(t, authURL.Query().Get("state"))
+
+			// Get login state cookie
+			stateCookies := w.Result().Cookies() //nolint:bodyclose // Recorder result
+			require.Len(t, stateCookies, 1)
+			assert.Equal(t, "oidc_login", stateCookies[0].Name)
+			assert.Equal(t, callbackPath, stateCookies[0].Path)
+			assert.True(t, stateCookies[0].HttpOnly)
+
+			// Alter code verifier in signed cookie with a valid signature
+			if tt.alterVerifier {
+				ls, err2 := decodeLoginState(loginStateSecret, stateCookies[0].Value)
+				require.NoError(t, err2)
+
+				ls.CodeVerifier = "wrong-verifier-wrong-verifier-wrong-verifier"
+				stateCookies[0].Value, err2 = ls.encode(loginStateSecret)
+				require.NoError(t, err2)
+			}
+
+			// Call provider
+			cl := &http.Client{CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+				return http.ErrUseLastResponse
+			}}
+			resp, err := cl.Get(authURL.String())
+			require.NoError(t, err)
+			resp.Body.Close()
+			require.Equal(t, http.StatusFound, resp.StatusCode)
+
+			// Build callback request
+			cbURL, err := url.Parse(resp.Header.Get("Location"))
+			require.NoError(t, err)
+
+			if tt.alterState {
+				q := cbURL.Query()
+				q.Set("state", "forged")
+				cbURL.RawQuery = q.Encode()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, cbURL.String(), nil)
+			if !tt.removeStateCookie {
+				req.AddCookie(stateCookies[0])
+			}
+
+			// Call callback
+			w = httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+
+			if tt.expectedStatusCode == http.StatusTemporaryRedirect {
+				assert.Equal(t, "http://example.com/fake", w.Header().Get("Location"))
+
+				// Check cookies: login state flushed and auth cookie set
+				cookies := map[string]*http.Cookie{}
+				for _, c := range w.Result().Cookies() { //nolint:bodyclose // Recorder result
+					cookies[c.Name] = c
+				}
+
+				require.Contains(t, cookies, "oidc_login")
+				assert.Empty(t, cookies["oidc_login"].Value)
+				require.Contains(t, cookies, "oidc")
+				assert.NotEmpty(t, cookies["oidc"].Value)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/samber/lo"
 	"golang.org/x/oauth2"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
 	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
@@ -24,13 +25,11 @@ import (
 )
 
 const (
-	callbackPath           = "/auth/oidc/callback"
-	loginPath              = "/auth/oidc"
-	logoutPath             = "/auth/oidc/logout"
-	userContextKeyName     = "USER_CONTEXT_KEY"
-	redirectQueryKey       = "rd"
-	stateRedirectSeparator = ":"
-	stateLength            = 2
+	callbackPath       = "/auth/oidc/callback"
+	loginPath          = "/auth/oidc"
+	logoutPath         = "/auth/oidc/logout"
+	userContextKeyName = "USER_CONTEXT_KEY"
+	redirectQueryKey   = "rd"
 )
 
 var userContextKey = &contextKey{name: userContextKeyName}
@@ -41,8 +40,14 @@ type providerEndpointsClaims struct {
 }
 
 type service struct {
-	verifier   *oidc.IDTokenVerifier
-	cfgManager config.Manager
+	verifier         *oidc.IDTokenVerifier
+	oauthConfig      *oauth2.Config
+	cfgManager       config.Manager
+	apiTokenSvc      APITokenService
+	sessionSvc       SessionService
+	impersonationSvc ImpersonationService
+	trustedIssuers   map[string]*trustedIssuer
+	refreshGroup     singleflight.Group
 }
 
 // GetAuthenticatedUser will get authenticated user in context.
@@ -128,21 +133,69 @@ func (s *service) OIDCEndpoints(router gin.IRouter) error {
 		oauthConfig.ClientSecret = cfg.OIDCAuthentication.ClientSecret.Value
 	}
 
-	// Store state
-	state := cfg.OIDCAuthentication.State
+	// Store state cookie signing secret
+	stateSecret := getLoginStateSecret(cfg.OIDCAuthentication)
+	// Check that secret exists
+	if stateSecret == "" {
+		return errors.New("oidc login state secret must be set to sign login state cookie")
+	}
+	// Store login state cookie name and path (only sent to callback)
+	stateCookieName := loginStateCookieName(cfg.OIDCAuthentication.CookieName)
+	stateCookiePath := mainRedirectURLObject.Path
 
 	// Store provider verifier in map
 	s.verifier = verifier
+	// Store oauth configuration for session token refresh
+	s.oauthConfig = &oauthConfig
+
+	// Load additional trusted issuers
+	err = s.loadTrustedIssuers(ctx, cfg.OIDCAuthentication)
+	// Check error
+	if err != nil {
+		return err
+	}
 
 	// Login mount point
 	router.GET(loginPath, func(c *gin.Context) {
+		// Get logger from request
+		logger := log.GetLoggerFromGin(c)
 		// Get redirect query from query params
 		rdVal := c.Query(redirectQueryKey)
-		// Build new state with redirect value
-		// Same solution as here: https://github.com/oauth2-proxy/oauth2-proxy/blob/3fa42edb7350219d317c4bd47faf5da6192dc70f/oauthproxy.go#L751
-		newState := state + stateRedirectSeparator + rdVal
 
-		c.Redirect(http.StatusFound, oauthConfig.AuthCodeURL(newState))
+		// Generate per login state, nonce and PKCE code verifier
+		ls, err := newLoginState(rdVal)
+		// Check error
+		if err != nil {
+			logger.Error(err)
+			utils.AnswerWithError(c, err)
+
+			return
+		}
+
+		// Encode and sign login state
+		lsValue, err := ls.encode(stateSecret)
+		// Check error
+		if err != nil {
+			logger.Error(err)
+			utils.AnswerWithError(c, err)
+
+			return
+		}
+
+		// Store it in a short-lived cookie
+		http.SetCookie(c.Writer, buildLoginStateCookie(
+			stateCookieName,
+			lsValue,
+			stateCookiePath,
+			cfg.OIDCAuthentication.CookieSecure,
+			ls.ExpiresAt,
+		))
+
+		c.Redirect(http.StatusFound, oauthConfig.AuthCodeURL(
+			ls.State,
+			oauth2.S256ChallengeOption(ls.CodeVerifier),
+			oidc.Nonce(ls.Nonce),
+		))
 		c.Abort()
 	})
 
@@ -164,6 +217,13 @@ func (s *service) OIDCEndpoints(router gin.IRouter) error {
 			rdTo = lgURL.String()
 		}
 
+		// Delete server-side session
+		err := s.deleteSession(c, cfg)
+		// Check error
+		if err != nil {
+			log.GetLoggerFromGin(c).Error(err)
+		}
+
 		// Flush auth cookie
 		flushAuthCookie(c, cfg)
 		// Redirect
@@ -188,25 +248,49 @@ func (s *service) OIDCEndpoints(router gin.IRouter) error {
 			return
 		}
 
-		// Split request query state to get redirect url and original state
-		split := strings.SplitN(reqQueryState, stateRedirectSeparator, stateLength)
-		// Prepare and affect values
-		reqState := split[0]
-		rdVal := ""
-		// Check if length is ok to include a redirect url
-		if len(split) == stateLength {
-			rdVal = split[1]
+		// Get login state cookie
+		lsCookie, err := c.Request.Cookie(stateCookieName)
+		// Check error
+		if err != nil {
+			err = cerrors.NewInvalidInputError("login state cookie not found in request")
+			logger.Error(err)
+			utils.AnswerWithError(c, err)
+
+			return
+		}
+
+		// Login state can only be used once
+		http.SetCookie(c.Writer, buildLoginStateCookie(
+			stateCookieName,
+			"",
+			stateCookiePath,
+			cfg.OIDCAuthentication.CookieSecure,
+			time.Unix(0, 0),
+		))
+
+		// Verify and decode login state
+		ls, err := decodeLoginState(stateSecret, lsCookie.Value)
+		// Check error
+		if err != nil {
+			err = cerrors.NewInvalidInputErrorWithError(err)
+			logger.Error(err)
+			utils.AnswerWithError(c, err)
+
+			return
 		}
 
 		// Check state
-		if reqState != state {
-			err := cerrors.NewInvalidInputError("state did not match")
+		if !ls.matchState(reqQueryState) {
+			err = cerrors.NewInvalidInputError("state did not match")
 			logger.Error(err)
 			utils.AnswerWithError(c, err)
 
 			return
 		}
 
+		// Get redirect url
+		rdVal := ls.RedirectURL
+
 		// Check if rdVal exists and that redirect url value is valid
 		if rdVal != "" {
 			isValid, err := isValidRedirect(rdVal, utils.GetRequestURL(c.Request))
@@ -232,7 +316,7 @@ func (s *service) OIDCEndpoints(router gin.IRouter) error {
 			}
 		}
 
-		oauth2Token, err := oauthConfig.Exchange(ctx, c.Query("code"))
+		oauth2Token, err := oauthConfig.Exchange(ctx, c.Query("code"), oauth2.VerifierOption(ls.CodeVerifier))
 		if err != nil {
 			err = cerrors.NewInternalServerError("failed to exchange token: " + err.Error())
 			logger.Error(err)
@@ -259,6 +343,15 @@ func (s *service) OIDCEndpoints(router gin.IRouter) error {
 			return
 		}
 
+		// Check nonce to prevent ID token replay
+		if !ls.matchNonce(idToken.Nonce) {
+			err = cerrors.NewInvalidInputError("nonce did not match")
+			logger.Error(err)
+			utils.AnswerWithError(c, err)
+
+			return
+		}
+
 		var resp models.OIDCUser
 
 		// Try to open JWT token in order to verify that we can open it
@@ -275,18 +368,46 @@ func (s *service) OIDCEndpoints(router gin.IRouter) error {
 		resp.OriginalToken = rawIDToken
 		// Now, we know that we can open jwt token to get claims
 
-		// Build cookie
-		cookie := &http.Cookie{
-			Expires:  idToken.Expiry,
-			Name:     cfg.OIDCAuthentication.CookieName,
-			Value:    rawIDToken,
-			HttpOnly: true,
-			Secure:   cfg.OIDCAuthentication.CookieSecure,
-			Path:     "/",
-		}
+		// Check if server-side sessions are enabled
+		if isSessionEnabled(cfg) {
+			// Build principal with claim mapping to get the same identifier as in middleware
+			ouser, err := buildOIDCUser(idToken, cfg.OIDCAuthentication.ClaimMapping, config.OIDCPrincipalTypeUser)
+			// Check error
+			if err != nil {
+				logger.Error(err)
+				utils.AnswerWithError(c, err)
+
+				return
+			}
 
-		// Set cookie
-		http.SetCookie(c.Writer, cookie)
+			// Create session and set session cookie
+			err = s.createSession(c, cfg, ouser, &models.SessionTokens{
+				Expiry:       idToken.Expiry,
+				IDToken:      rawIDToken,
+				AccessToken:  oauth2Token.AccessToken,
+				RefreshToken: oauth2Token.RefreshToken,
+			})
+			// Check error
+			if err != nil {
+				logger.Error(err)
+				utils.AnswerWithError(c, err)
+
+				return
+			}
+		} else {
+			// Build cookie
+			cookie := &http.Cookie{
+				Expires:  idToken.Expiry,
+				Name:     cfg.OIDCAuthentication.CookieName,
+				Value:    rawIDToken,
+				HttpOnly: true,
+				Secure:   cfg.OIDCAuthentication.CookieSecure,
+				Path:     "/",
+			}
+
+			// Set cookie
+			http.SetCookie(c.Writer, cookie)
+		}
 
 		// Manage default redirect case
 		if rdVal == "" {
@@ -307,6 +428,14 @@ func (s *service) Middleware(unauthorizedPathRegexList []*regexp.Regexp) gin.Han
 		logger := log.GetLoggerFromGin(c)
 		// Get configuration
 		cfg := s.cfgManager.GetConfig()
+
+		// Check if a personal access token is provided
+		if apiToken := getAPIToken(c.Request); apiToken != "" {
+			s.manageAPITokenAuthentication(c, apiToken)
+
+			return
+		}
+
 		// Get JWT Token from header or cookie
 		jwtContent, err := getJWTToken(logger, c.Request, cfg.OIDCAuthentication.CookieName)
 		// Check if error exists
@@ -328,42 +457,51 @@ func (s *service) Middleware(unauthorizedPathRegexList []*regexp.Regexp) gin.Han
 			return
 		}
 
-		// Parse token
-
-		var ouser models.OIDCUser
-		// Verify token
-		idToken, err := s.verifier.Verify(context.Background(), jwtContent)
-		// Check error
-		if err != nil {
-			logger.Error(errors.WithStack(err))
-			// Flush potential cookie
-			flushAuthCookie(c, cfg)
+		var ouser *models.OIDCUser
+		// Check if it is an opaque session token
+		if isSessionEnabled(cfg) && isSessionToken(jwtContent) {
+			var ok bool
+			// Authenticate with server-side session
+			ouser, ok = s.manageSessionAuthentication(c, cfg, jwtContent, unauthorizedPathRegexList)
+			// Check if request have been answered
+			if !ok {
+				return
+			}
+		} else {
+			// Verify token and build principal
+			ouser, err = s.verifyToken(c.Request.Context(), cfg.OIDCAuthentication, jwtContent)
+			// Check error
+			if err != nil {
+				logger.Error(err)
+				// Flush potential cookie
+				flushAuthCookie(c, cfg)
 
-			redirectOrUnauthorized(c, unauthorizedPathRegexList)
+				redirectOrUnauthorized(c, unauthorizedPathRegexList)
 
-			return
+				return
+			}
 		}
 
-		// Get claims
-		err = idToken.Claims(&ouser)
-		if err != nil {
-			logger.Error(errors.WithStack(err))
-			// Flush potential cookie
-			flushAuthCookie(c, cfg)
-
-			redirectOrUnauthorized(c, unauthorizedPathRegexList)
-
+		// Manage impersonation
+		ouser, ok := s.manageImpersonation(c, ouser)
+		// Check if request have been answered
+		if !ok {
 			return
 		}
 
 		// Create new request with new context
 		c.Request = c.Request.WithContext(
-			SetAuthenticatedUserToContext(c.Request.Context(), &ouser),
+			SetAuthenticatedUserToContext(c.Request.Context(), ouser),
 		)
 		// Add it to gin context
-		SetAuthenticatedUserToGin(c, &ouser)
+		SetAuthenticatedUserToGin(c, ouser)
 
-		logger.Infof("OIDC User authenticated: %s", ouser.GetIdentifier())
+		// Check if it is a service principal
+		if ouser.IsServicePrincipal() {
+			logger.Infof("OIDC service principal authenticated: %s (issuer: %s)", ouser.GetIdentifier(), ouser.Issuer)
+		} else {
+			logger.Infof("OIDC User authenticated: %s", ouser.GetIdentifier())
+		}
 		c.Next()
 	}
 }
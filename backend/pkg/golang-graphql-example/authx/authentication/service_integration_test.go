@@ -16,6 +16,7 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/fakeoidc"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
 	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
@@ -25,14 +26,45 @@ import (
 )
 
 func Test_authentication(t *testing.T) {
+	// Start embedded fake oidc provider with same users and clients as integration realm
+	oidcSvr, err := fakeoidc.NewTestServer(&fakeoidc.Options{
+		Users: []*fakeoidc.User{
+			{
+				Username: "user",
+				Password: "password",
+				Claims: map[string]any{
+					"email":          "sample-user@example.com",
+					"email_verified": true,
+					"family_name":    "User",
+					"given_name":     "Sample",
+					"name":           "Sample User",
+				},
+			},
+		},
+		Clients: []*fakeoidc.Client{
+			{
+				ID:           "client-with-secret",
+				Secret:       "565f78f2-a706-41cd-a1a0-431d7df29443",
+				RedirectURLs: []string{"http://localhost:8080/auth/oidc/callback"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer oidcSvr.Close()
+
+	issuerURL := oidcSvr.IssuerURL()
+
 	fakeMatchingReg := regexp.MustCompile(".*fake")
 	validAuthCfg := &config.OIDCAuthConfig{
-		ClientID:      "client-with-secret",
-		ClientSecret:  &config.CredentialConfig{Value: "565f78f2-a706-41cd-a1a0-431d7df29443"},
-		CookieName:    "oidc",
-		EmailVerified: false,
-		RedirectURL:   "http://localhost:8080/",
-		IssuerURL:     "http://localhost:8088/auth/realms/integration",
+		ClientID:         "client-with-secret",
+		ClientSecret:     &config.CredentialConfig{Value: "565f78f2-a706-41cd-a1a0-431d7df29443"},
+		CookieName:       "oidc",
+		LoginStateSecret: &config.CredentialConfig{Value: "my-secret-login-state-key-with-32-chars"},
+		EmailVerified:    false,
+		RedirectURL:      "http://localhost:8080/",
+		IssuerURL:        issuerURL,
 	}
 	type jwtToken struct {
 		IDToken string `json:"id_token"`
@@ -51,6 +83,7 @@ func Test_authentication(t *testing.T) {
 		checkBody                  bool
 		expectedBody               string
 		expectedHeaders            map[string]string
+		expectedHeaderRegexps      map[string]string
 		expectedUser               *models.OIDCUser
 	}{
 		{
@@ -83,8 +116,9 @@ func Test_authentication(t *testing.T) {
 			inputRequestURL:    "/auth/oidc?rd=http%3A%2F%2Fexample.com%2Ffake",
 			wantErr:            false,
 			expectedStatusCode: 302,
-			expectedHeaders: map[string]string{
-				"Location": "http://localhost:8088/auth/realms/integration/protocol/openid-connect/auth?client_id=client-with-secret&redirect_uri=http%3A%2F%2Flocalhost%3A8080%2Fauth%2Foidc%2Fcallback&response_type=code&state=%3Ahttp%3A%2F%2Fexample.com%2Ffake",
+			expectedHeaderRegexps: map[string]string{
+				"Location":   `^` + regexp.QuoteMeta(issuerURL) + `/authorize\?client_id=client-with-secret&code_challenge=[\w-]+&code_challenge_method=S256&nonce=[\w-]+&redirect_uri=http%3A%2F%2Flocalhost%3A8080%2Fauth%2Foidc%2Fcallback&response_type=code&state=[\w-]+$`,
+				"Set-Cookie": `^oidc_login=[\w-]+\.[\w-]+; Path=/auth/oidc/callback; .*HttpOnly; SameSite=Lax$`,
 			},
 		},
 		{
@@ -144,7 +178,7 @@ func Test_authentication(t *testing.T) {
 				"Content-Type": "application/json; charset=utf-8",
 			},
 			checkBody:    true,
-			expectedBody: "{\"email\":\"sample-user@example.com\", \"email_verified\":true, \"family_name\":\"User\", \"given_name\":\"Sample\", \"name\":\"Sample User\", \"preferred_username\":\"user\"}",
+			expectedBody: "{\"email\":\"sample-user@example.com\", \"email_verified\":true, \"family_name\":\"User\", \"given_name\":\"Sample\", \"name\":\"Sample User\", \"preferred_username\":\"user\", \"principal_type\":\"USER\", \"iss\":\"" + issuerURL + "\", \"sub\":\"\", \"client_id\":\"client-with-secret\"}",
 		},
 	}
 	for _, tt := range tests {
@@ -179,6 +213,8 @@ func Test_authentication(t *testing.T) {
 			// Add a fake endpoint
 			router.GET("/fake", func(c *gin.Context) {
 				us := GetAuthenticatedUserFromGin(c)
+				// Subject is generated by identity provider, ignore it
+				us.Subject = ""
 				c.JSON(http.StatusOK, us)
 			})
 
@@ -196,7 +232,7 @@ func Test_authentication(t *testing.T) {
 				data.Set("grant_type", "password")
 				data.Set("scope", "openid profile email")
 
-				authentUrlStr := "http://localhost:8088/auth/realms/integration/protocol/openid-connect/token"
+				authentUrlStr := issuerURL + "/token"
 
 				clientAuth := &http.Client{}
 				r, err := http.NewRequest("POST", authentUrlStr, strings.NewReader(data.Encode())) // URL-encoded payload
@@ -263,6 +299,12 @@ func Test_authentication(t *testing.T) {
 					}
 				}
 			}
+
+			if tt.expectedHeaderRegexps != nil {
+				for key, val := range tt.expectedHeaderRegexps {
+					assert.Regexp(t, val, w.HeaderMap.Get(key), "header = "+key)
+				}
+			}
 		})
 	}
 }
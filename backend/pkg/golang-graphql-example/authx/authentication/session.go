@@ -0,0 +1,326 @@
+package authentication
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/oauth2"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+const (
+	// Timeout of token refresh shared by concurrent requests of the same session.
+	sessionRefreshTimeout = 30 * time.Second
+	// OAuth2 error code returned by provider when refresh token is invalid, expired or revoked.
+	oauth2InvalidGrantErrorCode = "invalid_grant"
+)
+
+// errNoRefreshToken is returned when session tokens must be refreshed without any refresh token.
+var errNoRefreshToken = errors.New("session tokens expired and no refresh token available")
+
+func (s *service) SetSessionService(sessionSvc SessionService) {
+	s.sessionSvc = sessionSvc
+}
+
+// Check if server-side sessions are enabled.
+func isSessionEnabled(cfg *config.Config) bool {
+	return cfg.OIDCAuthentication != nil && cfg.OIDCAuthentication.Session != nil
+}
+
+// Create a server-side session and set opaque session cookie.
+func (s *service) createSession(
+	c *gin.Context,
+	cfg *config.Config,
+	user *models.OIDCUser,
+	tokens *models.SessionTokens,
+) error {
+	// Check if service is available
+	if s.sessionSvc == nil {
+		return errors.New("session service not available")
+	}
+
+	// Create session
+	token, err := s.sessionSvc.CreateSession(c.Request.Context(), &models.SessionInput{
+		User:      user,
+		Tokens:    tokens,
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
+	})
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Parse max lifetime
+	maxLifetime, err := time.ParseDuration(cfg.OIDCAuthentication.Session.MaxLifetime)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Set cookie
+	http.SetCookie(c.Writer, &http.Cookie{
+		Expires:  time.Now().Add(maxLifetime),
+		Name:     cfg.OIDCAuthentication.CookieName,
+		Value:    token,
+		HttpOnly: true,
+		Secure:   cfg.OIDCAuthentication.CookieSecure,
+		Path:     "/",
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return nil
+}
+
+// Delete server-side session linked to cookie if it exists.
+func (s *service) deleteSession(c *gin.Context, cfg *config.Config) error {
+	// Check if service is available
+	if s.sessionSvc == nil {
+		return nil
+	}
+
+	// Get cookie
+	cookie, err := c.Request.Cookie(cfg.OIDCAuthentication.CookieName)
+	// Check error
+	if err != nil || !isSessionToken(cookie.Value) {
+		return nil //nolint:nilerr // No session cookie => nothing to delete
+	}
+
+	return s.sessionSvc.DeleteSession(c.Request.Context(), cookie.Value)
+}
+
+func (s *service) manageSessionAuthentication(
+	c *gin.Context,
+	cfg *config.Config,
+	token string,
+	unauthorizedPathRegexList []*regexp.Regexp,
+) (*models.OIDCUser, bool) {
+	// Get logger
+	logger := log.GetLoggerFromGin(c)
+
+	// Check if service is available
+	if s.sessionSvc == nil {
+		logger.Error(cerrors.NewUnauthorizedError("session authentication not available"))
+		// Flush cookie
+		flushAuthCookie(c, cfg)
+		redirectOrUnauthorized(c, unauthorizedPathRegexList)
+
+		return nil, false
+	}
+
+	// Get session
+	sess, err := s.sessionSvc.GetSession(c.Request.Context(), token)
+	// Check error
+	if err != nil {
+		logger.Error(err)
+		utils.AnswerWithError(c, err)
+
+		return nil, false
+	}
+	// Check if session doesn't exist anymore (expired or revoked)
+	if sess == nil {
+		logger.Error(cerrors.NewUnauthorizedError("session not found, expired or revoked"))
+		// Flush cookie
+		flushAuthCookie(c, cfg)
+		redirectOrUnauthorized(c, unauthorizedPathRegexList)
+
+		return nil, false
+	}
+
+	// Refresh tokens if needed
+	tokens, err := s.refreshSessionTokensIfNeeded(c.Request.Context(), cfg.OIDCAuthentication, token, sess)
+	// Check if refresh have failed because of a temporary error (provider or network failure)
+	if err != nil && !isSessionRefreshRejected(err) {
+		// Check if current tokens are still valid
+		if sess.IsTokenExpiringBefore(time.Now()) {
+			logger.Error(err)
+			utils.AnswerWithError(c, cerrors.NewInternalServerErrorWithError(err))
+
+			return nil, false
+		}
+
+		// Keep current tokens, refresh will be tried again on next request
+		logger.WithError(err).Warn("session tokens refresh failed, current tokens are kept")
+
+		tokens, err = sess.Tokens, nil
+	}
+	// Check error
+	if err != nil {
+		logger.Error(err)
+		// Session cannot be used anymore
+		err2 := s.sessionSvc.DeleteSession(c.Request.Context(), token)
+		// Check error
+		if err2 != nil {
+			logger.Error(err2)
+		}
+		// Flush cookie
+		flushAuthCookie(c, cfg)
+		redirectOrUnauthorized(c, unauthorizedPathRegexList)
+
+		return nil, false
+	}
+
+	// Verify token and build principal
+	ouser, err := s.verifyToken(c.Request.Context(), cfg.OIDCAuthentication, tokens.IDToken)
+	// Check error
+	if err != nil {
+		logger.Error(err)
+		// Flush cookie
+		flushAuthCookie(c, cfg)
+		redirectOrUnauthorized(c, unauthorizedPathRegexList)
+
+		return nil, false
+	}
+
+	// Save session id
+	ouser.SessionID = sess.ID
+
+	return ouser, true
+}
+
+func (s *service) refreshSessionTokensIfNeeded(
+	ctx context.Context,
+	cfg *config.OIDCAuthConfig,
+	token string,
+	sess *models.Session,
+) (*models.SessionTokens, error) {
+	// Parse refresh before duration
+	refreshBefore, err := time.ParseDuration(cfg.Session.RefreshBefore)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Check if refresh is needed
+	if !sess.IsTokenExpiringBefore(time.Now().Add(refreshBefore)) {
+		return sess.Tokens, nil
+	}
+
+	// Refresh only once for concurrent requests on the same session
+	// as refresh tokens can be rotated by provider.
+	res, err, _ := s.refreshGroup.Do(sess.ID, func() (any, error) {
+		// Refresh is shared by all waiting requests, so it mustn't be cancelled with the first one
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sessionRefreshTimeout)
+		defer cancel()
+
+		tokens, err := s.refreshSessionTokens(ctx, sess)
+		// Check if refresh token have been rejected
+		// Another instance may have refreshed the same session and rotated refresh token at the same time
+		if err != nil && isSessionRefreshRejected(err) {
+			return s.getConcurrentlyRefreshedSessionTokens(ctx, token, sess, err)
+		}
+
+		return tokens, err
+	})
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*models.SessionTokens), nil //nolint:forcetypeassert // Type is ensured by function above
+}
+
+func (s *service) refreshSessionTokens(ctx context.Context, sess *models.Session) (*models.SessionTokens, error) {
+	// Check if oauth configuration is available
+	if s.oauthConfig == nil {
+		return nil, errors.New("oidc endpoints not initialized, cannot refresh tokens")
+	}
+
+	// Check if refresh token exists
+	if sess.Tokens.RefreshToken == "" {
+		return nil, errors.WithStack(errNoRefreshToken)
+	}
+
+	// Refresh tokens
+	oauth2Token, err := s.oauthConfig.TokenSource(ctx, &oauth2.Token{
+		RefreshToken: sess.Tokens.RefreshToken,
+		// Force refresh
+		Expiry: time.Unix(1, 0),
+	}).Token()
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Get new id token
+	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("no id_token field in refreshed token")
+	}
+
+	// Verify id token
+	idToken, err := s.verifier.Verify(ctx, rawIDToken)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	tokens := &models.SessionTokens{
+		Expiry:       idToken.Expiry,
+		IDToken:      rawIDToken,
+		AccessToken:  oauth2Token.AccessToken,
+		RefreshToken: oauth2Token.RefreshToken,
+	}
+	// Keep old refresh token if provider doesn't rotate them
+	if tokens.RefreshToken == "" {
+		tokens.RefreshToken = sess.Tokens.RefreshToken
+	}
+
+	// Save
+	err = s.sessionSvc.UpdateSessionTokens(ctx, sess.ID, tokens)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// Get session tokens saved by another instance that refreshed session concurrently.
+// Refresh error is returned when session tokens haven't been changed since session was read.
+func (s *service) getConcurrentlyRefreshedSessionTokens(
+	ctx context.Context,
+	token string,
+	sess *models.Session,
+	refreshErr error,
+) (*models.SessionTokens, error) {
+	// Read session again
+	cur, err := s.sessionSvc.GetSession(ctx, token)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if tokens haven't been changed
+	if cur == nil || cur.Tokens == nil || cur.Tokens.RefreshToken == sess.Tokens.RefreshToken {
+		return nil, refreshErr
+	}
+
+	return cur.Tokens, nil
+}
+
+// Check if session refresh have been rejected, meaning that session cannot be used anymore.
+// Other errors are considered as temporary.
+func isSessionRefreshRejected(err error) bool {
+	// Check if provider rejected refresh token
+	var rErr *oauth2.RetrieveError
+	if errors.As(err, &rErr) {
+		return rErr.ErrorCode == oauth2InvalidGrantErrorCode
+	}
+
+	return errors.Is(err, errNoRefreshToken)
+}
+
+// Check if value is an opaque session token.
+func isSessionToken(v string) bool {
+	return strings.HasPrefix(v, models.SessionTokenPrefix)
+}
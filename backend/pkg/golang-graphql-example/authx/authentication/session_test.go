@@ -0,0 +1,421 @@
+//go:build unit
+
+package authentication
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/oauth2"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+type fakeSessionService struct {
+	session       *models.Session
+	owner         string
+	updatedTokens *models.SessionTokens
+	deleted       bool
+}
+
+func (*fakeSessionService) CreateSession(_ context.Context, _ *models.SessionInput) (string, error) {
+	return "", nil
+}
+
+func (f *fakeSessionService) GetSession(_ context.Context, _ string) (*models.Session, error) {
+	return f.session, nil
+}
+
+func (f *fakeSessionService) GetLatestSessionForOwner(_ context.Context, owner string) (*models.Session, error) {
+	f.owner = owner
+
+	return f.session, nil
+}
+
+func (f *fakeSessionService) UpdateSessionTokens(_ context.Context, _ string, tokens *models.SessionTokens) error {
+	f.updatedTokens = tokens
+
+	return nil
+}
+
+func (f *fakeSessionService) DeleteSession(_ context.Context, _ string) error {
+	f.deleted = true
+
+	return nil
+}
+
+func Test_service_Middleware_session(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	newIDToken := func(exp time.Time) string {
+		return signTestToken(t, key, map[string]any{
+			"iss":                testIssuer,
+			"sub":                "s",
+			"aud":                "client",
+			"exp":                exp.Unix(),
+			"preferred_username": "user",
+		})
+	}
+
+	validIDToken := newIDToken(time.Now().Add(time.Hour))
+	refreshedIDToken := newIDToken(time.Now().Add(2 * time.Hour))
+
+	// Fake token endpoint for refresh
+	tokenSvr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.Form.Get("grant_type"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		// Manage failures
+		switch r.Form.Get("refresh_token") {
+		case "revoked":
+			w.WriteHeader(http.StatusBadRequest)
+			assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{"error": "invalid_grant"}))
+
+			return
+		case "unavailable":
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		assert.Equal(t, "refresh", r.Form.Get("refresh_token"))
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "new-access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     refreshedIDToken,
+		}))
+	}))
+	defer tokenSvr.Close()
+
+	cfg := &config.Config{
+		OIDCAuthentication: &config.OIDCAuthConfig{
+			CookieName:   "oidc",
+			ClaimMapping: &config.OIDCClaimMappingConfig{Username: "preferred_username"},
+			Session: &config.OIDCSessionConfig{
+				Store:         config.OIDCSessionStoreMemory,
+				MaxLifetime:   "1h",
+				RefreshBefore: "1m",
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		session             *models.Session
+		expectRefresh       bool
+		expectDelete        bool
+		expectedStatusCode  int
+		expectedSessionID   string
+		expectedCookieFlush bool
+	}{
+		{
+			name:                "session not found",
+			expectedStatusCode:  http.StatusUnauthorized,
+			expectedCookieFlush: true,
+		},
+		{
+			name: "valid session",
+			session: &models.Session{
+				ID:     "sess1",
+				Tokens: &models.SessionTokens{Expiry: time.Now().Add(time.Hour), IDToken: validIDToken},
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedSessionID:  "sess1",
+		},
+		{
+			name: "expired tokens are refreshed",
+			session: &models.Session{
+				ID: "sess2",
+				Tokens: &models.SessionTokens{
+					Expiry:       time.Now().Add(-time.Minute),
+					IDToken:      "expired",
+					RefreshToken: "refresh",
+				},
+			},
+			expectRefresh:      true,
+			expectedStatusCode: http.StatusOK,
+			expectedSessionID:  "sess2",
+		},
+		{
+			name: "expired tokens without refresh token",
+			session: &models.Session{
+				ID:     "sess3",
+				Tokens: &models.SessionTokens{Expiry: time.Now().Add(-time.Minute), IDToken: "expired"},
+			},
+			expectDelete:        true,
+			expectedStatusCode:  http.StatusUnauthorized,
+			expectedCookieFlush: true,
+		},
+		{
+			name: "refresh token revoked",
+			session: &models.Session{
+				ID: "sess4",
+				Tokens: &models.SessionTokens{
+					Expiry:       time.Now().Add(-time.Minute),
+					IDToken:      "expired",
+					RefreshToken: "revoked",
+				},
+			},
+			expectDelete:        true,
+			expectedStatusCode:  http.StatusUnauthorized,
+			expectedCookieFlush: true,
+		},
+		{
+			name: "provider unavailable with expired tokens",
+			session: &models.Session{
+				ID: "sess5",
+				Tokens: &models.SessionTokens{
+					Expiry:       time.Now().Add(-time.Minute),
+					IDToken:      "expired",
+					RefreshToken: "unavailable",
+				},
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "provider unavailable with tokens still valid",
+			session: &models.Session{
+				ID: "sess6",
+				Tokens: &models.SessionTokens{
+					Expiry:       time.Now().Add(30 * time.Second),
+					IDToken:      validIDToken,
+					RefreshToken: "unavailable",
+				},
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedSessionID:  "sess6",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cfgManager := cmocks.NewMockManager(ctrl)
+			sessionSvc := &fakeSessionService{session: tt.session}
+
+			cfgManager.EXPECT().GetConfig().AnyTimes().Return(cfg)
+
+			token := models.SessionTokenPrefix + "fake"
+
+			s := &service{
+				cfgManager: cfgManager,
+				sessionSvc: sessionSvc,
+				verifier: oidc.NewVerifier(
+					testIssuer,
+					&oidc.StaticKeySet{PublicKeys: []crypto.PublicKey{key.Public()}},
+					&oidc.Config{ClientID: "client"},
+				),
+				oauthConfig: &oauth2.Config{
+					ClientID: "client",
+					Endpoint: oauth2.Endpoint{TokenURL: tokenSvr.URL, AuthStyle: oauth2.AuthStyleInParams},
+				},
+			}
+
+			// Create router
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				log.SetLoggerToGin(c, log.NewLogger())
+			})
+			router.Use(s.Middleware([]*regexp.Regexp{regexp.MustCompile(".*")}))
+			router.GET("/fake", func(c *gin.Context) {
+				user := GetAuthenticatedUserFromGin(c)
+				c.String(http.StatusOK, user.SessionID)
+			})
+
+			// Create request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/fake", nil)
+			req.AddCookie(&http.Cookie{Name: "oidc", Value: token})
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, tt.expectedSessionID, w.Body.String())
+			}
+
+			if tt.expectedCookieFlush {
+				assert.Contains(t, w.Header().Get("Set-Cookie"), "oidc=;")
+			} else {
+				assert.Empty(t, w.Header().Get("Set-Cookie"))
+			}
+
+			if tt.expectRefresh {
+				require.NotNil(t, sessionSvc.updatedTokens)
+				assert.Equal(t, refreshedIDToken, sessionSvc.updatedTokens.IDToken)
+				assert.Equal(t, "new-access", sessionSvc.updatedTokens.AccessToken)
+				// Refresh token isn't rotated by provider so old one must be kept
+				assert.Equal(t, "refresh", sessionSvc.updatedTokens.RefreshToken)
+			} else {
+				assert.Nil(t, sessionSvc.updatedTokens)
+			}
+
+			// Unusable sessions must be deleted
+			assert.Equal(t, tt.expectDelete, sessionSvc.deleted)
+		})
+	}
+}
+
+func Test_service_refreshSessionTokensIfNeeded_cancelledCaller(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	refreshedIDToken := signTestToken(t, key, map[string]any{
+		"iss": testIssuer,
+		"sub": "s",
+		"aud": "client",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+
+	// Fake token endpoint for refresh
+	tokenSvr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "new-access",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+			"refresh_token": "new-refresh",
+			"id_token":      refreshedIDToken,
+		}))
+	}))
+	defer tokenSvr.Close()
+
+	sessionSvc := &fakeSessionService{}
+	s := &service{
+		sessionSvc: sessionSvc,
+		verifier: oidc.NewVerifier(
+			testIssuer,
+			&oidc.StaticKeySet{PublicKeys: []crypto.PublicKey{key.Public()}},
+			&oidc.Config{ClientID: "client"},
+		),
+		oauthConfig: &oauth2.Config{
+			ClientID: "client",
+			Endpoint: oauth2.Endpoint{TokenURL: tokenSvr.URL, AuthStyle: oauth2.AuthStyleInParams},
+		},
+	}
+
+	// Request that started the shared refresh is already gone
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tokens, err := s.refreshSessionTokensIfNeeded(
+		ctx,
+		&config.OIDCAuthConfig{Session: &config.OIDCSessionConfig{RefreshBefore: "1m"}},
+		models.SessionTokenPrefix+"fake",
+		&models.Session{
+			ID:     "sess",
+			Tokens: &models.SessionTokens{Expiry: time.Now().Add(-time.Minute), RefreshToken: "refresh"},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, refreshedIDToken, tokens.IDToken)
+	assert.Equal(t, "new-refresh", tokens.RefreshToken)
+	assert.Equal(t, tokens, sessionSvc.updatedTokens)
+}
+
+func Test_service_refreshSessionTokensIfNeeded_concurrentRefresh(t *testing.T) {
+	// Fake token endpoint rejecting refresh token already rotated by another instance
+	tokenSvr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{"error": "invalid_grant"}))
+	}))
+	defer tokenSvr.Close()
+
+	sess := &models.Session{
+		ID:     "sess",
+		Tokens: &models.SessionTokens{Expiry: time.Now().Add(-time.Minute), RefreshToken: "refresh"},
+	}
+	rotatedTokens := &models.SessionTokens{
+		Expiry:       time.Now().Add(time.Hour),
+		IDToken:      "rotated-id",
+		RefreshToken: "rotated-refresh",
+	}
+
+	tests := []struct {
+		name           string
+		storedSession  *models.Session
+		expectedTokens *models.SessionTokens
+		wantRejected   bool
+	}{
+		{
+			name:           "session refreshed by another instance",
+			storedSession:  &models.Session{ID: "sess", Tokens: rotatedTokens},
+			expectedTokens: rotatedTokens,
+		},
+		{
+			name:          "session tokens not changed",
+			storedSession: sess,
+			wantRejected:  true,
+		},
+		{
+			name:         "session deleted",
+			wantRejected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionSvc := &fakeSessionService{session: tt.storedSession}
+			s := &service{
+				sessionSvc: sessionSvc,
+				oauthConfig: &oauth2.Config{
+					ClientID: "client",
+					Endpoint: oauth2.Endpoint{TokenURL: tokenSvr.URL, AuthStyle: oauth2.AuthStyleInParams},
+				},
+			}
+
+			tokens, err := s.refreshSessionTokensIfNeeded(
+				context.TODO(),
+				&config.OIDCAuthConfig{Session: &config.OIDCSessionConfig{RefreshBefore: "1m"}},
+				models.SessionTokenPrefix+"fake",
+				sess,
+			)
+			if tt.wantRejected {
+				require.Error(t, err)
+				assert.True(t, isSessionRefreshRejected(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTokens, tokens)
+			// Tokens saved by other instance mustn't be saved again
+			assert.Nil(t, sessionSvc.updatedTokens)
+		})
+	}
+}
+
+func Test_isSessionRefreshRejected(t *testing.T) {
+	assert.True(t, isSessionRefreshRejected(errors.WithStack(&oauth2.RetrieveError{ErrorCode: "invalid_grant"})))
+	assert.True(t, isSessionRefreshRejected(errors.WithStack(errNoRefreshToken)))
+	assert.False(t, isSessionRefreshRejected(errors.WithStack(&oauth2.RetrieveError{ErrorCode: "temporarily_unavailable"})))
+	assert.False(t, isSessionRefreshRejected(errors.New("connection refused")))
+	assert.False(t, isSessionRefreshRejected(context.DeadlineExceeded))
+}
+
+func Test_isSessionToken(t *testing.T) {
+	assert.True(t, isSessionToken(models.SessionTokenPrefix+"value"))
+	assert.False(t, isSessionToken("eyJhbGciOiJSUzI1NiJ9.payload.signature"))
+	assert.False(t, isSessionToken(""))
+}
@@ -0,0 +1,74 @@
+package authorization
+
+import (
+	"sync"
+	"time"
+)
+
+// circuitBreaker will stop calling OPA server after a number of consecutive failures.
+// When open, a single probe request is allowed after the open duration to close it again.
+type circuitBreaker struct {
+	openedAt         time.Time
+	openDuration     time.Duration
+	failureThreshold int
+	failures         int
+	probing          bool
+	mutex            sync.Mutex
+}
+
+func newCircuitBreaker(failureThreshold int, openDuration time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: failureThreshold,
+		openDuration:     openDuration,
+	}
+}
+
+// allow will return true if a request can be sent.
+func (cb *circuitBreaker) allow() bool {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	// Check if circuit is closed
+	if cb.failureThreshold <= 0 || cb.failures < cb.failureThreshold {
+		return true
+	}
+
+	// Circuit is open, allow only one probe after open duration
+	if !cb.probing && time.Since(cb.openedAt) >= cb.openDuration {
+		cb.probing = true
+
+		return true
+	}
+
+	return false
+}
+
+func (cb *circuitBreaker) success() {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	cb.failures = 0
+	cb.probing = false
+}
+
+// release will end a probe request without saving a success or a failure.
+// It is used when caller is gone before OPA server answered, so another probe can be sent.
+func (cb *circuitBreaker) release() {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	cb.probing = false
+}
+
+func (cb *circuitBreaker) failure() {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	cb.failures++
+	cb.probing = false
+
+	// Open (or re-open) circuit
+	if cb.failureThreshold > 0 && cb.failures >= cb.failureThreshold {
+		cb.openedAt = time.Now()
+	}
+}
@@ -0,0 +1,97 @@
+package authorization
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+)
+
+const requestDecisionCacheContextKeyName = "REQUEST_DECISION_CACHE_CONTEXT_KEY"
+
+var requestDecisionCacheContextKey = &contextKey{name: requestDecisionCacheContextKeyName}
+
+// requestDecisionCache stores decisions for the duration of a single request.
+type requestDecisionCache struct {
+	decisions map[string]bool
+	mutex     sync.RWMutex
+}
+
+// DecisionCacheMiddleware will add a per request decision cache in request context.
+func DecisionCacheMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Add cache in request context
+		c.Request = c.Request.WithContext(SetRequestDecisionCacheToContext(c.Request.Context()))
+
+		c.Next()
+	}
+}
+
+// SetRequestDecisionCacheToContext will add a new per request decision cache in context.
+func SetRequestDecisionCacheToContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestDecisionCacheContextKey, &requestDecisionCache{
+		decisions: map[string]bool{},
+	})
+}
+
+func getRequestDecisionCacheFromContext(ctx context.Context) *requestDecisionCache {
+	// Get value from context
+	res, _ := ctx.Value(requestDecisionCacheContextKey).(*requestDecisionCache)
+
+	return res
+}
+
+func (c *requestDecisionCache) get(key string) (bool, bool) {
+	// Ignore when cache isn't available
+	if c == nil {
+		return false, false
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	res, ok := c.decisions[key]
+
+	return res, ok
+}
+
+func (c *requestDecisionCache) set(key string, value bool) {
+	// Ignore when cache isn't available
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.decisions[key] = value
+}
+
+// decisionCachePrincipalKey will generate the principal part of cache keys.
+// It is a hash of the user sent to OPA server, so any claim change (roles, groups, api token scopes,
+// impersonation, ...) will lead to a new decision.
+func decisionCachePrincipalKey(user *models.OIDCUser) (string, error) {
+	// Json encode user
+	b, err := json.Marshal(user)
+	// Check error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	// Hash
+	h := sha256.Sum256(b)
+
+	return hex.EncodeToString(h[:]), nil
+}
+
+// decisionCacheKey will generate a cache key for principal, action and resource.
+func decisionCacheKey(principalKey string, check *models.AuthorizationCheck) string {
+	return strings.Join([]string{principalKey, check.Action, check.Resource}, "\x00")
+}
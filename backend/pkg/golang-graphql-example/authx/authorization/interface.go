@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
 )
 
@@ -16,6 +17,13 @@ type Service interface {
 	IsAuthorized(ctx context.Context, action, resource string) (bool, error)
 	// Check authorized and fail if not authorized
 	CheckAuthorized(ctx context.Context, action, resource string) error
+	// Check multiple authorizations at once (one OPA request when batch url is configured).
+	// Results are in the same order as checks.
+	AreAuthorized(ctx context.Context, checks []*models.AuthorizationCheck) ([]bool, error)
+	// Get row level constraints for an action using OPA partial evaluation
+	GetRowFilter(ctx context.Context, action string) (*models.RowFilter, error)
+	// Initialize and reload built-in RBAC engine, OPA client and decision cache from configuration
+	InitializeAndReload() error
 }
 
 func NewService(cfgManager config.Manager) Service {
@@ -65,14 +65,31 @@ func (s *service) Middleware() gin.HandlerFunc {
 
 		// User is authorized
 
-		logger.Infof("OIDC user %s authorized", ouser.GetIdentifier())
+		logger.Infof("OIDC user %s authorized", getUserIdentifier(ouser))
 		c.Next()
 	}
 }
 
 func (s *service) isRequestAuthorized(req *http.Request, oidcUser *models.OIDCUser) (bool, error) {
+	// Get runtime objects
+	rbac, opaCl, _ := s.getRuntime()
+	// Check if built-in rbac engine is enabled
+	if rbac != nil {
+		// Action is the http method and resource is the path (e.g. "http:GET" on "http:/api/todos")
+		return rbac.isAuthorized(
+			oidcUser,
+			rbacHTTPActionPrefix+req.Method,
+			rbacHTTPActionPrefix+req.URL.Path,
+		), nil
+	}
+
 	// Get configuration
 	opaServerCfg := s.cfgManager.GetConfig().OPAServerAuthorization
+	// Check if configuration is empty
+	if opaServerCfg == nil {
+		// Configuration doesn't exists, authorization is given
+		return true, nil
+	}
 
 	// Transform headers into map
 	headers := make(map[string]string)
@@ -109,7 +126,21 @@ func (s *service) isRequestAuthorized(req *http.Request, oidcUser *models.OIDCUs
 		return false, goerrors.WithStack(err)
 	}
 
-	return s.requestOPAServer(req.Context(), opaServerCfg, bb)
+	// Check that OPA client is initialized
+	if opaCl == nil {
+		return false, goerrors.New("opa server client not initialized")
+	}
+
+	// Prepare answer
+	var answer opaAnswer
+	// Request
+	err = opaCl.request(req.Context(), opaServerCfg.URL, bb, &answer)
+	// Check error
+	if err != nil {
+		return false, err
+	}
+
+	return answer.Result, nil
 }
 
 func deleteEmpty(s []string) []string {
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	gin "github.com/gin-gonic/gin"
+	models "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,6 +42,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AreAuthorized mocks base method.
+func (m *MockService) AreAuthorized(ctx context.Context, checks []*models.AuthorizationCheck) ([]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AreAuthorized", ctx, checks)
+	ret0, _ := ret[0].([]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AreAuthorized indicates an expected call of AreAuthorized.
+func (mr *MockServiceMockRecorder) AreAuthorized(ctx, checks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AreAuthorized", reflect.TypeOf((*MockService)(nil).AreAuthorized), ctx, checks)
+}
+
 // CheckAuthorized mocks base method.
 func (m *MockService) CheckAuthorized(ctx context.Context, action, resource string) error {
 	m.ctrl.T.Helper()
@@ -55,6 +71,35 @@ func (mr *MockServiceMockRecorder) CheckAuthorized(ctx, action, resource any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAuthorized", reflect.TypeOf((*MockService)(nil).CheckAuthorized), ctx, action, resource)
 }
 
+// GetRowFilter mocks base method.
+func (m *MockService) GetRowFilter(ctx context.Context, action string) (*models.RowFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRowFilter", ctx, action)
+	ret0, _ := ret[0].(*models.RowFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRowFilter indicates an expected call of GetRowFilter.
+func (mr *MockServiceMockRecorder) GetRowFilter(ctx, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRowFilter", reflect.TypeOf((*MockService)(nil).GetRowFilter), ctx, action)
+}
+
+// InitializeAndReload mocks base method.
+func (m *MockService) InitializeAndReload() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitializeAndReload")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InitializeAndReload indicates an expected call of InitializeAndReload.
+func (mr *MockServiceMockRecorder) InitializeAndReload() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeAndReload", reflect.TypeOf((*MockService)(nil).InitializeAndReload))
+}
+
 // IsAuthorized mocks base method.
 func (m *MockService) IsAuthorized(ctx context.Context, action, resource string) (bool, error) {
 	m.ctrl.T.Helper()
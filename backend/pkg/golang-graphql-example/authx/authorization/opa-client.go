@@ -0,0 +1,167 @@
+package authorization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/tracing"
+)
+
+// ErrOPACircuitOpen is returned when OPA server isn't called because of too many consecutive failures.
+var ErrOPACircuitOpen = errors.New("opa server circuit breaker is open")
+
+type opaClient struct {
+	httpClient        *http.Client
+	breaker           *circuitBreaker
+	retryCount        int
+	retryWaitDuration time.Duration
+}
+
+// opaRetryableError represents an error that can be retried (network or server errors).
+type opaRetryableError struct {
+	err error
+}
+
+func (e *opaRetryableError) Error() string { return e.err.Error() }
+
+func (e *opaRetryableError) Unwrap() error { return e.err }
+
+func newOPAClient(cfg *config.OPAServerAuthorization) (*opaClient, error) {
+	// Parse timeout
+	timeout, err := time.ParseDuration(cfg.Timeout)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Parse retry wait duration
+	retryWaitDuration, err := time.ParseDuration(cfg.RetryWaitDuration)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Parse circuit breaker open duration
+	openDuration, err := time.ParseDuration(cfg.CircuitBreaker.OpenDuration)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &opaClient{
+		httpClient:        &http.Client{Timeout: timeout},
+		breaker:           newCircuitBreaker(cfg.CircuitBreaker.FailureThreshold, openDuration),
+		retryCount:        cfg.RetryCount,
+		retryWaitDuration: retryWaitDuration,
+	}, nil
+}
+
+func (c *opaClient) request(ctx context.Context, url string, body []byte, out any) (err error) {
+	// Get trace from context
+	trace := tracing.GetTraceFromContext(ctx)
+	// Generate child trace
+	ctx, childTrace := trace.GetChildTrace(ctx, "opa-server.request")
+
+	defer func() {
+		// Check error
+		if err != nil {
+			childTrace.MarkAsError()
+		}
+
+		childTrace.Finish()
+	}()
+	// Add data
+	childTrace.SetTag("opa.uri", url)
+
+	// Loop over tries
+	for i := 0; ; i++ {
+		// Check circuit breaker
+		if !c.breaker.allow() {
+			return errors.WithStack(ErrOPACircuitOpen)
+		}
+
+		// Send request
+		err = c.send(ctx, childTrace, url, body, out)
+		// Check if error can be retried
+		var rErr *opaRetryableError
+		if !errors.As(err, &rErr) {
+			// Success or non retryable error means OPA server is up
+			c.breaker.success()
+
+			return err
+		}
+
+		// Check if caller context is cancelled or its deadline is exceeded
+		// This isn't an OPA server failure, so circuit breaker state isn't changed
+		// but probe request slot is released
+		if ctx.Err() != nil {
+			c.breaker.release()
+
+			return err
+		}
+
+		// Save failure
+		c.breaker.failure()
+
+		// Check if maximum retries is reached
+		if i >= c.retryCount {
+			return err
+		}
+
+		// Wait before retry
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-time.After(c.retryWaitDuration):
+		}
+	}
+}
+
+func (c *opaClient) send(ctx context.Context, childTrace tracing.Trace, url string, body []byte, out any) error {
+	// Change NewRequest to NewRequestWithContext and pass context it
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// Add content type
+	req.Header.Add("Content-Type", "application/json")
+	// Forward trace on request
+	childTrace.InjectInHTTPHeader(req.Header)
+	// Making request to OPA server
+	resp, err := c.httpClient.Do(req)
+	// Check error
+	if err != nil {
+		return errors.WithStack(&opaRetryableError{err: err})
+	}
+	// Defer closing body
+	defer resp.Body.Close()
+
+	// Check server errors
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return errors.WithStack(&opaRetryableError{
+			err: fmt.Errorf("opa server answered with status code %d", resp.StatusCode), //nolint:err113
+		})
+	}
+
+	// Check other errors
+	if resp.StatusCode >= http.StatusBadRequest {
+		return errors.Errorf("opa server answered with status code %d", resp.StatusCode)
+	}
+
+	// Decode answer
+	err = json.NewDecoder(resp.Body).Decode(out)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package authorization
+
+import (
+	"regexp"
+	"strings"
+
+	goerrors "emperror.dev/errors"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+// RBAC http action prefix used by middleware.
+const rbacHTTPActionPrefix = "http:"
+
+type rbacEngine struct {
+	rules []*rbacRule
+}
+
+type rbacRule struct {
+	actions   []*regexp.Regexp
+	resources []*regexp.Regexp
+	roles     []*regexp.Regexp
+	groups    []*regexp.Regexp
+	deny      bool
+}
+
+func newRBACEngine(cfg *config.RBACAuthorizationConfig) (*rbacEngine, error) {
+	// Initialize
+	res := &rbacEngine{}
+
+	// Loop over rules
+	for _, r := range cfg.Rules {
+		// Check if it exists
+		if r == nil {
+			continue
+		}
+
+		rule := &rbacRule{deny: r.Effect == config.RBACRuleEffectDeny}
+
+		var err error
+		// Compile all patterns
+		rule.actions, err = compileRBACPatterns(r.Actions)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		rule.resources, err = compileRBACPatterns(r.Resources)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		rule.roles, err = compileRBACPatterns(r.Roles)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		rule.groups, err = compileRBACPatterns(r.Groups)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		// Save
+		res.rules = append(res.rules, rule)
+	}
+
+	return res, nil
+}
+
+// isAuthorized will return true when at least one allow rule matches and no deny rule matches.
+func (e *rbacEngine) isAuthorized(user *models.OIDCUser, action, resource string) bool {
+	// Initialize
+	allowed := false
+
+	// Loop over rules
+	for _, r := range e.rules {
+		// Check if rule matches
+		if !r.matches(user, action, resource) {
+			continue
+		}
+
+		// Deny rules always win
+		if r.deny {
+			return false
+		}
+
+		allowed = true
+	}
+
+	return allowed
+}
+
+func (r *rbacRule) matches(user *models.OIDCUser, action, resource string) bool {
+	// Check action and resource
+	if !matchAnyRBACPattern(r.actions, action) || !matchAnyRBACPattern(r.resources, resource) {
+		return false
+	}
+
+	// No user means no role or group
+	if user == nil {
+		return false
+	}
+
+	// Check roles
+	for _, role := range user.Roles {
+		if matchAnyRBACPattern(r.roles, role) {
+			return true
+		}
+	}
+
+	// Check groups
+	for _, group := range user.Groups {
+		if matchAnyRBACPattern(r.groups, group) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchAnyRBACPattern(patterns []*regexp.Regexp, value string) bool {
+	for _, p := range patterns {
+		if p.MatchString(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compileRBACPatterns will transform patterns where "*" matches any characters into regexps.
+func compileRBACPatterns(patterns []string) ([]*regexp.Regexp, error) {
+	res := make([]*regexp.Regexp, 0, len(patterns))
+
+	for _, p := range patterns {
+		// Quote and replace wildcards
+		expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(p), `\*`, ".*") + "$"
+		// Compile
+		r, err := regexp.Compile(expr)
+		// Check error
+		if err != nil {
+			return nil, goerrors.WithStack(err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
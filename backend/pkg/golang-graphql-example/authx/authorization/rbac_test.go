@@ -0,0 +1,197 @@
+//go:build unit
+
+package authorization
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+func Test_rbacEngine_isAuthorized(t *testing.T) {
+	engine, err := newRBACEngine(&config.RBACAuthorizationConfig{
+		Rules: []*config.RBACRuleConfig{
+			{Effect: "ALLOW", Actions: []string{"todo:*"}, Resources: []string{"*"}, Roles: []string{"admin"}},
+			{Effect: "ALLOW", Actions: []string{"todo:Get", "todo:List"}, Resources: []string{"*"}, Groups: []string{"readers"}},
+			{Effect: "ALLOW", Actions: []string{"todo:Close"}, Resources: []string{"todo:1"}, Groups: []string{"team-*"}},
+			{Effect: "DENY", Actions: []string{"todo:Close"}, Resources: []string{"todo:*"}, Groups: []string{"interns"}},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		user     *models.OIDCUser
+		action   string
+		resource string
+		want     bool
+	}{
+		{
+			name:     "no user",
+			action:   "todo:Get",
+			resource: "todo:1",
+			want:     false,
+		},
+		{
+			name:     "admin role with wildcard action",
+			user:     &models.OIDCUser{Roles: []string{"admin"}},
+			action:   "todo:Close",
+			resource: "todo:1",
+			want:     true,
+		},
+		{
+			name:     "reader group with empty resource",
+			user:     &models.OIDCUser{Groups: []string{"readers"}},
+			action:   "todo:List",
+			resource: "",
+			want:     true,
+		},
+		{
+			name:     "reader group not allowed action",
+			user:     &models.OIDCUser{Groups: []string{"readers"}},
+			action:   "todo:Create",
+			resource: "",
+			want:     false,
+		},
+		{
+			name:     "group pattern on exact resource",
+			user:     &models.OIDCUser{Groups: []string{"team-a"}},
+			action:   "todo:Close",
+			resource: "todo:1",
+			want:     true,
+		},
+		{
+			name:     "group pattern on other resource",
+			user:     &models.OIDCUser{Groups: []string{"team-a"}},
+			action:   "todo:Close",
+			resource: "todo:2",
+			want:     false,
+		},
+		{
+			name:     "deny rule wins",
+			user:     &models.OIDCUser{Roles: []string{"admin"}, Groups: []string{"interns"}},
+			action:   "todo:Close",
+			resource: "todo:1",
+			want:     false,
+		},
+		{
+			name:     "pattern special characters are quoted",
+			user:     &models.OIDCUser{Roles: []string{"admin"}},
+			action:   "todoxClose",
+			resource: "todo:1",
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, engine.isAuthorized(tt.user, tt.action, tt.resource))
+		})
+	}
+}
+
+func Test_service_RBAC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cfgManager := cmocks.NewMockManager(ctrl)
+
+	cfg := &config.Config{
+		RBACAuthorization: &config.RBACAuthorizationConfig{
+			Rules: []*config.RBACRuleConfig{
+				{Effect: "ALLOW", Actions: []string{"todo:Create", "http:GET"}, Resources: []string{"*"}, Roles: []string{"writer"}},
+			},
+		},
+	}
+	cfgManager.EXPECT().GetConfig().AnyTimes().DoAndReturn(func() *config.Config { return cfg })
+
+	s := &service{cfgManager: cfgManager}
+	require.NoError(t, s.InitializeAndReload())
+
+	user := &models.OIDCUser{PreferredUsername: "user", Roles: []string{"writer"}}
+	ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+	ctx = authentication.SetAuthenticatedUserToContext(ctx, user)
+
+	res, err := s.IsAuthorized(ctx, "todo:Create", "")
+	require.NoError(t, err)
+	assert.True(t, res)
+
+	res, err = s.IsAuthorized(ctx, "todo:Close", "todo:1")
+	require.NoError(t, err)
+	assert.False(t, res)
+
+	// Middleware
+	authorized, err := s.isRequestAuthorized(httptest.NewRequest(http.MethodGet, "/api/todos", nil), user)
+	require.NoError(t, err)
+	assert.True(t, authorized)
+
+	authorized, err = s.isRequestAuthorized(httptest.NewRequest(http.MethodDelete, "/api/todos", nil), user)
+	require.NoError(t, err)
+	assert.False(t, authorized)
+
+	// Reload with new rules
+	cfg = &config.Config{
+		RBACAuthorization: &config.RBACAuthorizationConfig{
+			Rules: []*config.RBACRuleConfig{
+				{Effect: "ALLOW", Actions: []string{"todo:*"}, Resources: []string{"*"}, Roles: []string{"writer"}},
+			},
+		},
+	}
+	require.NoError(t, s.InitializeAndReload())
+
+	res, err = s.IsAuthorized(ctx, "todo:Close", "todo:1")
+	require.NoError(t, err)
+	assert.True(t, res)
+
+	// Disable rbac without opa means everything is allowed
+	cfg = &config.Config{}
+	require.NoError(t, s.InitializeAndReload())
+
+	res, err = s.IsAuthorized(ctx, "todo:Delete", "todo:1")
+	require.NoError(t, err)
+	assert.True(t, res)
+}
+
+func Test_service_RBAC_WithoutAuthenticatedUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cfgManager := cmocks.NewMockManager(ctrl)
+
+	cfgManager.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+		RBACAuthorization: &config.RBACAuthorizationConfig{
+			Rules: []*config.RBACRuleConfig{
+				{Effect: "ALLOW", Actions: []string{"todo:*"}, Resources: []string{"*"}, Roles: []string{"writer"}},
+			},
+		},
+	})
+
+	s := &service{cfgManager: cfgManager}
+	require.NoError(t, s.InitializeAndReload())
+
+	// No user in context (rbac authorization enabled without oidc authentication)
+	ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+
+	res, err := s.AreAuthorized(ctx, []*models.AuthorizationCheck{
+		{Action: "todo:Create", Resource: ""},
+		{Action: "todo:Close", Resource: "todo:1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false, false}, res)
+
+	// Middleware
+	authorized, err := s.isRequestAuthorized(httptest.NewRequest(http.MethodGet, "/api/todos", nil), nil)
+	require.NoError(t, err)
+	assert.False(t, authorized)
+}
+
+func Test_getUserIdentifier(t *testing.T) {
+	assert.Equal(t, "anonymous", getUserIdentifier(nil))
+	assert.Equal(t, "user", getUserIdentifier(&models.OIDCUser{PreferredUsername: "user"}))
+}
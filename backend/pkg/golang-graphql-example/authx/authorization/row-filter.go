@@ -0,0 +1,511 @@
+package authorization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+
+	goerrors "emperror.dev/errors"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+)
+
+// Unknown input path used for partial evaluation.
+// Policies must use "input.resource.<column>" to define row level constraints.
+const (
+	rowFilterUnknown  = "input.resource"
+	rowFilterInputVar = "input"
+	rowFilterResource = "resource"
+)
+
+// OPA operators supported in residual queries.
+const (
+	opaOperatorEq         = "eq"
+	opaOperatorEqual      = "equal"
+	opaOperatorNeq        = "neq"
+	opaOperatorGt         = "gt"
+	opaOperatorGte        = "gte"
+	opaOperatorLt         = "lt"
+	opaOperatorLte        = "lte"
+	opaOperatorStartsWith = "startswith"
+	opaOperatorEndsWith   = "endswith"
+	opaOperatorContains   = "contains"
+	opaOperatorMember     = "internal.member_2"
+)
+
+// OPA term types.
+const (
+	opaTermTypeRef     = "ref"
+	opaTermTypeVar     = "var"
+	opaTermTypeString  = "string"
+	opaTermTypeNumber  = "number"
+	opaTermTypeBoolean = "boolean"
+	opaTermTypeNull    = "null"
+	opaTermTypeArray   = "array"
+	opaTermTypeSet     = "set"
+)
+
+// ErrUnsupportedResidualPolicy is returned when OPA partial evaluation result cannot be transformed in filters.
+var ErrUnsupportedResidualPolicy = goerrors.New("unsupported residual policy")
+
+type opaCompileInput struct {
+	Input    *generalInputDataOPA `json:"input"`
+	Query    string               `json:"query"`
+	Unknowns []string             `json:"unknowns"`
+}
+
+type opaCompileAnswer struct {
+	Result *opaCompileResult `json:"result"`
+}
+
+type opaCompileResult struct {
+	Queries [][]*opaExpression `json:"queries"`
+	Support []json.RawMessage  `json:"support"`
+}
+
+type opaExpression struct {
+	Terms   json.RawMessage `json:"terms"`
+	Negated bool            `json:"negated"`
+}
+
+type opaTerm struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+func (s *service) GetRowFilter(ctx context.Context, action string) (*models.RowFilter, error) {
+	// Get user from context
+	user := authentication.GetAuthenticatedUserFromContext(ctx)
+
+	// Check personal access token scopes first
+	if user != nil && !user.IsActionAllowedByAPIToken(action) {
+		return &models.RowFilter{}, nil
+	}
+
+	// Get runtime objects
+	rbac, opaCl, _ := s.getRuntime()
+	// Get configuration
+	cfg := s.cfgManager.GetConfig().OPAServerAuthorization
+
+	// Check if row level authorization is enabled
+	// Built-in rbac engine doesn't support it
+	if rbac != nil || cfg == nil || cfg.CompileURL == "" {
+		return &models.RowFilter{AllowAll: true}, nil
+	}
+
+	// Check that OPA client is initialized
+	if opaCl == nil {
+		return nil, goerrors.New("opa server client not initialized")
+	}
+
+	// Create opa input
+	input := &opaCompileInput{
+		Query:    cfg.CompileQuery,
+		Unknowns: []string{rowFilterUnknown},
+		Input: &generalInputDataOPA{
+			User: user,
+			Tags: cfg.Tags,
+			Data: &generalDataOPA{Action: action},
+		},
+	}
+	// Json encode body
+	bb, err := json.Marshal(input)
+	if err != nil {
+		return nil, goerrors.WithStack(err)
+	}
+
+	// Prepare answer
+	var answer opaCompileAnswer
+	// Request
+	err = opaCl.request(ctx, cfg.CompileURL, bb, &answer)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return residualToRowFilter(answer.Result)
+}
+
+// residualToRowFilter will transform OPA partial evaluation result into row filter.
+func residualToRowFilter(res *opaCompileResult) (*models.RowFilter, error) {
+	// No result or no query means that policy is never true
+	if res == nil || len(res.Queries) == 0 {
+		return &models.RowFilter{}, nil
+	}
+
+	// Support modules can't be transformed
+	if len(res.Support) != 0 {
+		return nil, goerrors.Wrap(ErrUnsupportedResidualPolicy, "support modules aren't supported")
+	}
+
+	// Initialize
+	out := &models.RowFilter{}
+
+	// Loop over queries (OR)
+	for _, q := range res.Queries {
+		// Empty query means that policy is always true
+		if len(q) == 0 {
+			return &models.RowFilter{AllowAll: true}, nil
+		}
+
+		conditions := make([]*models.RowFilterCondition, 0, len(q))
+
+		// Loop over expressions (AND)
+		for _, expr := range q {
+			// Transform expression
+			cond, err := expressionToCondition(expr)
+			// Check error
+			if err != nil {
+				return nil, err
+			}
+
+			conditions = append(conditions, cond)
+		}
+
+		out.Conditions = append(out.Conditions, conditions)
+	}
+
+	return out, nil
+}
+
+func expressionToCondition(expr *opaExpression) (*models.RowFilterCondition, error) {
+	// Check if expression is a single term (e.g. "input.resource.done")
+	if bytes.HasPrefix(bytes.TrimSpace(expr.Terms), []byte("{")) {
+		var term opaTerm
+		// Decode
+		err := json.Unmarshal(expr.Terms, &term)
+		// Check error
+		if err != nil {
+			return nil, goerrors.WithStack(err)
+		}
+
+		// Get field
+		field, ok, err := getResourceField(&term)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Check if it is a resource field
+		if !ok {
+			return nil, goerrors.Wrap(ErrUnsupportedResidualPolicy, "single term must be a resource field")
+		}
+
+		// Build filter
+		filter, err := buildGenericFilter(opaOperatorEq, expr.Negated, true)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		return &models.RowFilterCondition{Field: field, Filter: filter}, nil
+	}
+
+	var terms []*opaTerm
+	// Decode
+	err := json.Unmarshal(expr.Terms, &terms)
+	// Check error
+	if err != nil {
+		return nil, goerrors.WithStack(err)
+	}
+
+	// Check terms length (operator and 2 operands)
+	if len(terms) != 3 { //nolint:mnd
+		return nil, goerrors.Wrapf(ErrUnsupportedResidualPolicy, "expression with %d terms", len(terms))
+	}
+
+	// Get operator
+	op, err := getOperatorName(terms[0])
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get field and value
+	field, valueTerm, reversed, err := getFieldAndValueTerms(terms[1], terms[2])
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Reversed expression are only supported for comparison operators
+	if reversed {
+		switch op {
+		case opaOperatorGt:
+			op = opaOperatorLt
+		case opaOperatorGte:
+			op = opaOperatorLte
+		case opaOperatorLt:
+			op = opaOperatorGt
+		case opaOperatorLte:
+			op = opaOperatorGte
+		case opaOperatorEq, opaOperatorEqual, opaOperatorNeq:
+		default:
+			return nil, goerrors.Wrapf(ErrUnsupportedResidualPolicy, "resource field must be first argument of %s", op)
+		}
+	}
+
+	// Get value
+	value, err := getTermValue(valueTerm)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Build filter
+	filter, err := buildGenericFilter(op, expr.Negated, value)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.RowFilterCondition{Field: field, Filter: filter}, nil
+}
+
+func getFieldAndValueTerms(t1, t2 *opaTerm) (string, *opaTerm, bool, error) {
+	// Check first term
+	field, ok, err := getResourceField(t1)
+	// Check error
+	if err != nil {
+		return "", nil, false, err
+	}
+	// Check if found
+	if ok {
+		return field, t2, false, nil
+	}
+
+	// Check second term
+	field, ok, err = getResourceField(t2)
+	// Check error
+	if err != nil {
+		return "", nil, false, err
+	}
+	// Check if found
+	if ok {
+		return field, t1, true, nil
+	}
+
+	return "", nil, false, goerrors.Wrap(ErrUnsupportedResidualPolicy, "expression without resource field")
+}
+
+// getResourceField will return field name when term is a reference to "input.resource.<field>".
+func getResourceField(term *opaTerm) (string, bool, error) {
+	// Check type
+	if term.Type != opaTermTypeRef {
+		return "", false, nil
+	}
+
+	// Decode reference
+	var parts []*opaTerm
+	// Decode
+	err := json.Unmarshal(term.Value, &parts)
+	// Check error
+	if err != nil {
+		return "", false, goerrors.WithStack(err)
+	}
+
+	// Get reference values
+	values, err := getRefValues(parts)
+	// Check error
+	if err != nil {
+		return "", false, err
+	}
+
+	// Check that reference is on resource
+	if len(values) < 2 || values[0] != rowFilterInputVar || values[1] != rowFilterResource {
+		return "", false, nil
+	}
+
+	// Only direct fields are supported
+	if len(values) != 3 { //nolint:mnd
+		return "", false, goerrors.Wrapf(
+			ErrUnsupportedResidualPolicy,
+			"unsupported resource reference %s",
+			strings.Join(values, "."),
+		)
+	}
+
+	return values[2], true, nil
+}
+
+func getOperatorName(term *opaTerm) (string, error) {
+	// Check type
+	if term.Type != opaTermTypeRef {
+		return "", goerrors.Wrapf(ErrUnsupportedResidualPolicy, "operator term type %s", term.Type)
+	}
+
+	// Decode reference
+	var parts []*opaTerm
+	// Decode
+	err := json.Unmarshal(term.Value, &parts)
+	// Check error
+	if err != nil {
+		return "", goerrors.WithStack(err)
+	}
+
+	// Get reference values
+	values, err := getRefValues(parts)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(values, "."), nil
+}
+
+func getRefValues(parts []*opaTerm) ([]string, error) {
+	res := make([]string, 0, len(parts))
+
+	for _, p := range parts {
+		// Check type
+		if p.Type != opaTermTypeVar && p.Type != opaTermTypeString {
+			return nil, goerrors.Wrapf(ErrUnsupportedResidualPolicy, "reference part type %s", p.Type)
+		}
+
+		var v string
+		// Decode
+		err := json.Unmarshal(p.Value, &v)
+		// Check error
+		if err != nil {
+			return nil, goerrors.WithStack(err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+func getTermValue(term *opaTerm) (any, error) {
+	switch term.Type {
+	case opaTermTypeNull:
+		return nil, nil
+	case opaTermTypeString, opaTermTypeNumber, opaTermTypeBoolean:
+		var v any
+		// Decode
+		err := json.Unmarshal(term.Value, &v)
+		// Check error
+		if err != nil {
+			return nil, goerrors.WithStack(err)
+		}
+
+		return v, nil
+	case opaTermTypeArray, opaTermTypeSet:
+		var items []*opaTerm
+		// Decode
+		err := json.Unmarshal(term.Value, &items)
+		// Check error
+		if err != nil {
+			return nil, goerrors.WithStack(err)
+		}
+
+		res := make([]any, 0, len(items))
+		// Loop over items
+		for _, it := range items {
+			// Only scalar values are supported
+			if it.Type == opaTermTypeArray || it.Type == opaTermTypeSet {
+				return nil, goerrors.Wrap(ErrUnsupportedResidualPolicy, "nested collections")
+			}
+
+			v, err := getTermValue(it)
+			// Check error
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, v)
+		}
+
+		return res, nil
+	default:
+		return nil, goerrors.Wrapf(ErrUnsupportedResidualPolicy, "value term type %s", term.Type)
+	}
+}
+
+func buildGenericFilter(op string, negated bool, value any) (*common.GenericFilter, error) {
+	// Initialize
+	res := &common.GenericFilter{}
+
+	// Manage null values
+	if value == nil {
+		switch {
+		case (op == opaOperatorEq || op == opaOperatorEqual) != negated:
+			res.IsNull = true
+		case op == opaOperatorEq || op == opaOperatorEqual || op == opaOperatorNeq:
+			res.IsNotNull = true
+		default:
+			return nil, goerrors.Wrapf(ErrUnsupportedResidualPolicy, "null value with operator %s", op)
+		}
+
+		return res, nil
+	}
+
+	switch op {
+	case opaOperatorEq, opaOperatorEqual:
+		if negated {
+			res.NotEq = value
+		} else {
+			res.Eq = value
+		}
+	case opaOperatorNeq:
+		if negated {
+			res.Eq = value
+		} else {
+			res.NotEq = value
+		}
+	case opaOperatorGt:
+		if negated {
+			res.NotGt = value
+		} else {
+			res.Gt = value
+		}
+	case opaOperatorGte:
+		if negated {
+			res.NotGte = value
+		} else {
+			res.Gte = value
+		}
+	case opaOperatorLt:
+		if negated {
+			res.NotLt = value
+		} else {
+			res.Lt = value
+		}
+	case opaOperatorLte:
+		if negated {
+			res.NotLte = value
+		} else {
+			res.Lte = value
+		}
+	case opaOperatorStartsWith:
+		if negated {
+			res.NotStartsWith = value
+		} else {
+			res.StartsWith = value
+		}
+	case opaOperatorEndsWith:
+		if negated {
+			res.NotEndsWith = value
+		} else {
+			res.EndsWith = value
+		}
+	case opaOperatorContains:
+		if negated {
+			res.NotContains = value
+		} else {
+			res.Contains = value
+		}
+	case opaOperatorMember:
+		if negated {
+			res.NotIn = value
+		} else {
+			res.In = value
+		}
+	default:
+		return nil, goerrors.Wrapf(ErrUnsupportedResidualPolicy, "operator %s", op)
+	}
+
+	return res, nil
+}
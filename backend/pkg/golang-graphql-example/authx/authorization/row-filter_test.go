@@ -0,0 +1,164 @@
+//go:build unit
+
+package authorization
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+)
+
+func Test_residualToRowFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  string
+		want    *models.RowFilter
+		wantErr error
+	}{
+		{
+			name:   "never true",
+			result: `{}`,
+			want:   &models.RowFilter{},
+		},
+		{
+			name:   "always true",
+			result: `{"queries": [[]]}`,
+			want:   &models.RowFilter{AllowAll: true},
+		},
+		{
+			name: "equality and reversed comparison",
+			result: `{"queries": [[
+				{"index": 0, "terms": [
+					{"type": "ref", "value": [{"type": "var", "value": "eq"}]},
+					{"type": "ref", "value": [{"type": "var", "value": "input"}, {"type": "string", "value": "resource"}, {"type": "string", "value": "done"}]},
+					{"type": "boolean", "value": false}
+				]},
+				{"index": 1, "terms": [
+					{"type": "ref", "value": [{"type": "var", "value": "gt"}]},
+					{"type": "number", "value": 10},
+					{"type": "ref", "value": [{"type": "var", "value": "input"}, {"type": "string", "value": "resource"}, {"type": "string", "value": "priority"}]}
+				]}
+			]]}`,
+			want: &models.RowFilter{Conditions: [][]*models.RowFilterCondition{{
+				{Field: "done", Filter: &common.GenericFilter{Eq: false}},
+				{Field: "priority", Filter: &common.GenericFilter{Lt: float64(10)}},
+			}}},
+		},
+		{
+			name: "alternatives with negation, member, functions and single term",
+			result: `{"queries": [
+				[{"index": 0, "negated": true, "terms": [
+					{"type": "ref", "value": [{"type": "var", "value": "startswith"}]},
+					{"type": "ref", "value": [{"type": "var", "value": "input"}, {"type": "string", "value": "resource"}, {"type": "string", "value": "text"}]},
+					{"type": "string", "value": "secret"}
+				]}],
+				[{"index": 0, "terms": [
+					{"type": "ref", "value": [{"type": "var", "value": "internal"}, {"type": "string", "value": "member_2"}]},
+					{"type": "ref", "value": [{"type": "var", "value": "input"}, {"type": "string", "value": "resource"}, {"type": "string", "value": "id"}]},
+					{"type": "array", "value": [{"type": "string", "value": "1"}, {"type": "string", "value": "2"}]}
+				]}],
+				[{"index": 0, "terms": {"type": "ref", "value": [{"type": "var", "value": "input"}, {"type": "string", "value": "resource"}, {"type": "string", "value": "done"}]}}],
+				[{"index": 0, "terms": [
+					{"type": "ref", "value": [{"type": "var", "value": "neq"}]},
+					{"type": "ref", "value": [{"type": "var", "value": "input"}, {"type": "string", "value": "resource"}, {"type": "string", "value": "text"}]},
+					{"type": "null", "value": null}
+				]}]
+			]}`,
+			want: &models.RowFilter{Conditions: [][]*models.RowFilterCondition{
+				{{Field: "text", Filter: &common.GenericFilter{NotStartsWith: "secret"}}},
+				{{Field: "id", Filter: &common.GenericFilter{In: []any{"1", "2"}}}},
+				{{Field: "done", Filter: &common.GenericFilter{Eq: true}}},
+				{{Field: "text", Filter: &common.GenericFilter{IsNotNull: true}}},
+			}},
+		},
+		{
+			name: "unsupported operator",
+			result: `{"queries": [[{"index": 0, "terms": [
+				{"type": "ref", "value": [{"type": "var", "value": "regex"}, {"type": "string", "value": "match"}]},
+				{"type": "string", "value": "^a"},
+				{"type": "ref", "value": [{"type": "var", "value": "input"}, {"type": "string", "value": "resource"}, {"type": "string", "value": "text"}]}
+			]}]]}`,
+			wantErr: ErrUnsupportedResidualPolicy,
+		},
+		{
+			name: "nested resource field",
+			result: `{"queries": [[{"index": 0, "terms": [
+				{"type": "ref", "value": [{"type": "var", "value": "eq"}]},
+				{"type": "ref", "value": [{"type": "var", "value": "input"}, {"type": "string", "value": "resource"}, {"type": "string", "value": "owner"}, {"type": "string", "value": "id"}]},
+				{"type": "string", "value": "1"}
+			]}]]}`,
+			wantErr: ErrUnsupportedResidualPolicy,
+		},
+		{
+			name:    "support modules",
+			result:  `{"queries": [[]], "support": [{}]}`,
+			wantErr: ErrUnsupportedResidualPolicy,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &opaCompileResult{}
+			require.NoError(t, json.Unmarshal([]byte(tt.result), res))
+
+			got, err := residualToRowFilter(res)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "error %v", err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_service_GetRowFilter(t *testing.T) {
+	var body *opaCompileInput
+
+	opaSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body = &opaCompileInput{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+
+		_, _ = w.Write([]byte(`{"result": {"queries": [[{"index": 0, "terms": [
+			{"type": "ref", "value": [{"type": "var", "value": "eq"}]},
+			{"type": "ref", "value": [{"type": "var", "value": "input"}, {"type": "string", "value": "resource"}, {"type": "string", "value": "done"}]},
+			{"type": "boolean", "value": false}
+		]}]]}}`))
+	}))
+	defer opaSrv.Close()
+
+	s := newTestOPAService(t, &config.OPAServerAuthorization{
+		URL:          opaSrv.URL,
+		CompileURL:   opaSrv.URL + "/v1/compile",
+		CompileQuery: "data.example.authz.rows_allowed == true",
+	})
+
+	res, err := s.GetRowFilter(newTestAuthorizationContext(), "todo:List")
+	require.NoError(t, err)
+	assert.Equal(t, &models.RowFilter{Conditions: [][]*models.RowFilterCondition{{
+		{Field: "done", Filter: &common.GenericFilter{Eq: false}},
+	}}}, res)
+
+	require.NotNil(t, body)
+	assert.Equal(t, "data.example.authz.rows_allowed == true", body.Query)
+	assert.Equal(t, []string{"input.resource"}, body.Unknowns)
+	assert.Equal(t, "todo:List", body.Input.Data.Action)
+	assert.Equal(t, "user", body.Input.User.PreferredUsername)
+
+	// Without compile url, all rows are allowed
+	s = newTestOPAService(t, &config.OPAServerAuthorization{URL: opaSrv.URL})
+
+	res, err = s.GetRowFilter(newTestAuthorizationContext(), "todo:List")
+	require.NoError(t, err)
+	assert.Equal(t, &models.RowFilter{AllowAll: true}, res)
+}
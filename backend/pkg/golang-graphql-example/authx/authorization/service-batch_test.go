@@ -0,0 +1,401 @@
+//go:build unit
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+func newTestOPAService(t *testing.T, opaCfg *config.OPAServerAuthorization) *service {
+	t.Helper()
+
+	// Add defaults
+	opaCfg.Tags = map[string]string{}
+	opaCfg.Timeout = "1s"
+	opaCfg.RetryWaitDuration = "1ms"
+
+	if opaCfg.CircuitBreaker == nil {
+		opaCfg.CircuitBreaker = &config.OPAServerAuthorizationCircuitBreakerConfig{OpenDuration: "1h"}
+	}
+
+	ctrl := gomock.NewController(t)
+	cfgManager := cmocks.NewMockManager(ctrl)
+	cfgManager.EXPECT().GetConfig().AnyTimes().Return(&config.Config{OPAServerAuthorization: opaCfg})
+
+	s := &service{cfgManager: cfgManager}
+	require.NoError(t, s.InitializeAndReload())
+
+	return s
+}
+
+func newTestAuthorizationContext() context.Context {
+	ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+
+	return authentication.SetAuthenticatedUserToContext(ctx, &models.OIDCUser{PreferredUsername: "user"})
+}
+
+func Test_service_AreAuthorized_Batch(t *testing.T) {
+	var singleCalls, batchCalls atomic.Int32
+
+	// Create fake opa server allowing only resources ending with 0 or 2
+	isAllowed := func(resource string) bool {
+		return strings.HasSuffix(resource, "0") || strings.HasSuffix(resource, "2")
+	}
+
+	opaSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/batch" {
+			batchCalls.Add(1)
+
+			body := &batchInputOPA{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+
+			res := []bool{}
+			for _, c := range body.Input.Checks {
+				res = append(res, isAllowed(c.Resource))
+			}
+
+			_ = json.NewEncoder(w).Encode(&opaBatchAnswer{Result: res})
+
+			return
+		}
+
+		singleCalls.Add(1)
+
+		body := &generalInputOPA{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+
+		_ = json.NewEncoder(w).Encode(&opaAnswer{Result: isAllowed(body.Input.Data.Resource)})
+	}))
+	defer opaSrv.Close()
+
+	s := newTestOPAService(t, &config.OPAServerAuthorization{
+		URL:      opaSrv.URL,
+		BatchURL: opaSrv.URL + "/batch",
+		Cache:    &config.OPAServerAuthorizationCacheConfig{TTL: "1h", MaxSize: 100},
+	})
+
+	ctx := SetRequestDecisionCacheToContext(newTestAuthorizationContext())
+
+	checks := []*models.AuthorizationCheck{
+		{Action: "todo:Get", Resource: "todo:1"},
+		{Action: "todo:Get", Resource: "todo:2"},
+		{Action: "todo:Get", Resource: "todo:3"},
+	}
+
+	res, err := s.AreAuthorized(ctx, checks)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false, true, false}, res)
+	assert.Equal(t, int32(1), batchCalls.Load())
+	assert.Equal(t, int32(0), singleCalls.Load())
+
+	// Cached decisions don't call OPA server again
+	res, err = s.AreAuthorized(ctx, append(checks, &models.AuthorizationCheck{Action: "todo:Get", Resource: "todo:10"}))
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false, true, false, true}, res)
+	assert.Equal(t, int32(1), batchCalls.Load())
+	assert.Equal(t, int32(1), singleCalls.Load())
+
+	// Shared cache is used by other requests
+	ok, err := s.IsAuthorized(newTestAuthorizationContext(), "todo:Get", "todo:2")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(1), singleCalls.Load())
+
+	// Reload flushes shared cache
+	require.NoError(t, s.InitializeAndReload())
+
+	ok, err = s.IsAuthorized(newTestAuthorizationContext(), "todo:Get", "todo:2")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(2), singleCalls.Load())
+}
+
+func Test_service_AreAuthorized_RequestCacheOnly(t *testing.T) {
+	var calls atomic.Int32
+
+	opaSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+
+		_, _ = w.Write([]byte(`{"result": true}`))
+	}))
+	defer opaSrv.Close()
+
+	s := newTestOPAService(t, &config.OPAServerAuthorization{URL: opaSrv.URL})
+
+	ctx := SetRequestDecisionCacheToContext(newTestAuthorizationContext())
+
+	for range 3 {
+		require.NoError(t, s.CheckAuthorized(ctx, "todo:Get", "todo:1"))
+	}
+
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Without request cache
+	require.NoError(t, s.CheckAuthorized(newTestAuthorizationContext(), "todo:Get", "todo:1"))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func Test_service_AreAuthorized_RetriesAndCircuitBreaker(t *testing.T) {
+	var calls atomic.Int32
+
+	failing := atomic.Bool{}
+	failing.Store(true)
+
+	opaSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"result": true}`))
+	}))
+	defer opaSrv.Close()
+
+	s := newTestOPAService(t, &config.OPAServerAuthorization{
+		URL:        opaSrv.URL,
+		RetryCount: 1,
+		CircuitBreaker: &config.OPAServerAuthorizationCircuitBreakerConfig{
+			FailureThreshold: 3,
+			OpenDuration:     "50ms",
+		},
+	})
+
+	// First call: 1 try + 1 retry
+	_, err := s.IsAuthorized(newTestAuthorizationContext(), "todo:Get", "todo:1")
+	require.Error(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// Second call: circuit opens after the third failure
+	_, err = s.IsAuthorized(newTestAuthorizationContext(), "todo:Get", "todo:1")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrOPACircuitOpen))
+	assert.Equal(t, int32(3), calls.Load())
+
+	// Circuit is open, server isn't called
+	_, err = s.IsAuthorized(newTestAuthorizationContext(), "todo:Get", "todo:1")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrOPACircuitOpen))
+	assert.Equal(t, int32(3), calls.Load())
+
+	// After open duration, probe closes the circuit
+	failing.Store(false)
+	time.Sleep(60 * time.Millisecond)
+
+	ok, err := s.IsAuthorized(newTestAuthorizationContext(), "todo:Get", "todo:1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(4), calls.Load())
+}
+
+func Test_service_AreAuthorized_NonRetryableError(t *testing.T) {
+	var calls atomic.Int32
+
+	opaSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer opaSrv.Close()
+
+	s := newTestOPAService(t, &config.OPAServerAuthorization{URL: opaSrv.URL, RetryCount: 3})
+
+	_, err := s.IsAuthorized(newTestAuthorizationContext(), "todo:Get", "todo:1")
+	require.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func Test_service_AreAuthorized_CallerCancellationIsNotFailure(t *testing.T) {
+	var calls atomic.Int32
+
+	slow := atomic.Bool{}
+	slow.Store(true)
+
+	opaSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+
+		if slow.Load() {
+			// Wait for client disconnection
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"result": true}`))
+	}))
+	defer opaSrv.Close()
+
+	s := newTestOPAService(t, &config.OPAServerAuthorization{
+		URL:        opaSrv.URL,
+		RetryCount: 2,
+		CircuitBreaker: &config.OPAServerAuthorizationCircuitBreakerConfig{
+			FailureThreshold: 1,
+			OpenDuration:     "1h",
+		},
+	})
+
+	// Callers are leaving before OPA server answers
+	for range 3 {
+		ctx, cancel := context.WithTimeout(newTestAuthorizationContext(), 10*time.Millisecond)
+
+		_, err := s.IsAuthorized(ctx, "todo:Get", "todo:1")
+
+		cancel()
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, ErrOPACircuitOpen))
+	}
+
+	// Requests aren't retried for gone callers
+	assert.Equal(t, int32(3), calls.Load())
+
+	// Circuit is still closed for other callers
+	slow.Store(false)
+
+	ok, err := s.IsAuthorized(newTestAuthorizationContext(), "todo:Get", "todo:1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(4), calls.Load())
+}
+
+func Test_service_AreAuthorized_CancelledProbe(t *testing.T) {
+	var calls atomic.Int32
+
+	// 0: failing, 1: slow, 2: ok
+	mode := atomic.Int32{}
+
+	opaSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+
+		switch mode.Load() {
+		case 0:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 1:
+			// Wait for client disconnection
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		default:
+			_, _ = w.Write([]byte(`{"result": true}`))
+		}
+	}))
+	defer opaSrv.Close()
+
+	s := newTestOPAService(t, &config.OPAServerAuthorization{
+		URL: opaSrv.URL,
+		CircuitBreaker: &config.OPAServerAuthorizationCircuitBreakerConfig{
+			FailureThreshold: 1,
+			OpenDuration:     "20ms",
+		},
+	})
+
+	// Open circuit
+	_, err := s.IsAuthorized(newTestAuthorizationContext(), "todo:Get", "todo:1")
+	require.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Wait for half-open state and cancel probe request
+	mode.Store(1)
+	time.Sleep(30 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(newTestAuthorizationContext(), 10*time.Millisecond)
+	_, err = s.IsAuthorized(ctx, "todo:Get", "todo:1")
+
+	cancel()
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrOPACircuitOpen))
+	assert.Equal(t, int32(2), calls.Load())
+
+	// Another probe is allowed and closes the circuit
+	mode.Store(2)
+
+	ok, err := s.IsAuthorized(newTestAuthorizationContext(), "todo:Get", "todo:1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func Test_service_AreAuthorized_SharedCachePrincipal(t *testing.T) {
+	var calls atomic.Int32
+
+	opaSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+
+		body := &generalInputOPA{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+
+		// Only admins without api token are allowed
+		_ = json.NewEncoder(w).Encode(&opaAnswer{
+			Result: body.Input.User.HasAnyRole([]string{"admin"}) && body.Input.User.APIToken == nil,
+		})
+	}))
+	defer opaSrv.Close()
+
+	s := newTestOPAService(t, &config.OPAServerAuthorization{
+		URL:   opaSrv.URL,
+		Cache: &config.OPAServerAuthorizationCacheConfig{TTL: "1h", MaxSize: 100},
+	})
+
+	newCtx := func(user *models.OIDCUser) context.Context {
+		return authentication.SetAuthenticatedUserToContext(
+			log.SetLoggerToContext(context.TODO(), log.NewLogger()),
+			user,
+		)
+	}
+
+	// Admin
+	ok, err := s.IsAuthorized(newCtx(&models.OIDCUser{PreferredUsername: "user", Roles: []string{"admin"}}), "todo:Get", "todo:1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Same claims are using shared cache
+	ok, err = s.IsAuthorized(newCtx(&models.OIDCUser{PreferredUsername: "user", Roles: []string{"admin"}}), "todo:Get", "todo:1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Roles have changed
+	ok, err = s.IsAuthorized(newCtx(&models.OIDCUser{PreferredUsername: "user", Roles: []string{"reader"}}), "todo:Get", "todo:1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// Same user authenticated with an api token
+	ok, err = s.IsAuthorized(
+		newCtx(&models.OIDCUser{
+			PreferredUsername: "user",
+			Roles:             []string{"admin"},
+			APIToken:          &models.APITokenInfo{ID: "token", Scopes: []string{"*"}},
+		}),
+		"todo:Get",
+		"todo:1",
+	)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, int32(3), calls.Load())
+}
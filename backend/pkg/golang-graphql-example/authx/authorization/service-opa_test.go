@@ -0,0 +1,74 @@
+//go:build unit
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+func Test_service_IsAuthorized_OPAInput(t *testing.T) {
+	var input map[string]any
+
+	// Create fake opa server
+	opaSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]map[string]any{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		input = body["input"]
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"result": true}`))
+	}))
+	defer opaSrv.Close()
+
+	ctrl := gomock.NewController(t)
+	cfgManager := cmocks.NewMockManager(ctrl)
+	cfgManager.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+		OPAServerAuthorization: &config.OPAServerAuthorization{
+			URL:               opaSrv.URL,
+			Tags:              map[string]string{},
+			Timeout:           "1s",
+			RetryWaitDuration: "1ms",
+			CircuitBreaker:    &config.OPAServerAuthorizationCircuitBreakerConfig{OpenDuration: "1s"},
+		},
+	})
+
+	s := &service{cfgManager: cfgManager}
+	require.NoError(t, s.InitializeAndReload())
+
+	ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+	ctx = authentication.SetAuthenticatedUserToContext(ctx, &models.OIDCUser{
+		PreferredUsername: "user",
+		PrincipalType:     models.PrincipalTypeUser,
+		Roles:             []string{"admin"},
+		Groups:            []string{"team-a"},
+		Tenant:            "tenant-1",
+		ExtraClaims:       map[string]any{"department": "it"},
+	})
+
+	res, err := s.IsAuthorized(ctx, "todo:Create", "todo:1")
+	require.NoError(t, err)
+	assert.True(t, res)
+
+	user, _ := input["user"].(map[string]any)
+	require.NotNil(t, user)
+	assert.Equal(t, []any{"admin"}, user["roles"])
+	assert.Equal(t, []any{"team-a"}, user["groups"])
+	assert.Equal(t, "tenant-1", user["tenant"])
+	assert.Equal(t, map[string]any{"department": "it"}, user["extra_claims"])
+	assert.Equal(t, map[string]any{"action": "todo:Create", "resource": "todo:1"}, input["data"])
+}
@@ -1,23 +1,29 @@
 package authorization
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"net/http"
+	"sync"
+	"time"
 
 	goerrors "emperror.dev/errors"
+	"github.com/hashicorp/golang-lru/v2/expirable"
 
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
-	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/tracing"
 )
 
+const anonymousUserIdentifier = "anonymous"
+
 type service struct {
-	cfgManager config.Manager
+	cfgManager  config.Manager
+	rbac        *rbacEngine
+	opaClient   *opaClient
+	sharedCache *expirable.LRU[string, bool]
+	mutex       sync.RWMutex
 }
 
 type generalInputOPA struct {
@@ -39,115 +45,333 @@ type opaAnswer struct {
 	Result bool `json:"result"`
 }
 
+type batchInputOPA struct {
+	Input *batchInputDataOPA `json:"input"`
+}
+
+type batchInputDataOPA struct {
+	User   *models.OIDCUser  `json:"user"`
+	Tags   map[string]string `json:"tags"`
+	Checks []*generalDataOPA `json:"checks"`
+}
+
+type opaBatchAnswer struct {
+	Result []bool `json:"result"`
+}
+
 func (s *service) IsAuthorized(ctx context.Context, action, resource string) (bool, error) {
+	// Call batch with only one check
+	res, err := s.AreAuthorized(ctx, []*models.AuthorizationCheck{{Action: action, Resource: resource}})
+	// Check error
+	if err != nil {
+		return false, err
+	}
+
+	return res[0], nil
+}
+
+func (s *service) AreAuthorized(ctx context.Context, checks []*models.AuthorizationCheck) ([]bool, error) {
 	// Get logger
 	logger := log.GetLoggerFromContext(ctx)
+	// Get user from context
+	user := authentication.GetAuthenticatedUserFromContext(ctx)
+	// Get runtime objects
+	rbac, opaCl, sharedCache := s.getRuntime()
 	// Get configuration to check that authorization can be calculated
 	cfg := s.cfgManager.GetConfig().OPAServerAuthorization
-	// Check if configuration is empty
-	if cfg == nil {
-		// Configuration doesn't exists, authorization is given
-		return true, nil
+	// Get request cache
+	reqCache := getRequestDecisionCacheFromContext(ctx)
+
+	// Compute principal part of decision cache keys when OPA server is used
+	var principalKey string
+	if rbac == nil && cfg != nil {
+		var err error
+
+		principalKey, err = decisionCachePrincipalKey(user)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Get user from context
-	user := authentication.GetAuthenticatedUserFromContext(ctx)
+	// Initialize results
+	results := make([]bool, len(checks))
+	// Checks that need an OPA request
+	pending := []int{}
 
-	// Create opa input
-	input := &generalInputOPA{
-		Input: &generalInputDataOPA{
-			User: user,
-			Tags: cfg.Tags,
-			Data: &generalDataOPA{
-				Action:   action,
-				Resource: resource,
-			},
-		},
+	// Loop over checks
+	for i, check := range checks {
+		// Check personal access token scopes first
+		if user != nil && !user.IsActionAllowedByAPIToken(check.Action) {
+			logger.Infof(
+				"User %s not authorized for action %s on resource %s by API token scopes",
+				user.GetIdentifier(),
+				check.Action,
+				check.Resource,
+			)
+
+			continue
+		}
+
+		// Check if built-in rbac engine is enabled
+		if rbac != nil {
+			// Compute
+			results[i] = rbac.isAuthorized(user, check.Action, check.Resource)
+			// Log
+			logDecision(logger, user, check, results[i], " by RBAC rules")
+
+			continue
+		}
+
+		// Check if configuration is empty
+		if cfg == nil {
+			// Configuration doesn't exists, authorization is given
+			results[i] = true
+
+			continue
+		}
+
+		// Check caches
+		key := decisionCacheKey(principalKey, check)
+		// Check request cache
+		if v, ok := reqCache.get(key); ok {
+			results[i] = v
+
+			continue
+		}
+		// Check shared cache
+		if sharedCache != nil {
+			if v, ok := sharedCache.Get(key); ok {
+				results[i] = v
+				// Save in request cache
+				reqCache.set(key, v)
+
+				continue
+			}
+		}
+
+		// Save as pending
+		pending = append(pending, i)
 	}
-	// Json encode body
-	bb, err := json.Marshal(input)
-	if err != nil {
-		return false, goerrors.WithStack(err)
+
+	// Check if there isn't anything to ask to OPA server
+	if len(pending) == 0 {
+		return results, nil
+	}
+
+	// Check that OPA client is initialized
+	if opaCl == nil {
+		return nil, goerrors.New("opa server client not initialized")
 	}
 
-	authorized, err := s.requestOPAServer(ctx, cfg, bb)
+	// Build pending checks
+	pendingChecks := make([]*models.AuthorizationCheck, 0, len(pending))
+	for _, i := range pending {
+		pendingChecks = append(pendingChecks, checks[i])
+	}
+
+	// Request OPA server
+	decisions, err := s.requestOPADecisions(ctx, opaCl, cfg, user, pendingChecks)
 	// Check error
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+
+	// Save results
+	for j, i := range pending {
+		results[i] = decisions[j]
+		// Save in caches
+		key := decisionCacheKey(principalKey, checks[i])
+		reqCache.set(key, decisions[j])
+
+		if sharedCache != nil {
+			sharedCache.Add(key, decisions[j])
+		}
+
+		// Log
+		logDecision(logger, user, checks[i], decisions[j], "")
+	}
+
+	return results, nil
+}
+
+func (*service) requestOPADecisions(
+	ctx context.Context,
+	opaCl *opaClient,
+	cfg *config.OPAServerAuthorization,
+	user *models.OIDCUser,
+	checks []*models.AuthorizationCheck,
+) ([]bool, error) {
+	// Check if batch can be used
+	if cfg.BatchURL != "" && len(checks) > 1 {
+		// Create opa input
+		input := &batchInputOPA{
+			Input: &batchInputDataOPA{
+				User:   user,
+				Tags:   cfg.Tags,
+				Checks: make([]*generalDataOPA, 0, len(checks)),
+			},
+		}
+		// Add checks
+		for _, c := range checks {
+			input.Input.Checks = append(input.Input.Checks, &generalDataOPA{Action: c.Action, Resource: c.Resource})
+		}
+		// Json encode body
+		bb, err := json.Marshal(input)
+		if err != nil {
+			return nil, goerrors.WithStack(err)
+		}
+
+		// Prepare answer
+		var answer opaBatchAnswer
+		// Request
+		err = opaCl.request(ctx, cfg.BatchURL, bb, &answer)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		// Check answer
+		if len(answer.Result) != len(checks) {
+			return nil, goerrors.Errorf(
+				"opa server batch answer contains %d results instead of %d",
+				len(answer.Result),
+				len(checks),
+			)
+		}
+
+		return answer.Result, nil
+	}
+
+	// Initialize
+	res := make([]bool, 0, len(checks))
+
+	// Loop over checks
+	for _, c := range checks {
+		// Create opa input
+		input := &generalInputOPA{
+			Input: &generalInputDataOPA{
+				User: user,
+				Tags: cfg.Tags,
+				Data: &generalDataOPA{
+					Action:   c.Action,
+					Resource: c.Resource,
+				},
+			},
+		}
+		// Json encode body
+		bb, err := json.Marshal(input)
+		if err != nil {
+			return nil, goerrors.WithStack(err)
+		}
+
+		// Prepare answer
+		var answer opaAnswer
+		// Request
+		err = opaCl.request(ctx, cfg.URL, bb, &answer)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, answer.Result)
 	}
 
+	return res, nil
+}
+
+func logDecision(logger log.Logger, user *models.OIDCUser, check *models.AuthorizationCheck, authorized bool, suffix string) {
 	// Check if user isn't authorized
 	if !authorized {
 		logger.Infof(
-			"User %s not authorized for action %s on resource %s",
-			user.GetIdentifier(),
-			action,
-			resource,
+			"User %s not authorized for action %s on resource %s%s",
+			getUserIdentifier(user),
+			check.Action,
+			check.Resource,
+			suffix,
 		)
 
-		return false, nil
+		return
 	}
 
 	logger.Infof(
-		"User %s authorized for action %s on resource %s",
-		user.GetIdentifier(),
-		action,
-		resource,
+		"User %s authorized for action %s on resource %s%s",
+		getUserIdentifier(user),
+		check.Action,
+		check.Resource,
+		suffix,
 	)
+}
 
-	return true, nil
+// getUserIdentifier will return user identifier for logs.
+// User can be nil when authorization is enabled without authentication.
+func getUserIdentifier(user *models.OIDCUser) string {
+	if user == nil {
+		return anonymousUserIdentifier
+	}
+
+	return user.GetIdentifier()
 }
 
-func (*service) requestOPAServer(
-	ctx context.Context,
-	opaCfg *config.OPAServerAuthorization,
-	body []byte,
-) (authn bool, err error) {
-	// Get trace from context
-	trace := tracing.GetTraceFromContext(ctx)
-	// Generate child trace
-	ctx, childTrace := trace.GetChildTrace(ctx, "opa-server.request")
-
-	defer func() {
+func (s *service) InitializeAndReload() error {
+	// Get configuration
+	cfg := s.cfgManager.GetConfig()
+
+	var rbac *rbacEngine
+	// Check if rbac is enabled
+	if cfg.RBACAuthorization != nil {
+		var err error
+		// Compile rules
+		rbac, err = newRBACEngine(cfg.RBACAuthorization)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	var (
+		opaCl       *opaClient
+		sharedCache *expirable.LRU[string, bool]
+	)
+	// Check if opa server is enabled
+	if cfg.OPAServerAuthorization != nil {
+		var err error
+		// Create client
+		opaCl, err = newOPAClient(cfg.OPAServerAuthorization)
 		// Check error
 		if err != nil {
-			childTrace.MarkAsError()
+			return err
 		}
 
-		childTrace.Finish()
-	}()
-	// Add data
-	childTrace.SetTag("opa.uri", opaCfg.URL)
+		// Check if shared cache is enabled
+		if cfg.OPAServerAuthorization.Cache != nil {
+			// Parse ttl
+			ttl, err := time.ParseDuration(cfg.OPAServerAuthorization.Cache.TTL)
+			// Check error
+			if err != nil {
+				return goerrors.WithStack(err)
+			}
 
-	// Change NewRequest to NewRequestWithContext and pass context it
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, opaCfg.URL, bytes.NewBuffer(body))
-	// Check error
-	if err != nil {
-		return false, goerrors.WithStack(err)
-	}
-	// Add content type
-	req.Header.Add("Content-Type", "application/json")
-	// Forward trace on request
-	childTrace.InjectInHTTPHeader(req.Header)
-	// Making request to OPA server
-	resp, err := http.DefaultClient.Do(req)
-	// Check error
-	if err != nil {
-		return false, goerrors.WithStack(err)
+			// Create cache
+			sharedCache = expirable.NewLRU[string, bool](cfg.OPAServerAuthorization.Cache.MaxSize, nil, ttl)
+		}
 	}
-	// Defer closing body
-	defer resp.Body.Close()
 
-	// Prepare answer
-	var answer opaAnswer
-	// Decode answer
-	err = json.NewDecoder(resp.Body).Decode(&answer)
-	// Check error
-	if err != nil {
-		return false, goerrors.WithStack(err)
-	}
+	// Save
+	s.mutex.Lock()
+	s.rbac = rbac
+	s.opaClient = opaCl
+	s.sharedCache = sharedCache
+	s.mutex.Unlock()
+
+	return nil
+}
+
+func (s *service) getRuntime() (*rbacEngine, *opaClient, *expirable.LRU[string, bool]) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
 
-	return answer.Result, nil
+	return s.rbac, s.opaClient, s.sharedCache
 }
 
 func (s *service) CheckAuthorized(ctx context.Context, action, resource string) error {
@@ -0,0 +1,4 @@
+package fakeoidc
+
+// This package provides an embedded fake OpenID Connect provider.
+// It must only be used for local development and tests.
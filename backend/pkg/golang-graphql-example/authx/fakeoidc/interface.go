@@ -0,0 +1,63 @@
+package fakeoidc
+
+import (
+	"net/http"
+	"time"
+)
+
+// DefaultTokenDuration is the default token duration.
+const DefaultTokenDuration = time.Hour
+
+// User is a test user that can log in on the fake provider.
+type User struct {
+	// Claims are added to ID tokens and userinfo response
+	Claims   map[string]any
+	Username string
+	Password string
+	// Subject is the "sub" claim. Username is used when empty
+	Subject string
+}
+
+// Client is an OAuth2 client allowed on the fake provider.
+type Client struct {
+	ID     string
+	Secret string
+	// RedirectURLs are the allowed redirect urls. Any redirect url is allowed when empty
+	RedirectURLs []string
+}
+
+// Options are the fake provider options.
+type Options struct {
+	// IssuerURL is the public url on which the provider handler is reachable
+	IssuerURL string
+	Users     []*User
+	// Clients are the allowed clients. Any client is allowed without secret when empty
+	Clients       []*Client
+	TokenDuration time.Duration
+}
+
+// Provider is an in-process OpenID Connect provider.
+type Provider interface {
+	// Handler returns the http handler serving discovery, jwks, authorize, token and userinfo endpoints
+	// relatively to issuer url path.
+	Handler() http.Handler
+	// IssuerURL returns the issuer url.
+	IssuerURL() string
+	// IssueTokens will directly issue tokens for a user without any login flow.
+	IssueTokens(username, clientID string) (*Tokens, error)
+}
+
+// Tokens are the tokens issued by the fake provider.
+type Tokens struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	IDToken      string `json:"id_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// NewProvider will create a new fake provider.
+func NewProvider(opts *Options) (Provider, error) {
+	return newProvider(opts)
+}
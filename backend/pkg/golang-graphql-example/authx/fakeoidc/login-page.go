@@ -0,0 +1,62 @@
+package fakeoidc
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+	"slices"
+
+	"github.com/samber/lo"
+)
+
+// Login page is posted on the same url to keep authorization request parameters.
+var loginPageTemplate = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>Fake OIDC provider</title>
+</head>
+<body>
+  <h1>Fake OIDC provider</h1>
+  {{- if .Error }}
+  <p style="color: red">{{ .Error }}</p>
+  {{- end }}
+  <form method="post">
+    <label>Username <input name="username" list="users" autofocus></label>
+    <datalist id="users">
+      {{- range .Usernames }}
+      <option value="{{ . }}">
+      {{- end }}
+    </datalist>
+    <label>Password <input name="password" type="password"></label>
+    <button type="submit">Log in</button>
+  </form>
+</body>
+</html>
+`))
+
+type loginPageData struct {
+	Error     string
+	Usernames []string
+}
+
+func (p *provider) renderLoginPage(w http.ResponseWriter, status int, errMsg string) {
+	// Get sorted usernames
+	usernames := lo.Keys(p.users)
+	slices.Sort(usernames)
+
+	var buf bytes.Buffer
+	// Render template
+	err := loginPageTemplate.Execute(&buf, &loginPageData{Error: errMsg, Usernames: usernames})
+	// Check error
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	// Ignore error as headers are already sent
+	_, _ = w.Write(buf.Bytes())
+}
@@ -0,0 +1,716 @@
+package fakeoidc
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/go-jose/go-jose/v4"
+)
+
+const (
+	discoveryPath        = "/.well-known/openid-configuration"
+	jwksPath             = "/jwks"
+	authorizePath        = "/authorize"
+	tokenPath            = "/token"
+	userinfoPath         = "/userinfo"
+	codeDuration         = time.Minute
+	refreshTokenDuration = 24 * time.Hour
+	randomLength         = 32
+	keySize              = 2048
+)
+
+const (
+	pkceMethodS256             = "S256"
+	pkceMethodPlain            = "plain"
+	grantTypeAuthorizationCode = "authorization_code"
+	grantTypeRefreshToken      = "refresh_token"
+	grantTypePassword          = "password"
+	responseTypeCode           = "code"
+	scopeOpenID                = "openid"
+	tokenTypeBearer            = "Bearer"
+	preferredUsernameClaim     = "preferred_username"
+)
+
+// ErrUserNotFound is returned when user isn't declared in provider options.
+var ErrUserNotFound = errors.Sentinel("user not found")
+
+var errInvalidGrant = errors.Sentinel("invalid grant")
+
+// Authorization request stored between authorize and token endpoints.
+type authorizationRequest struct {
+	expiresAt       time.Time
+	user            *User
+	clientID        string
+	redirectURI     string
+	scope           string
+	nonce           string
+	challenge       string
+	challengeMethod string
+	state           string
+}
+
+// Refresh token grant.
+type refreshGrant struct {
+	expiresAt time.Time
+	user      *User
+	clientID  string
+	scope     string
+}
+
+type provider struct {
+	signer        jose.Signer
+	key           *rsa.PrivateKey
+	users         map[string]*User
+	clients       map[string]*Client
+	codes         map[string]*authorizationRequest
+	refreshTokens map[string]*refreshGrant
+	handler       http.Handler
+	issuerURL     string
+	keyID         string
+	tokenDuration time.Duration
+	mutex         sync.Mutex
+}
+
+func newProvider(opts *Options) (*provider, error) {
+	// Parse issuer url
+	issuerURL, err := url.Parse(strings.TrimSuffix(opts.IssuerURL, "/"))
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	// Check issuer url
+	if issuerURL.Scheme == "" || issuerURL.Host == "" {
+		return nil, errors.Errorf("invalid issuer url %q", opts.IssuerURL)
+	}
+
+	// Generate signing key
+	key, err := rsa.GenerateKey(rand.Reader, keySize)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Compute key id
+	thumbprint, err := (&jose.JSONWebKey{Key: key.Public()}).Thumbprint(crypto.SHA256)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	keyID := base64.RawURLEncoding.EncodeToString(thumbprint)
+
+	// Create signer
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: &jose.JSONWebKey{Key: key, KeyID: keyID}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	p := &provider{
+		signer:        signer,
+		key:           key,
+		users:         map[string]*User{},
+		clients:       map[string]*Client{},
+		codes:         map[string]*authorizationRequest{},
+		refreshTokens: map[string]*refreshGrant{},
+		issuerURL:     issuerURL.String(),
+		keyID:         keyID,
+		tokenDuration: opts.TokenDuration,
+	}
+
+	// Default token duration
+	if p.tokenDuration <= 0 {
+		p.tokenDuration = DefaultTokenDuration
+	}
+
+	// Index users
+	for _, it := range opts.Users {
+		// Check username
+		if it.Username == "" {
+			return nil, errors.New("user without username")
+		}
+
+		p.users[it.Username] = it
+	}
+
+	// Index clients
+	for _, it := range opts.Clients {
+		// Check client id
+		if it.ID == "" {
+			return nil, errors.New("client without id")
+		}
+
+		p.clients[it.ID] = it
+	}
+
+	// Create handler
+	prefix := issuerURL.Path
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+prefix+discoveryPath, p.discovery)
+	mux.HandleFunc("GET "+prefix+jwksPath, p.jwks)
+	mux.HandleFunc("GET "+prefix+authorizePath, p.authorize)
+	mux.HandleFunc("POST "+prefix+authorizePath, p.authorize)
+	mux.HandleFunc("POST "+prefix+tokenPath, p.token)
+	mux.HandleFunc("GET "+prefix+userinfoPath, p.userinfo)
+
+	p.handler = mux
+
+	return p, nil
+}
+
+func (p *provider) Handler() http.Handler {
+	return p.handler
+}
+
+func (p *provider) IssuerURL() string {
+	return p.issuerURL
+}
+
+func (p *provider) IssueTokens(username, clientID string) (*Tokens, error) {
+	// Find user
+	user := p.users[username]
+	// Check if user exists
+	if user == nil {
+		return nil, errors.WithStack(ErrUserNotFound)
+	}
+
+	return p.issueTokens(user, clientID, scopeOpenID, "")
+}
+
+func (p *provider) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.issuerURL,
+		"authorization_endpoint":                p.issuerURL + authorizePath,
+		"token_endpoint":                        p.issuerURL + tokenPath,
+		"userinfo_endpoint":                     p.issuerURL + userinfoPath,
+		"jwks_uri":                              p.issuerURL + jwksPath,
+		"response_types_supported":              []string{responseTypeCode},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{string(jose.RS256)},
+		"scopes_supported":                      []string{scopeOpenID, "profile", "email", "offline_access"},
+		"grant_types_supported":                 []string{grantTypeAuthorizationCode, grantTypeRefreshToken, grantTypePassword},
+		"code_challenge_methods_supported":      []string{pkceMethodS256, pkceMethodPlain},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+	})
+}
+
+func (p *provider) jwks(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{{Key: p.key.Public(), KeyID: p.keyID, Algorithm: string(jose.RS256), Use: "sig"}},
+	})
+}
+
+func (p *provider) authorize(w http.ResponseWriter, r *http.Request) {
+	// Parse form
+	err := r.ParseForm()
+	// Check error
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	// Validate request
+	// Errors aren't sent to redirect uri as it may not be trusted
+	areq, err := p.parseAuthorizationRequest(r.URL.Query())
+	// Check error
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	// Display login page
+	if r.Method == http.MethodGet {
+		p.renderLoginPage(w, http.StatusOK, "")
+
+		return
+	}
+
+	// Authenticate user
+	user := p.authenticateUser(r.PostForm.Get("username"), r.PostForm.Get("password"))
+	// Check if user is authenticated
+	if user == nil {
+		p.renderLoginPage(w, http.StatusUnauthorized, "Invalid username or password")
+
+		return
+	}
+
+	// Generate code
+	code, err := generateRandomString()
+	// Check error
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	areq.user = user
+	areq.expiresAt = time.Now().Add(codeDuration)
+
+	// Store code
+	p.mutex.Lock()
+	p.pruneExpired()
+	p.codes[code] = areq
+	p.mutex.Unlock()
+
+	// Build redirect url
+	// Redirect uri has already been parsed during validation
+	rd, _ := url.Parse(areq.redirectURI)
+	q := rd.Query()
+	q.Set("code", code)
+	// Add state if present
+	if areq.state != "" {
+		q.Set("state", areq.state)
+	}
+
+	rd.RawQuery = q.Encode()
+
+	http.Redirect(w, r, rd.String(), http.StatusFound)
+}
+
+func (p *provider) parseAuthorizationRequest(values url.Values) (*authorizationRequest, error) {
+	// Check response type
+	if values.Get("response_type") != responseTypeCode {
+		return nil, errors.New("unsupported response type")
+	}
+
+	// Find client
+	client := p.findClient(values.Get("client_id"))
+	// Check if client exists
+	if client == nil {
+		return nil, errors.New("unknown client")
+	}
+
+	// Check redirect uri
+	redirectURI := values.Get("redirect_uri")
+	// Parse it
+	rd, err := url.Parse(redirectURI)
+	// Check error
+	if err != nil || rd.Scheme == "" || rd.Host == "" {
+		return nil, errors.New("invalid redirect uri")
+	}
+	// Check if it is allowed
+	if len(client.RedirectURLs) != 0 && !slices.Contains(client.RedirectURLs, redirectURI) {
+		return nil, errors.New("redirect uri not allowed")
+	}
+
+	// Check PKCE
+	challenge := values.Get("code_challenge")
+	challengeMethod := values.Get("code_challenge_method")
+	// Default method is plain when a challenge is present
+	if challenge != "" && challengeMethod == "" {
+		challengeMethod = pkceMethodPlain
+	}
+	// Check method
+	if challengeMethod != "" && challengeMethod != pkceMethodS256 && challengeMethod != pkceMethodPlain {
+		return nil, errors.New("unsupported code challenge method")
+	}
+
+	return &authorizationRequest{
+		clientID:        client.ID,
+		redirectURI:     redirectURI,
+		scope:           values.Get("scope"),
+		nonce:           values.Get("nonce"),
+		challenge:       challenge,
+		challengeMethod: challengeMethod,
+		state:           values.Get("state"),
+	}, nil
+}
+
+func (p *provider) token(w http.ResponseWriter, r *http.Request) {
+	// Parse form
+	err := r.ParseForm()
+	// Check error
+	if err != nil {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", err.Error())
+
+		return
+	}
+
+	// Authenticate client
+	client := p.authenticateClient(r)
+	// Check if client is authenticated
+	if client == nil {
+		writeOAuthError(w, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+
+		return
+	}
+
+	var tokens *Tokens
+	// Manage grant type
+	switch r.PostForm.Get("grant_type") {
+	case grantTypeAuthorizationCode:
+		tokens, err = p.exchangeCode(client, r.PostForm)
+	case grantTypeRefreshToken:
+		tokens, err = p.refresh(client, r.PostForm)
+	case grantTypePassword:
+		// Authenticate user
+		user := p.authenticateUser(r.PostForm.Get("username"), r.PostForm.Get("password"))
+		// Check if user is authenticated
+		if user == nil {
+			writeOAuthError(w, http.StatusBadRequest, "invalid_grant", "invalid username or password")
+
+			return
+		}
+
+		tokens, err = p.issueTokens(user, client.ID, r.PostForm.Get("scope"), "")
+	default:
+		writeOAuthError(w, http.StatusBadRequest, "unsupported_grant_type", "")
+
+		return
+	}
+
+	// Check error
+	if err != nil {
+		// Check if it is an invalid grant
+		if errors.Is(err, errInvalidGrant) {
+			writeOAuthError(w, http.StatusBadRequest, "invalid_grant", err.Error())
+
+			return
+		}
+
+		writeOAuthError(w, http.StatusInternalServerError, "server_error", err.Error())
+
+		return
+	}
+
+	// Tokens mustn't be cached
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, tokens)
+}
+
+func (p *provider) exchangeCode(client *Client, form url.Values) (*Tokens, error) {
+	code := form.Get("code")
+
+	// Get and consume code
+	p.mutex.Lock()
+	areq := p.codes[code]
+	delete(p.codes, code)
+	p.mutex.Unlock()
+
+	// Check code
+	if areq == nil || areq.expiresAt.Before(time.Now()) {
+		return nil, errors.Wrap(errInvalidGrant, "invalid code")
+	}
+	// Check that code was issued to this client with this redirect uri
+	if areq.clientID != client.ID || areq.redirectURI != form.Get("redirect_uri") {
+		return nil, errors.Wrap(errInvalidGrant, "code not issued for this client or redirect uri")
+	}
+	// Check PKCE code verifier
+	if areq.challenge != "" && !verifyCodeChallenge(areq.challenge, areq.challengeMethod, form.Get("code_verifier")) {
+		return nil, errors.Wrap(errInvalidGrant, "invalid code verifier")
+	}
+
+	return p.issueTokens(areq.user, client.ID, areq.scope, areq.nonce)
+}
+
+func (p *provider) refresh(client *Client, form url.Values) (*Tokens, error) {
+	token := form.Get("refresh_token")
+
+	// Get and consume refresh token (rotation)
+	p.mutex.Lock()
+	grant := p.refreshTokens[token]
+	delete(p.refreshTokens, token)
+	p.mutex.Unlock()
+
+	// Check refresh token
+	if grant == nil || grant.expiresAt.Before(time.Now()) || grant.clientID != client.ID {
+		return nil, errors.Wrap(errInvalidGrant, "invalid refresh token")
+	}
+
+	return p.issueTokens(grant.user, client.ID, grant.scope, "")
+}
+
+func (p *provider) issueTokens(user *User, clientID, scope, nonce string) (*Tokens, error) {
+	now := time.Now()
+	// Build base claims
+	claims := p.userClaims(user)
+	claims["iss"] = p.issuerURL
+	claims["aud"] = clientID
+	claims["azp"] = clientID
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(p.tokenDuration).Unix()
+
+	// Access token
+	accessClaims := maps.Clone(claims)
+	accessClaims["scope"] = scope
+
+	accessToken, err := p.sign(accessClaims)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate refresh token
+	refreshToken, err := generateRandomString()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Store refresh token
+	p.mutex.Lock()
+	p.pruneExpired()
+	p.refreshTokens[refreshToken] = &refreshGrant{
+		expiresAt: now.Add(refreshTokenDuration),
+		user:      user,
+		clientID:  clientID,
+		scope:     scope,
+	}
+	p.mutex.Unlock()
+
+	res := &Tokens{
+		AccessToken:  accessToken,
+		TokenType:    tokenTypeBearer,
+		RefreshToken: refreshToken,
+		Scope:        scope,
+		ExpiresIn:    int(p.tokenDuration.Seconds()),
+	}
+
+	// ID token is only issued for openid scope
+	if slices.Contains(strings.Fields(scope), scopeOpenID) {
+		claims["auth_time"] = now.Unix()
+		// Add nonce if present
+		if nonce != "" {
+			claims["nonce"] = nonce
+		}
+
+		res.IDToken, err = p.sign(claims)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func (p *provider) userinfo(w http.ResponseWriter, r *http.Request) {
+	// Get bearer token
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), tokenTypeBearer+" ")
+	// Check if it exists
+	if !ok {
+		w.Header().Set("WWW-Authenticate", tokenTypeBearer)
+		writeOAuthError(w, http.StatusUnauthorized, "invalid_token", "")
+
+		return
+	}
+
+	// Find user from token
+	user := p.verifyAccessToken(token)
+	// Check if user exists
+	if user == nil {
+		w.Header().Set("WWW-Authenticate", tokenTypeBearer+` error="invalid_token"`)
+		writeOAuthError(w, http.StatusUnauthorized, "invalid_token", "")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, p.userClaims(user))
+}
+
+func (p *provider) verifyAccessToken(token string) *User {
+	// Parse token
+	jws, err := jose.ParseSigned(token, []jose.SignatureAlgorithm{jose.RS256})
+	// Check error
+	if err != nil {
+		return nil
+	}
+
+	// Verify signature
+	payload, err := jws.Verify(p.key.Public())
+	// Check error
+	if err != nil {
+		return nil
+	}
+
+	var claims struct {
+		Subject   string `json:"sub"`
+		ExpiresAt int64  `json:"exp"`
+	}
+	// Decode claims
+	err = json.Unmarshal(payload, &claims)
+	// Check error
+	if err != nil || time.Unix(claims.ExpiresAt, 0).Before(time.Now()) {
+		return nil
+	}
+
+	// Find user by subject
+	for _, it := range p.users {
+		if subject(it) == claims.Subject {
+			return it
+		}
+	}
+
+	return nil
+}
+
+func (p *provider) userClaims(user *User) map[string]any {
+	// Copy user claims
+	res := maps.Clone(user.Claims)
+	// Check if there is no claims
+	if res == nil {
+		res = map[string]any{}
+	}
+
+	res["sub"] = subject(user)
+	// Add default username claim
+	if _, ok := res[preferredUsernameClaim]; !ok {
+		res[preferredUsernameClaim] = user.Username
+	}
+
+	return res
+}
+
+func (p *provider) sign(claims map[string]any) (string, error) {
+	// Json encode
+	b, err := json.Marshal(claims)
+	// Check error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	// Sign
+	jws, err := p.signer.Sign(b)
+	// Check error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	// Serialize
+	res, err := jws.CompactSerialize()
+	// Check error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return res, nil
+}
+
+func (p *provider) findClient(clientID string) *Client {
+	// Check client id
+	if clientID == "" {
+		return nil
+	}
+
+	// Any client is accepted when none are declared
+	if len(p.clients) == 0 {
+		return &Client{ID: clientID}
+	}
+
+	return p.clients[clientID]
+}
+
+func (p *provider) authenticateClient(r *http.Request) *Client {
+	// Get client credentials from basic authentication or from form
+	clientID, clientSecret, ok := r.BasicAuth()
+	// Check if basic authentication is used
+	if ok {
+		// Credentials are url encoded in basic authentication (RFC 6749 section 2.3.1)
+		clientID, _ = url.QueryUnescape(clientID)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	} else {
+		clientID = r.PostForm.Get("client_id")
+		clientSecret = r.PostForm.Get("client_secret")
+	}
+
+	// Find client
+	client := p.findClient(clientID)
+	// Check if client exists
+	if client == nil {
+		return nil
+	}
+
+	// Check secret for confidential clients
+	if client.Secret != "" && subtle.ConstantTimeCompare([]byte(client.Secret), []byte(clientSecret)) != 1 {
+		return nil
+	}
+
+	return client
+}
+
+func (p *provider) authenticateUser(username, password string) *User {
+	// Find user
+	user := p.users[username]
+	// Check user and password
+	if user == nil || subtle.ConstantTimeCompare([]byte(user.Password), []byte(password)) != 1 {
+		return nil
+	}
+
+	return user
+}
+
+// pruneExpired will remove expired codes and refresh tokens. Mutex must be locked.
+func (p *provider) pruneExpired() {
+	now := time.Now()
+
+	maps.DeleteFunc(p.codes, func(_ string, v *authorizationRequest) bool { return v.expiresAt.Before(now) })
+	maps.DeleteFunc(p.refreshTokens, func(_ string, v *refreshGrant) bool { return v.expiresAt.Before(now) })
+}
+
+func subject(user *User) string {
+	// Check if subject is set
+	if user.Subject != "" {
+		return user.Subject
+	}
+
+	return user.Username
+}
+
+func verifyCodeChallenge(challenge, method, verifier string) bool {
+	// Compute expected challenge
+	expected := verifier
+	// Check method
+	if method == pkceMethodS256 {
+		h := sha256.Sum256([]byte(verifier))
+		expected = base64.RawURLEncoding.EncodeToString(h[:])
+	}
+
+	return verifier != "" && subtle.ConstantTimeCompare([]byte(challenge), []byte(expected)) == 1
+}
+
+func generateRandomString() (string, error) {
+	b := make([]byte, randomLength)
+
+	_, err := rand.Read(b)
+	// Check error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	// Ignore error as headers are already sent
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeOAuthError(w http.ResponseWriter, status int, code, description string) {
+	body := map[string]string{"error": code}
+	// Add description if present
+	if description != "" {
+		body["error_description"] = description
+	}
+
+	writeJSON(w, status, body)
+}
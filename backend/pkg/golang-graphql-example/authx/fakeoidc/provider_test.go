@@ -0,0 +1,226 @@
+//go:build unit
+
+package fakeoidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func newTestServer(t *testing.T) *TestServer {
+	t.Helper()
+
+	ts, err := NewTestServer(&Options{
+		Users: []*User{
+			{Username: "user", Password: "password", Claims: map[string]any{"email": "user@example.com"}},
+			{Username: "admin", Password: "admin", Subject: "admin-id"},
+		},
+		Clients: []*Client{
+			{ID: "client", Secret: "secret", RedirectURLs: []string{"http://localhost/callback"}},
+			{ID: "public"},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(ts.Close)
+
+	return ts
+}
+
+func noRedirectClient() *http.Client {
+	return &http.Client{CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+}
+
+func Test_provider_authorizationCodeFlow(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.TODO()
+
+	// Discovery
+	provider, err := oidc.NewProvider(ctx, ts.IssuerURL())
+	require.NoError(t, err)
+
+	oauthCfg := &oauth2.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  "http://localhost/callback",
+		Scopes:       []string{oidc.ScopeOpenID},
+	}
+	verifier := oauth2.GenerateVerifier()
+	authURL := oauthCfg.AuthCodeURL("state", oauth2.S256ChallengeOption(verifier), oidc.Nonce("nonce"))
+
+	// Login page
+	resp, err := http.Get(authURL) //nolint:noctx // Test
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Wrong password
+	resp, err = noRedirectClient().PostForm(authURL, url.Values{"username": {"user"}, "password": {"wrong"}}) //nolint:noctx // Test
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// Login
+	resp, err = noRedirectClient().PostForm(authURL, url.Values{"username": {"user"}, "password": {"password"}}) //nolint:noctx // Test
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	cbURL, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, "state", cbURL.Query().Get("state"))
+
+	// Wrong code verifier
+	_, err = oauthCfg.Exchange(ctx, cbURL.Query().Get("code"), oauth2.VerifierOption(oauth2.GenerateVerifier()))
+	require.Error(t, err)
+
+	// Code is consumed even on failure, so log in again
+	resp, err = noRedirectClient().PostForm(authURL, url.Values{"username": {"user"}, "password": {"password"}}) //nolint:noctx // Test
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	cbURL, err = url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+
+	// Exchange
+	tok, err := oauthCfg.Exchange(ctx, cbURL.Query().Get("code"), oauth2.VerifierOption(verifier))
+	require.NoError(t, err)
+	assert.NotEmpty(t, tok.RefreshToken)
+
+	// Verify ID token
+	rawIDToken, _ := tok.Extra("id_token").(string)
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: "client"}).Verify(ctx, rawIDToken)
+	require.NoError(t, err)
+	assert.Equal(t, "nonce", idToken.Nonce)
+	assert.Equal(t, "user", idToken.Subject)
+
+	var claims map[string]any
+	require.NoError(t, idToken.Claims(&claims))
+	assert.Equal(t, "user@example.com", claims["email"])
+	assert.Equal(t, "user", claims["preferred_username"])
+
+	// Userinfo
+	userInfo, err := provider.UserInfo(ctx, oauth2.StaticTokenSource(tok))
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", userInfo.Email)
+
+	// Refresh with rotation
+	tok.Expiry = time.Now().Add(-time.Minute)
+	newTok, err := oauthCfg.TokenSource(ctx, tok).Token()
+	require.NoError(t, err)
+	assert.NotEqual(t, tok.RefreshToken, newTok.RefreshToken)
+
+	// Old refresh token cannot be reused
+	_, err = oauthCfg.TokenSource(ctx, &oauth2.Token{RefreshToken: tok.RefreshToken}).Token()
+	require.Error(t, err)
+}
+
+func Test_provider_authorizeErrors(t *testing.T) {
+	ts := newTestServer(t)
+
+	tests := []struct {
+		name  string
+		query url.Values
+	}{
+		{
+			name:  "unknown client",
+			query: url.Values{"response_type": {"code"}, "client_id": {"fake"}, "redirect_uri": {"http://localhost/callback"}},
+		},
+		{
+			name:  "redirect uri not allowed",
+			query: url.Values{"response_type": {"code"}, "client_id": {"client"}, "redirect_uri": {"http://evil.com/callback"}},
+		},
+		{
+			name:  "unsupported response type",
+			query: url.Values{"response_type": {"token"}, "client_id": {"client"}, "redirect_uri": {"http://localhost/callback"}},
+		},
+		{
+			name: "unsupported code challenge method",
+			query: url.Values{
+				"response_type":         {"code"},
+				"client_id":             {"client"},
+				"redirect_uri":          {"http://localhost/callback"},
+				"code_challenge":        {"challenge"},
+				"code_challenge_method": {"S512"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Get(ts.IssuerURL() + authorizePath + "?" + tt.query.Encode()) //nolint:noctx // Test
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
+
+func Test_provider_passwordGrant(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.TODO()
+
+	oauthCfg := &oauth2.Config{
+		ClientID: "public",
+		Endpoint: oauth2.Endpoint{TokenURL: ts.IssuerURL() + tokenPath, AuthStyle: oauth2.AuthStyleInParams},
+		Scopes:   []string{oidc.ScopeOpenID},
+	}
+
+	// Valid credentials
+	tok, err := oauthCfg.PasswordCredentialsToken(ctx, "admin", "admin")
+	require.NoError(t, err)
+
+	rawIDToken, _ := tok.Extra("id_token").(string)
+	verifier := oidc.NewVerifier(
+		ts.IssuerURL(),
+		oidc.NewRemoteKeySet(ctx, ts.IssuerURL()+jwksPath),
+		&oidc.Config{ClientID: "public"},
+	)
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	require.NoError(t, err)
+	assert.Equal(t, "admin-id", idToken.Subject)
+
+	// Invalid credentials
+	_, err = oauthCfg.PasswordCredentialsToken(ctx, "admin", "wrong")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid_grant")
+
+	// Invalid client secret
+	oauthCfg.ClientID = "client"
+	oauthCfg.ClientSecret = "wrong"
+	_, err = oauthCfg.PasswordCredentialsToken(ctx, "admin", "admin")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid_client")
+}
+
+func Test_provider_IssueTokens(t *testing.T) {
+	ts := newTestServer(t)
+
+	tok, err := ts.IssueTokens("user", "client")
+	require.NoError(t, err)
+	assert.Equal(t, tokenTypeBearer, tok.TokenType)
+	assert.Equal(t, 3, len(strings.Split(tok.IDToken, ".")))
+
+	_, err = ts.IssueTokens("unknown", "client")
+	require.ErrorIs(t, err, ErrUserNotFound)
+}
+
+func Test_NewProvider_issuerPath(t *testing.T) {
+	p, err := NewProvider(&Options{IssuerURL: "http://localhost:9090/fake-oidc/"})
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:9090/fake-oidc", p.IssuerURL())
+
+	_, err = NewProvider(&Options{IssuerURL: "/fake-oidc"})
+	require.Error(t, err)
+}
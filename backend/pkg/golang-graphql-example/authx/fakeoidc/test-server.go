@@ -0,0 +1,43 @@
+package fakeoidc
+
+import (
+	"net/http/httptest"
+)
+
+// TestServer is a fake provider served on a local random port.
+type TestServer struct {
+	Provider
+
+	server *httptest.Server
+}
+
+// NewTestServer will start a fake provider on a local random port.
+// Issuer url option is ignored and replaced by the server url.
+func NewTestServer(opts *Options) (*TestServer, error) {
+	// Create server to get listen address
+	srv := httptest.NewUnstartedServer(nil)
+
+	// Copy options to override issuer url
+	o := *opts
+	o.IssuerURL = "http://" + srv.Listener.Addr().String()
+
+	// Create provider
+	p, err := newProvider(&o)
+	// Check error
+	if err != nil {
+		srv.Close()
+
+		return nil, err
+	}
+
+	// Start server
+	srv.Config.Handler = p.Handler()
+	srv.Start()
+
+	return &TestServer{Provider: p, server: srv}, nil
+}
+
+// Close will stop the server.
+func (ts *TestServer) Close() {
+	ts.server.Close()
+}
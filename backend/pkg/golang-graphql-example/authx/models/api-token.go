@@ -0,0 +1,40 @@
+package models
+
+import "strings"
+
+const (
+	// APITokenPrefix is the prefix of all personal access tokens.
+	// It allows to distinguish them from OIDC JWT tokens in Authorization header.
+	APITokenPrefix = "ggepat_"
+	// APITokenAllScopes is the scope allowing all actions.
+	APITokenAllScopes           = "*"
+	apiTokenScopeWildcardSuffix = ":*"
+)
+
+// APITokenInfo represents the personal access token used to authenticate a user.
+type APITokenInfo struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+// IsActionAllowed will check if action is allowed by token scopes.
+// Scopes can be "*" for all actions, an exact action like "todo:Create"
+// or a prefix wildcard like "todo:*".
+func (ati *APITokenInfo) IsActionAllowed(action string) bool {
+	// Loop over scopes
+	for _, sc := range ati.Scopes {
+		// Check all scopes or exact match
+		if sc == APITokenAllScopes || sc == action {
+			return true
+		}
+
+		// Check wildcard
+		if strings.HasSuffix(sc, apiTokenScopeWildcardSuffix) &&
+			strings.HasPrefix(action, strings.TrimSuffix(sc, "*")) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"testing"
+)
+
+func TestAPITokenInfo_IsActionAllowed(t *testing.T) {
+	tests := []struct {
+		name   string
+		action string
+		scopes []string
+		want   bool
+	}{
+		{
+			name:   "no scopes",
+			action: "todo:Create",
+			want:   false,
+		},
+		{
+			name:   "all scopes",
+			action: "todo:Create",
+			scopes: []string{APITokenAllScopes},
+			want:   true,
+		},
+		{
+			name:   "exact scope",
+			action: "todo:Create",
+			scopes: []string{"todo:List", "todo:Create"},
+			want:   true,
+		},
+		{
+			name:   "exact scope not matching",
+			action: "todo:Close",
+			scopes: []string{"todo:List", "todo:Create"},
+			want:   false,
+		},
+		{
+			name:   "wildcard scope",
+			action: "todo:Close",
+			scopes: []string{"todo:*"},
+			want:   true,
+		},
+		{
+			name:   "wildcard scope on another prefix",
+			action: "todo:Close",
+			scopes: []string{"apitoken:*"},
+			want:   false,
+		},
+		{
+			name:   "wildcard scope must match full prefix",
+			action: "todos:Close",
+			scopes: []string{"todo:*"},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ati := &APITokenInfo{Scopes: tt.scopes}
+			if got := ati.IsActionAllowed(tt.action); got != tt.want {
+				t.Errorf("APITokenInfo.IsActionAllowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOIDCUser_IsActionAllowedByAPIToken(t *testing.T) {
+	u := &OIDCUser{}
+	if !u.IsActionAllowedByAPIToken("todo:Create") {
+		t.Error("OIDCUser.IsActionAllowedByAPIToken() must allow all actions without api token")
+	}
+
+	u.APIToken = &APITokenInfo{Scopes: []string{"todo:List"}}
+	if u.IsActionAllowedByAPIToken("todo:Create") {
+		t.Error("OIDCUser.IsActionAllowedByAPIToken() must restrict actions to api token scopes")
+	}
+}
@@ -0,0 +1,7 @@
+package models
+
+// AuthorizationCheck represents an authorization check of an action on a resource.
+type AuthorizationCheck struct {
+	Action   string
+	Resource string
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Impersonation is an audited impersonation session.
+type Impersonation struct {
+	ExpiresAt time.Time
+	ID        string
+	Target    string
+}
+
+// ImpersonatedRequest is a request done while impersonating a user.
+type ImpersonatedRequest struct {
+	Method string
+	URI    string
+}
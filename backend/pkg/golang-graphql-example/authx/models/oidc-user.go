@@ -1,23 +1,111 @@
 package models
 
+import "github.com/samber/lo"
+
+// IssuerIdentifierSeparator is the separator between issuer and identifier for principals coming from additional trusted issuers.
+const IssuerIdentifierSeparator = "#"
+
+const (
+	// PrincipalTypeUser is used for human users.
+	PrincipalTypeUser = "USER"
+	// PrincipalTypeService is used for service principals (machine to machine tokens).
+	PrincipalTypeService = "SERVICE"
+)
+
 type OIDCUser struct {
-	PreferredUsername string `json:"preferred_username"`
-	Name              string `json:"name"`
-	GivenName         string `json:"given_name"`
-	FamilyName        string `json:"family_name"`
-	Email             string `json:"email"`
-	OriginalToken     string `json:"-"`
-	EmailVerified     bool   `json:"email_verified"`
+	// APIToken is set when user is authenticated with a personal access token.
+	APIToken *APITokenInfo `json:"api_token,omitempty"`
+	// SessionID is set when user is authenticated with a server-side session.
+	SessionID string `json:"-"`
+	// Impersonator is the real authenticated actor when user is impersonated.
+	Impersonator *OIDCUser `json:"impersonator,omitempty"`
+	// ImpersonationID is the audited impersonation session identifier when user is impersonated.
+	ImpersonationID string `json:"impersonation_id,omitempty"`
+	// TrustedIssuer is set when principal is coming from an additional trusted issuer.
+	// Its identifier is qualified with its issuer to avoid collisions with main issuer principals.
+	TrustedIssuer bool `json:"trusted_issuer,omitempty"`
+	// ExtraClaims contains claims extracted with configured extra claim mapping.
+	ExtraClaims       map[string]any `json:"extra_claims,omitempty"`
+	PrincipalType     string         `json:"principal_type"`
+	Issuer            string         `json:"iss"`
+	Subject           string         `json:"sub"`
+	ClientID          string         `json:"client_id,omitempty"`
+	PreferredUsername string         `json:"preferred_username"`
+	Name              string         `json:"name"`
+	GivenName         string         `json:"given_name"`
+	FamilyName        string         `json:"family_name"`
+	Email             string         `json:"email"`
+	Tenant            string         `json:"tenant,omitempty"`
+	OriginalToken     string         `json:"-"`
+	Roles             []string       `json:"roles,omitempty"`
+	Groups            []string       `json:"groups,omitempty"`
+	EmailVerified     bool           `json:"email_verified"`
 }
 
 func (u *OIDCUser) GetAuthorizationHeader() string {
 	return "Bearer " + u.OriginalToken
 }
 
+// GetIdentifier will return the principal identifier used as owner, subject and bucket key.
+// Identifiers of principals coming from additional trusted issuers are prefixed with "<issuer>#".
 func (u *OIDCUser) GetIdentifier() string {
+	// Check if principal is coming from an additional trusted issuer
+	if u.TrustedIssuer {
+		return u.Issuer + IssuerIdentifierSeparator + u.getLocalIdentifier()
+	}
+
+	return u.getLocalIdentifier()
+}
+
+// getLocalIdentifier will return the identifier in its issuer.
+func (u *OIDCUser) getLocalIdentifier() string {
 	if u.PreferredUsername != "" {
 		return u.PreferredUsername
 	}
 
-	return u.Email
+	if u.Email != "" {
+		return u.Email
+	}
+
+	// Service principals usually don't have username or email
+	if u.ClientID != "" {
+		return u.ClientID
+	}
+
+	return u.Subject
+}
+
+// IsServicePrincipal will return true if principal isn't a human user.
+func (u *OIDCUser) IsServicePrincipal() bool {
+	return u.PrincipalType == PrincipalTypeService
+}
+
+// IsImpersonated will return true if user is impersonated by another one.
+func (u *OIDCUser) IsImpersonated() bool {
+	return u.Impersonator != nil
+}
+
+// GetActor will return the real authenticated actor.
+// This is the impersonator when user is impersonated, the user itself otherwise.
+func (u *OIDCUser) GetActor() *OIDCUser {
+	if u.Impersonator != nil {
+		return u.Impersonator
+	}
+
+	return u
+}
+
+// HasAnyRole will return true if user has at least one of the given roles.
+func (u *OIDCUser) HasAnyRole(roles []string) bool {
+	return lo.Some(u.Roles, roles)
+}
+
+// IsActionAllowedByAPIToken will check if action is allowed by personal access token scopes.
+// If user isn't authenticated with a personal access token, all actions are allowed.
+func (u *OIDCUser) IsActionAllowedByAPIToken(action string) bool {
+	if u.APIToken == nil {
+		return true
+	}
+
+	return u.APIToken.IsActionAllowed(action)
 }
@@ -11,7 +11,11 @@ func TestOIDCUser_GetIdentifier(t *testing.T) {
 		GivenName         string
 		FamilyName        string
 		Email             string
+		ClientID          string
+		Subject           string
+		Issuer            string
 		EmailVerified     bool
+		TrustedIssuer     bool
 	}
 
 	tests := []struct {
@@ -43,6 +47,21 @@ func TestOIDCUser_GetIdentifier(t *testing.T) {
 			},
 			want: "email",
 		},
+		{
+			name: "service principal with client id",
+			fields: fields{
+				ClientID: "client",
+				Subject:  "subject",
+			},
+			want: "client",
+		},
+		{
+			name: "subject only",
+			fields: fields{
+				Subject: "subject",
+			},
+			want: "subject",
+		},
 		{
 			name: "all set",
 			fields: fields{
@@ -51,6 +70,23 @@ func TestOIDCUser_GetIdentifier(t *testing.T) {
 			},
 			want: "username",
 		},
+		{
+			name: "trusted issuer principal",
+			fields: fields{
+				PreferredUsername: "username",
+				Issuer:            "https://partner.example.com",
+				TrustedIssuer:     true,
+			},
+			want: "https://partner.example.com#username",
+		},
+		{
+			name: "main issuer principal",
+			fields: fields{
+				PreferredUsername: "username",
+				Issuer:            "https://main.example.com",
+			},
+			want: "username",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -60,7 +96,11 @@ func TestOIDCUser_GetIdentifier(t *testing.T) {
 				GivenName:         tt.fields.GivenName,
 				FamilyName:        tt.fields.FamilyName,
 				Email:             tt.fields.Email,
+				ClientID:          tt.fields.ClientID,
+				Subject:           tt.fields.Subject,
+				Issuer:            tt.fields.Issuer,
 				EmailVerified:     tt.fields.EmailVerified,
+				TrustedIssuer:     tt.fields.TrustedIssuer,
 			}
 			if got := u.GetIdentifier(); got != tt.want {
 				t.Errorf("OIDCUser.GetIdentifier() = %v, want %v", got, tt.want)
@@ -101,3 +141,60 @@ func TestOIDCUser_GetAuthorizationHeader(t *testing.T) {
 		})
 	}
 }
+
+func TestOIDCUser_GetActor(t *testing.T) {
+	actor := &OIDCUser{PreferredUsername: "admin"}
+	impersonated := &OIDCUser{PreferredUsername: "user", Impersonator: actor}
+	user := &OIDCUser{PreferredUsername: "user"}
+
+	if got := impersonated.GetActor(); got != actor {
+		t.Errorf("OIDCUser.GetActor() = %v, want %v", got, actor)
+	}
+
+	if !impersonated.IsImpersonated() {
+		t.Errorf("OIDCUser.IsImpersonated() = false, want true")
+	}
+
+	if got := user.GetActor(); got != user {
+		t.Errorf("OIDCUser.GetActor() = %v, want %v", got, user)
+	}
+
+	if user.IsImpersonated() {
+		t.Errorf("OIDCUser.IsImpersonated() = true, want false")
+	}
+}
+
+func TestOIDCUser_HasAnyRole(t *testing.T) {
+	tests := []struct {
+		name  string
+		roles []string
+		input []string
+		want  bool
+	}{
+		{
+			name:  "no roles",
+			input: []string{"admin"},
+			want:  false,
+		},
+		{
+			name:  "matching role",
+			roles: []string{"reader", "admin"},
+			input: []string{"admin", "support"},
+			want:  true,
+		},
+		{
+			name:  "not matching role",
+			roles: []string{"reader"},
+			input: []string{"admin"},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &OIDCUser{Roles: tt.roles}
+			if got := u.HasAnyRole(tt.input); got != tt.want {
+				t.Errorf("OIDCUser.HasAnyRole() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
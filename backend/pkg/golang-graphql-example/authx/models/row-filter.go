@@ -0,0 +1,23 @@
+package models
+
+import "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+
+// RowFilter represents row level authorization constraints.
+// Conditions is a list of alternatives (OR) where each alternative is a list of conditions (AND).
+type RowFilter struct {
+	Conditions [][]*RowFilterCondition
+	// AllowAll is set when all rows are authorized
+	AllowAll bool
+}
+
+// RowFilterCondition represents a constraint on a resource field.
+type RowFilterCondition struct {
+	Filter *common.GenericFilter
+	// Field is the resource field name (database column name)
+	Field string
+}
+
+// IsDenied will return true when no row is authorized.
+func (rf *RowFilter) IsDenied() bool {
+	return !rf.AllowAll && len(rf.Conditions) == 0
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// SessionTokenPrefix is the prefix of all opaque session tokens.
+// It allows to distinguish them from OIDC JWT tokens in cookie.
+const SessionTokenPrefix = "ggesess_"
+
+// SessionTokens represents OIDC tokens kept on server side for a session.
+type SessionTokens struct {
+	// Expiry is the expiration date of the ID token
+	Expiry       time.Time
+	IDToken      string
+	AccessToken  string
+	RefreshToken string
+}
+
+// SessionInput represents data needed to create a server-side session.
+type SessionInput struct {
+	User      *OIDCUser
+	Tokens    *SessionTokens
+	UserAgent string
+	IPAddress string
+}
+
+// Session represents a server-side session.
+type Session struct {
+	Tokens    *SessionTokens
+	ExpiresAt time.Time
+	ID        string
+}
+
+// IsTokenExpiringBefore will check if ID token expires before the given date.
+func (s *Session) IsTokenExpiringBefore(t time.Time) bool {
+	return s.Tokens == nil || !s.Tokens.Expiry.After(t)
+}
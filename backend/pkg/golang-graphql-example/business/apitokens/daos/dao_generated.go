@@ -0,0 +1,115 @@
+// Code generated by daogen, DO NOT EDIT.
+package daos
+
+import (
+	"context"
+	models0 "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/models"
+	database "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	helpers "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/helpers"
+	pagination "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
+)
+
+/* Interface */
+
+// Dao for structure APIToken
+type APITokenStructureDao interface {
+	FindAPITokenByID(ctx context.Context, id string, projection *models0.Projection, opts ...helpers.GormOpt) (*models0.APIToken, error)
+	FindOneAPIToken(ctx context.Context, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) (*models0.APIToken, error)
+	FindAPITokenWithPagination(ctx context.Context, page *pagination.PageInput, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) ([]*models0.APIToken, error)
+	FindAPITokenPaginated(ctx context.Context, page *pagination.PageInput, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...database.TransactionOption) ([]*models0.APIToken, *pagination.PageOutput, error)
+	FindAllAPIToken(ctx context.Context, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) ([]*models0.APIToken, error)
+	CountAPITokenPaginated(ctx context.Context, page *pagination.PageInput, filter *models0.Filter, opts ...helpers.GormOpt) (int64, error)
+	CountAPIToken(ctx context.Context, filter *models0.Filter, opts ...helpers.GormOpt) (int64, error)
+	CreateOrUpdateAPIToken(ctx context.Context, input *models0.APIToken, opts ...helpers.GormOpt) (*models0.APIToken, error)
+	PermanentDeleteAPIToken(ctx context.Context, input *models0.APIToken, opts ...helpers.GormOpt) (*models0.APIToken, error)
+	PermanentDeleteAPITokenByID(ctx context.Context, id string, opts ...helpers.GormOpt) (*models0.APIToken, error)
+	PermanentDeleteAPITokenFiltered(ctx context.Context, filter *models0.Filter, opts ...helpers.GormOpt) error
+	PatchUpdateAPIToken(ctx context.Context, input *models0.APIToken, patch map[string]any, opts ...helpers.GormOpt) (*models0.APIToken, error)
+	PatchUpdateAPITokenByID(ctx context.Context, id string, patch map[string]any, opts ...helpers.GormOpt) (*models0.APIToken, error)
+	PatchUpdateAPITokenFiltered(ctx context.Context, filter *models0.Filter, patch map[string]any, opts ...helpers.GormOpt) error
+}
+
+// General Dao
+type Dao interface {
+	APITokenStructureDao
+}
+
+/* New */
+
+func NewDao(db database.DB) Dao {
+	return &dao{db: db}
+}
+
+/* Structure */
+
+type dao struct {
+	db database.DB
+}
+
+/* Functions */
+
+// Starting methods for APIToken structure
+
+func (d *dao) FindAPITokenByID(ctx context.Context, id string, projection *models0.Projection, opts ...helpers.GormOpt) (*models0.APIToken, error) {
+	return helpers.FindByID(ctx, &models0.APIToken{}, d.db, id, projection, opts...)
+}
+
+func (d *dao) FindOneAPIToken(ctx context.Context, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) (*models0.APIToken, error) {
+	return helpers.FindOne(ctx, &models0.APIToken{}, d.db, sorts, filter, projection, opts...)
+}
+
+func (d *dao) FindAPITokenWithPagination(ctx context.Context, page *pagination.PageInput, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) ([]*models0.APIToken, error) {
+	return helpers.FindWithPagination(ctx, []*models0.APIToken{}, d.db, page, sorts, filter, projection, opts...)
+}
+
+func (d *dao) FindAPITokenPaginated(ctx context.Context, page *pagination.PageInput, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...database.TransactionOption) ([]*models0.APIToken, *pagination.PageOutput, error) {
+	return helpers.GetAllPaginated(ctx, []*models0.APIToken{}, d.db, page, sorts, filter, projection, opts...)
+}
+
+func (d *dao) FindAllAPIToken(ctx context.Context, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) ([]*models0.APIToken, error) {
+	return helpers.Find(ctx, []*models0.APIToken{}, d.db, sorts, filter, projection, opts...)
+}
+
+func (d *dao) CountAPITokenPaginated(ctx context.Context, page *pagination.PageInput, filter *models0.Filter, opts ...helpers.GormOpt) (int64, error) {
+	return helpers.CountPaginated(ctx, d.db, &models0.APIToken{}, page, filter, opts...)
+}
+
+func (d *dao) CountAPIToken(ctx context.Context, filter *models0.Filter, opts ...helpers.GormOpt) (int64, error) {
+	return helpers.Count(ctx, d.db, &models0.APIToken{}, filter, opts...)
+}
+
+func (d *dao) CreateOrUpdateAPIToken(ctx context.Context, input *models0.APIToken, opts ...helpers.GormOpt) (*models0.APIToken, error) {
+	return helpers.CreateOrUpdate(ctx, input, d.db, opts...)
+}
+
+func (d *dao) PermanentDeleteAPIToken(ctx context.Context, input *models0.APIToken, opts ...helpers.GormOpt) (*models0.APIToken, error) {
+	return helpers.PermanentDelete(ctx, input, d.db, opts...)
+}
+
+func (d *dao) PermanentDeleteAPITokenByID(ctx context.Context, id string, opts ...helpers.GormOpt) (*models0.APIToken, error) {
+	input := &models0.APIToken{}
+	input.ID = id
+
+	return helpers.PermanentDelete(ctx, input, d.db, opts...)
+}
+
+func (d *dao) PermanentDeleteAPITokenFiltered(ctx context.Context, filter *models0.Filter, opts ...helpers.GormOpt) error {
+	return helpers.PermanentDeleteFiltered(ctx, &models0.APIToken{}, filter, d.db, opts...)
+}
+
+func (d *dao) PatchUpdateAPIToken(ctx context.Context, input *models0.APIToken, patch map[string]any, opts ...helpers.GormOpt) (*models0.APIToken, error) {
+	return helpers.PatchUpdate(ctx, input, patch, d.db, opts...)
+}
+
+func (d *dao) PatchUpdateAPITokenByID(ctx context.Context, id string, patch map[string]any, opts ...helpers.GormOpt) (*models0.APIToken, error) {
+	input := &models0.APIToken{}
+	input.ID = id
+
+	return helpers.PatchUpdate(ctx, input, patch, d.db, opts...)
+}
+
+func (d *dao) PatchUpdateAPITokenFiltered(ctx context.Context, filter *models0.Filter, patch map[string]any, opts ...helpers.GormOpt) error {
+	return helpers.PatchUpdateAllFiltered(ctx, &models0.APIToken{}, patch, filter, d.db, opts...)
+}
+
+// Ending methods for APIToken structure
@@ -0,0 +1,3 @@
+package daos
+
+//go:generate mockgen -destination=./mocks/mock_Dao.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/daos Dao
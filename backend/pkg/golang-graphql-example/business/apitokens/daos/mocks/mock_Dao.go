@@ -0,0 +1,324 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/daos (interfaces: Dao)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_Dao.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/daos Dao
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/models"
+	database "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	databasehelpers "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/helpers"
+	pagination "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDao is a mock of Dao interface.
+type MockDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockDaoMockRecorder
+	isgomock struct{}
+}
+
+// MockDaoMockRecorder is the mock recorder for MockDao.
+type MockDaoMockRecorder struct {
+	mock *MockDao
+}
+
+// NewMockDao creates a new mock instance.
+func NewMockDao(ctrl *gomock.Controller) *MockDao {
+	mock := &MockDao{ctrl: ctrl}
+	mock.recorder = &MockDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDao) EXPECT() *MockDaoMockRecorder {
+	return m.recorder
+}
+
+// CountAPIToken mocks base method.
+func (m *MockDao) CountAPIToken(ctx context.Context, filter *models.Filter, opts ...databasehelpers.GormOpt) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountAPIToken", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAPIToken indicates an expected call of CountAPIToken.
+func (mr *MockDaoMockRecorder) CountAPIToken(ctx, filter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAPIToken", reflect.TypeOf((*MockDao)(nil).CountAPIToken), varargs...)
+}
+
+// CountAPITokenPaginated mocks base method.
+func (m *MockDao) CountAPITokenPaginated(ctx context.Context, page *pagination.PageInput, filter *models.Filter, opts ...databasehelpers.GormOpt) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, page, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountAPITokenPaginated", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAPITokenPaginated indicates an expected call of CountAPITokenPaginated.
+func (mr *MockDaoMockRecorder) CountAPITokenPaginated(ctx, page, filter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, page, filter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAPITokenPaginated", reflect.TypeOf((*MockDao)(nil).CountAPITokenPaginated), varargs...)
+}
+
+// CreateOrUpdateAPIToken mocks base method.
+func (m *MockDao) CreateOrUpdateAPIToken(ctx context.Context, input *models.APIToken, opts ...databasehelpers.GormOpt) (*models.APIToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateOrUpdateAPIToken", varargs...)
+	ret0, _ := ret[0].(*models.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateAPIToken indicates an expected call of CreateOrUpdateAPIToken.
+func (mr *MockDaoMockRecorder) CreateOrUpdateAPIToken(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateAPIToken", reflect.TypeOf((*MockDao)(nil).CreateOrUpdateAPIToken), varargs...)
+}
+
+// FindAPITokenByID mocks base method.
+func (m *MockDao) FindAPITokenByID(ctx context.Context, id string, projection *models.Projection, opts ...databasehelpers.GormOpt) (*models.APIToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAPITokenByID", varargs...)
+	ret0, _ := ret[0].(*models.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAPITokenByID indicates an expected call of FindAPITokenByID.
+func (mr *MockDaoMockRecorder) FindAPITokenByID(ctx, id, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPITokenByID", reflect.TypeOf((*MockDao)(nil).FindAPITokenByID), varargs...)
+}
+
+// FindAPITokenPaginated mocks base method.
+func (m *MockDao) FindAPITokenPaginated(ctx context.Context, page *pagination.PageInput, sorts []*models.SortOrder, filter *models.Filter, projection *models.Projection, opts ...database.TransactionOption) ([]*models.APIToken, *pagination.PageOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, page, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAPITokenPaginated", varargs...)
+	ret0, _ := ret[0].([]*models.APIToken)
+	ret1, _ := ret[1].(*pagination.PageOutput)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAPITokenPaginated indicates an expected call of FindAPITokenPaginated.
+func (mr *MockDaoMockRecorder) FindAPITokenPaginated(ctx, page, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, page, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPITokenPaginated", reflect.TypeOf((*MockDao)(nil).FindAPITokenPaginated), varargs...)
+}
+
+// FindAPITokenWithPagination mocks base method.
+func (m *MockDao) FindAPITokenWithPagination(ctx context.Context, page *pagination.PageInput, sorts []*models.SortOrder, filter *models.Filter, projection *models.Projection, opts ...databasehelpers.GormOpt) ([]*models.APIToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, page, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAPITokenWithPagination", varargs...)
+	ret0, _ := ret[0].([]*models.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAPITokenWithPagination indicates an expected call of FindAPITokenWithPagination.
+func (mr *MockDaoMockRecorder) FindAPITokenWithPagination(ctx, page, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, page, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPITokenWithPagination", reflect.TypeOf((*MockDao)(nil).FindAPITokenWithPagination), varargs...)
+}
+
+// FindAllAPIToken mocks base method.
+func (m *MockDao) FindAllAPIToken(ctx context.Context, sorts []*models.SortOrder, filter *models.Filter, projection *models.Projection, opts ...databasehelpers.GormOpt) ([]*models.APIToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAllAPIToken", varargs...)
+	ret0, _ := ret[0].([]*models.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllAPIToken indicates an expected call of FindAllAPIToken.
+func (mr *MockDaoMockRecorder) FindAllAPIToken(ctx, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllAPIToken", reflect.TypeOf((*MockDao)(nil).FindAllAPIToken), varargs...)
+}
+
+// FindOneAPIToken mocks base method.
+func (m *MockDao) FindOneAPIToken(ctx context.Context, sorts []*models.SortOrder, filter *models.Filter, projection *models.Projection, opts ...databasehelpers.GormOpt) (*models.APIToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindOneAPIToken", varargs...)
+	ret0, _ := ret[0].(*models.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneAPIToken indicates an expected call of FindOneAPIToken.
+func (mr *MockDaoMockRecorder) FindOneAPIToken(ctx, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneAPIToken", reflect.TypeOf((*MockDao)(nil).FindOneAPIToken), varargs...)
+}
+
+// PatchUpdateAPIToken mocks base method.
+func (m *MockDao) PatchUpdateAPIToken(ctx context.Context, input *models.APIToken, patch map[string]any, opts ...databasehelpers.GormOpt) (*models.APIToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchUpdateAPIToken", varargs...)
+	ret0, _ := ret[0].(*models.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchUpdateAPIToken indicates an expected call of PatchUpdateAPIToken.
+func (mr *MockDaoMockRecorder) PatchUpdateAPIToken(ctx, input, patch any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpdateAPIToken", reflect.TypeOf((*MockDao)(nil).PatchUpdateAPIToken), varargs...)
+}
+
+// PatchUpdateAPITokenByID mocks base method.
+func (m *MockDao) PatchUpdateAPITokenByID(ctx context.Context, id string, patch map[string]any, opts ...databasehelpers.GormOpt) (*models.APIToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchUpdateAPITokenByID", varargs...)
+	ret0, _ := ret[0].(*models.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchUpdateAPITokenByID indicates an expected call of PatchUpdateAPITokenByID.
+func (mr *MockDaoMockRecorder) PatchUpdateAPITokenByID(ctx, id, patch any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpdateAPITokenByID", reflect.TypeOf((*MockDao)(nil).PatchUpdateAPITokenByID), varargs...)
+}
+
+// PatchUpdateAPITokenFiltered mocks base method.
+func (m *MockDao) PatchUpdateAPITokenFiltered(ctx context.Context, filter *models.Filter, patch map[string]any, opts ...databasehelpers.GormOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchUpdateAPITokenFiltered", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchUpdateAPITokenFiltered indicates an expected call of PatchUpdateAPITokenFiltered.
+func (mr *MockDaoMockRecorder) PatchUpdateAPITokenFiltered(ctx, filter, patch any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpdateAPITokenFiltered", reflect.TypeOf((*MockDao)(nil).PatchUpdateAPITokenFiltered), varargs...)
+}
+
+// PermanentDeleteAPIToken mocks base method.
+func (m *MockDao) PermanentDeleteAPIToken(ctx context.Context, input *models.APIToken, opts ...databasehelpers.GormOpt) (*models.APIToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentDeleteAPIToken", varargs...)
+	ret0, _ := ret[0].(*models.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PermanentDeleteAPIToken indicates an expected call of PermanentDeleteAPIToken.
+func (mr *MockDaoMockRecorder) PermanentDeleteAPIToken(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteAPIToken", reflect.TypeOf((*MockDao)(nil).PermanentDeleteAPIToken), varargs...)
+}
+
+// PermanentDeleteAPITokenByID mocks base method.
+func (m *MockDao) PermanentDeleteAPITokenByID(ctx context.Context, id string, opts ...databasehelpers.GormOpt) (*models.APIToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentDeleteAPITokenByID", varargs...)
+	ret0, _ := ret[0].(*models.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PermanentDeleteAPITokenByID indicates an expected call of PermanentDeleteAPITokenByID.
+func (mr *MockDaoMockRecorder) PermanentDeleteAPITokenByID(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteAPITokenByID", reflect.TypeOf((*MockDao)(nil).PermanentDeleteAPITokenByID), varargs...)
+}
+
+// PermanentDeleteAPITokenFiltered mocks base method.
+func (m *MockDao) PermanentDeleteAPITokenFiltered(ctx context.Context, filter *models.Filter, opts ...databasehelpers.GormOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentDeleteAPITokenFiltered", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PermanentDeleteAPITokenFiltered indicates an expected call of PermanentDeleteAPITokenFiltered.
+func (mr *MockDaoMockRecorder) PermanentDeleteAPITokenFiltered(ctx, filter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteAPITokenFiltered", reflect.TypeOf((*MockDao)(nil).PermanentDeleteAPITokenFiltered), varargs...)
+}
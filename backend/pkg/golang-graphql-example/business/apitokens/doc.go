@@ -0,0 +1,3 @@
+package apitokens
+
+// This package will manage business of personal access tokens
@@ -0,0 +1,45 @@
+package apitokens
+
+import (
+	"context"
+	"time"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/daos"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+)
+
+//go:generate mockgen -destination=./mocks/mock_AuthorizationService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens AuthorizationService
+type AuthorizationService interface {
+	CheckAuthorized(ctx context.Context, action, resource string) error
+}
+
+//go:generate mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens Service
+type Service interface {
+	// FindForCurrentUser will list personal access tokens of the authenticated user.
+	FindForCurrentUser(ctx context.Context, projection *models.Projection) ([]*models.APIToken, error)
+	// Create will create a personal access token for the authenticated user.
+	// The plain text token is only returned here and never stored.
+	// Expiration date is set to the configured maximum duration when missing.
+	Create(ctx context.Context, inp *InputCreateAPIToken) (*models.APIToken, string, error)
+	// Revoke will revoke a personal access token of the authenticated user.
+	Revoke(ctx context.Context, id string, projection *models.Projection) (*models.APIToken, error)
+	// AuthenticateAPIToken will validate a plain text token and return the associated principal.
+	AuthenticateAPIToken(ctx context.Context, token string) (*authxmodels.OIDCUser, error)
+}
+
+type InputCreateAPIToken struct {
+	ExpiresAt *time.Time
+	Name      string
+	Scopes    []string
+}
+
+func NewService(cfgManager config.Manager, db database.DB, authSvc AuthorizationService) Service {
+	// Create dao
+	dao := daos.NewDao(db)
+
+	return &service{cfgManager: cfgManager, dao: dao, authSvc: authSvc}
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens (interfaces: AuthorizationService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_AuthorizationService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens AuthorizationService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthorizationService is a mock of AuthorizationService interface.
+type MockAuthorizationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorizationServiceMockRecorder is the mock recorder for MockAuthorizationService.
+type MockAuthorizationServiceMockRecorder struct {
+	mock *MockAuthorizationService
+}
+
+// NewMockAuthorizationService creates a new mock instance.
+func NewMockAuthorizationService(ctrl *gomock.Controller) *MockAuthorizationService {
+	mock := &MockAuthorizationService{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationService) EXPECT() *MockAuthorizationServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckAuthorized mocks base method.
+func (m *MockAuthorizationService) CheckAuthorized(ctx context.Context, action, resource string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAuthorized", ctx, action, resource)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAuthorized indicates an expected call of CheckAuthorized.
+func (mr *MockAuthorizationServiceMockRecorder) CheckAuthorized(ctx, action, resource any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAuthorized", reflect.TypeOf((*MockAuthorizationService)(nil).CheckAuthorized), ctx, action, resource)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens Service
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	apitokens "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens"
+	models0 "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateAPIToken mocks base method.
+func (m *MockService) AuthenticateAPIToken(ctx context.Context, token string) (*models.OIDCUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIToken", ctx, token)
+	ret0, _ := ret[0].(*models.OIDCUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIToken indicates an expected call of AuthenticateAPIToken.
+func (mr *MockServiceMockRecorder) AuthenticateAPIToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIToken", reflect.TypeOf((*MockService)(nil).AuthenticateAPIToken), ctx, token)
+}
+
+// Create mocks base method.
+func (m *MockService) Create(ctx context.Context, inp *apitokens.InputCreateAPIToken) (*models0.APIToken, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, inp)
+	ret0, _ := ret[0].(*models0.APIToken)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceMockRecorder) Create(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, inp)
+}
+
+// FindForCurrentUser mocks base method.
+func (m *MockService) FindForCurrentUser(ctx context.Context, projection *models0.Projection) ([]*models0.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindForCurrentUser", ctx, projection)
+	ret0, _ := ret[0].([]*models0.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindForCurrentUser indicates an expected call of FindForCurrentUser.
+func (mr *MockServiceMockRecorder) FindForCurrentUser(ctx, projection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindForCurrentUser", reflect.TypeOf((*MockService)(nil).FindForCurrentUser), ctx, projection)
+}
+
+// Revoke mocks base method.
+func (m *MockService) Revoke(ctx context.Context, id string, projection *models0.Projection) (*models0.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, projection)
+	ret0, _ := ret[0].(*models0.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockServiceMockRecorder) Revoke(ctx, id, projection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockService)(nil).Revoke), ctx, id, projection)
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+)
+
+//go:generate go run github.com/oxyno-zeta/golang-graphql-example/tools/generator/modeltagsgen github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/models APIToken
+type APIToken struct {
+	database.Base
+	ExpiresAt   *time.Time
+	RevokedAt   *time.Time
+	LastUsedAt  *time.Time
+	Name        string   `gorm:"type:varchar(200)"`
+	Owner       string   `gorm:"type:varchar(500);index"`
+	OwnerEmail  string   `gorm:"type:varchar(500)"`
+	OwnerName   string   `gorm:"type:varchar(500)"`
+	OwnerIssuer string   `gorm:"type:varchar(500)"`
+	OwnerTenant string   `gorm:"type:varchar(500)"`
+	TokenPrefix string   `gorm:"type:varchar(20)"`
+	TokenHash   string   `gorm:"type:varchar(64);uniqueIndex"`
+	Scopes      []string `gorm:"type:text;serializer:json"`
+	// Owner roles and groups are saved at creation to be used by authorization engines
+	OwnerRoles  []string `gorm:"type:text;serializer:json"`
+	OwnerGroups []string `gorm:"type:text;serializer:json"`
+}
+
+// IsExpired will check if token is expired.
+func (t *APIToken) IsExpired() bool {
+	return t.ExpiresAt != nil && !t.ExpiresAt.After(time.Now())
+}
+
+// IsRevoked will check if token is revoked.
+func (t *APIToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
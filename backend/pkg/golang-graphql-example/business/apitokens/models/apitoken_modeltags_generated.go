@@ -0,0 +1,556 @@
+// Code generated by ModelTags, DO NOT EDIT.
+package models
+
+import errors "emperror.dev/errors"
+
+// ErrAPITokenUnsupportedGormColumn will be thrown when an unsupported Gorm column will be found in transform function.
+var ErrAPITokenUnsupportedGormColumn = errors.Sentinel("unsupported gorm column")
+
+// ErrAPITokenUnsupportedJSONKey will be thrown when an unsupported JSON key will be found in transform function.
+var ErrAPITokenUnsupportedJSONKey = errors.Sentinel("unsupported json key")
+
+// ErrAPITokenUnsupportedStructKeyName will be thrown when an unsupported structure key will be found in transform function.
+var ErrAPITokenUnsupportedStructKeyName = errors.Sentinel("unsupported struct key")
+
+/* Gorm columns Names */
+// APIToken CreatedAt Gorm Column Name
+const APITokenCreatedAtGormColumnName = "created_at"
+
+// APIToken DeletedAt Gorm Column Name
+const APITokenDeletedAtGormColumnName = "deleted_at"
+
+// APIToken ExpiresAt Gorm Column Name
+const APITokenExpiresAtGormColumnName = "expires_at"
+
+// APIToken ID Gorm Column Name
+const APITokenIDGormColumnName = "id"
+
+// APIToken LastUsedAt Gorm Column Name
+const APITokenLastUsedAtGormColumnName = "last_used_at"
+
+// APIToken Name Gorm Column Name
+const APITokenNameGormColumnName = "name"
+
+// APIToken Owner Gorm Column Name
+const APITokenOwnerGormColumnName = "owner"
+
+// APIToken OwnerEmail Gorm Column Name
+const APITokenOwnerEmailGormColumnName = "owner_email"
+
+// APIToken OwnerGroups Gorm Column Name
+const APITokenOwnerGroupsGormColumnName = "owner_groups"
+
+// APIToken OwnerIssuer Gorm Column Name
+const APITokenOwnerIssuerGormColumnName = "owner_issuer"
+
+// APIToken OwnerName Gorm Column Name
+const APITokenOwnerNameGormColumnName = "owner_name"
+
+// APIToken OwnerRoles Gorm Column Name
+const APITokenOwnerRolesGormColumnName = "owner_roles"
+
+// APIToken OwnerTenant Gorm Column Name
+const APITokenOwnerTenantGormColumnName = "owner_tenant"
+
+// APIToken RevokedAt Gorm Column Name
+const APITokenRevokedAtGormColumnName = "revoked_at"
+
+// APIToken Scopes Gorm Column Name
+const APITokenScopesGormColumnName = "scopes"
+
+// APIToken TokenHash Gorm Column Name
+const APITokenTokenHashGormColumnName = "token_hash"
+
+// APIToken TokenPrefix Gorm Column Name
+const APITokenTokenPrefixGormColumnName = "token_prefix"
+
+// APIToken UpdatedAt Gorm Column Name
+const APITokenUpdatedAtGormColumnName = "updated_at"
+
+var APITokenGormColumnNameList = []string{APITokenCreatedAtGormColumnName, APITokenDeletedAtGormColumnName, APITokenExpiresAtGormColumnName, APITokenIDGormColumnName, APITokenLastUsedAtGormColumnName, APITokenNameGormColumnName, APITokenOwnerGormColumnName, APITokenOwnerEmailGormColumnName, APITokenOwnerGroupsGormColumnName, APITokenOwnerIssuerGormColumnName, APITokenOwnerNameGormColumnName, APITokenOwnerRolesGormColumnName, APITokenOwnerTenantGormColumnName, APITokenRevokedAtGormColumnName, APITokenScopesGormColumnName, APITokenTokenHashGormColumnName, APITokenTokenPrefixGormColumnName, APITokenUpdatedAtGormColumnName}
+
+/* JSON Key Names */
+// APIToken CreatedAt JSON Key Name
+const APITokenCreatedAtJSONKeyName = "createdAt"
+
+// APIToken DeletedAt JSON Key Name
+const APITokenDeletedAtJSONKeyName = "deletedAt"
+
+// APIToken ExpiresAt JSON Key Name
+const APITokenExpiresAtJSONKeyName = "ExpiresAt"
+
+// APIToken ID JSON Key Name
+const APITokenIDJSONKeyName = "id"
+
+// APIToken LastUsedAt JSON Key Name
+const APITokenLastUsedAtJSONKeyName = "LastUsedAt"
+
+// APIToken Name JSON Key Name
+const APITokenNameJSONKeyName = "Name"
+
+// APIToken Owner JSON Key Name
+const APITokenOwnerJSONKeyName = "Owner"
+
+// APIToken OwnerEmail JSON Key Name
+const APITokenOwnerEmailJSONKeyName = "OwnerEmail"
+
+// APIToken OwnerGroups JSON Key Name
+const APITokenOwnerGroupsJSONKeyName = "OwnerGroups"
+
+// APIToken OwnerIssuer JSON Key Name
+const APITokenOwnerIssuerJSONKeyName = "OwnerIssuer"
+
+// APIToken OwnerName JSON Key Name
+const APITokenOwnerNameJSONKeyName = "OwnerName"
+
+// APIToken OwnerRoles JSON Key Name
+const APITokenOwnerRolesJSONKeyName = "OwnerRoles"
+
+// APIToken OwnerTenant JSON Key Name
+const APITokenOwnerTenantJSONKeyName = "OwnerTenant"
+
+// APIToken RevokedAt JSON Key Name
+const APITokenRevokedAtJSONKeyName = "RevokedAt"
+
+// APIToken Scopes JSON Key Name
+const APITokenScopesJSONKeyName = "Scopes"
+
+// APIToken TokenHash JSON Key Name
+const APITokenTokenHashJSONKeyName = "TokenHash"
+
+// APIToken TokenPrefix JSON Key Name
+const APITokenTokenPrefixJSONKeyName = "TokenPrefix"
+
+// APIToken UpdatedAt JSON Key Name
+const APITokenUpdatedAtJSONKeyName = "updatedAt"
+
+var APITokenJSONKeyNameList = []string{APITokenCreatedAtJSONKeyName, APITokenDeletedAtJSONKeyName, APITokenExpiresAtJSONKeyName, APITokenIDJSONKeyName, APITokenLastUsedAtJSONKeyName, APITokenNameJSONKeyName, APITokenOwnerJSONKeyName, APITokenOwnerEmailJSONKeyName, APITokenOwnerGroupsJSONKeyName, APITokenOwnerIssuerJSONKeyName, APITokenOwnerNameJSONKeyName, APITokenOwnerRolesJSONKeyName, APITokenOwnerTenantJSONKeyName, APITokenRevokedAtJSONKeyName, APITokenScopesJSONKeyName, APITokenTokenHashJSONKeyName, APITokenTokenPrefixJSONKeyName, APITokenUpdatedAtJSONKeyName}
+
+/* Struct Key Names */
+// APIToken CreatedAt Struct Key Name
+const APITokenCreatedAtStructKeyName = "CreatedAt"
+
+// APIToken DeletedAt Struct Key Name
+const APITokenDeletedAtStructKeyName = "DeletedAt"
+
+// APIToken ExpiresAt Struct Key Name
+const APITokenExpiresAtStructKeyName = "ExpiresAt"
+
+// APIToken ID Struct Key Name
+const APITokenIDStructKeyName = "ID"
+
+// APIToken LastUsedAt Struct Key Name
+const APITokenLastUsedAtStructKeyName = "LastUsedAt"
+
+// APIToken Name Struct Key Name
+const APITokenNameStructKeyName = "Name"
+
+// APIToken Owner Struct Key Name
+const APITokenOwnerStructKeyName = "Owner"
+
+// APIToken OwnerEmail Struct Key Name
+const APITokenOwnerEmailStructKeyName = "OwnerEmail"
+
+// APIToken OwnerGroups Struct Key Name
+const APITokenOwnerGroupsStructKeyName = "OwnerGroups"
+
+// APIToken OwnerIssuer Struct Key Name
+const APITokenOwnerIssuerStructKeyName = "OwnerIssuer"
+
+// APIToken OwnerName Struct Key Name
+const APITokenOwnerNameStructKeyName = "OwnerName"
+
+// APIToken OwnerRoles Struct Key Name
+const APITokenOwnerRolesStructKeyName = "OwnerRoles"
+
+// APIToken OwnerTenant Struct Key Name
+const APITokenOwnerTenantStructKeyName = "OwnerTenant"
+
+// APIToken RevokedAt Struct Key Name
+const APITokenRevokedAtStructKeyName = "RevokedAt"
+
+// APIToken Scopes Struct Key Name
+const APITokenScopesStructKeyName = "Scopes"
+
+// APIToken TokenHash Struct Key Name
+const APITokenTokenHashStructKeyName = "TokenHash"
+
+// APIToken TokenPrefix Struct Key Name
+const APITokenTokenPrefixStructKeyName = "TokenPrefix"
+
+// APIToken UpdatedAt Struct Key Name
+const APITokenUpdatedAtStructKeyName = "UpdatedAt"
+
+var APITokenStructKeyNameList = []string{APITokenCreatedAtStructKeyName, APITokenDeletedAtStructKeyName, APITokenExpiresAtStructKeyName, APITokenIDStructKeyName, APITokenLastUsedAtStructKeyName, APITokenNameStructKeyName, APITokenOwnerStructKeyName, APITokenOwnerEmailStructKeyName, APITokenOwnerGroupsStructKeyName, APITokenOwnerIssuerStructKeyName, APITokenOwnerNameStructKeyName, APITokenOwnerRolesStructKeyName, APITokenOwnerTenantStructKeyName, APITokenRevokedAtStructKeyName, APITokenScopesStructKeyName, APITokenTokenHashStructKeyName, APITokenTokenPrefixStructKeyName, APITokenUpdatedAtStructKeyName}
+
+// Transform APIToken Gorm Column To JSON Key
+func TransformAPITokenGormColumnToJSONKey(gormColumn string) (string, error) {
+	switch gormColumn {
+	case APITokenCreatedAtGormColumnName:
+		return APITokenCreatedAtJSONKeyName, nil
+	case APITokenDeletedAtGormColumnName:
+		return APITokenDeletedAtJSONKeyName, nil
+	case APITokenExpiresAtGormColumnName:
+		return APITokenExpiresAtJSONKeyName, nil
+	case APITokenIDGormColumnName:
+		return APITokenIDJSONKeyName, nil
+	case APITokenLastUsedAtGormColumnName:
+		return APITokenLastUsedAtJSONKeyName, nil
+	case APITokenNameGormColumnName:
+		return APITokenNameJSONKeyName, nil
+	case APITokenOwnerGormColumnName:
+		return APITokenOwnerJSONKeyName, nil
+	case APITokenOwnerEmailGormColumnName:
+		return APITokenOwnerEmailJSONKeyName, nil
+	case APITokenOwnerGroupsGormColumnName:
+		return APITokenOwnerGroupsJSONKeyName, nil
+	case APITokenOwnerIssuerGormColumnName:
+		return APITokenOwnerIssuerJSONKeyName, nil
+	case APITokenOwnerNameGormColumnName:
+		return APITokenOwnerNameJSONKeyName, nil
+	case APITokenOwnerRolesGormColumnName:
+		return APITokenOwnerRolesJSONKeyName, nil
+	case APITokenOwnerTenantGormColumnName:
+		return APITokenOwnerTenantJSONKeyName, nil
+	case APITokenRevokedAtGormColumnName:
+		return APITokenRevokedAtJSONKeyName, nil
+	case APITokenScopesGormColumnName:
+		return APITokenScopesJSONKeyName, nil
+	case APITokenTokenHashGormColumnName:
+		return APITokenTokenHashJSONKeyName, nil
+	case APITokenTokenPrefixGormColumnName:
+		return APITokenTokenPrefixJSONKeyName, nil
+	case APITokenUpdatedAtGormColumnName:
+		return APITokenUpdatedAtJSONKeyName, nil
+	default:
+		return "", errors.WithStack(ErrAPITokenUnsupportedGormColumn)
+	}
+}
+
+// Transform APIToken JSON Key To Gorm Column
+func TransformAPITokenJSONKeyToGormColumn(jsonKey string) (string, error) {
+	switch jsonKey {
+	case APITokenCreatedAtJSONKeyName:
+		return APITokenCreatedAtGormColumnName, nil
+	case APITokenDeletedAtJSONKeyName:
+		return APITokenDeletedAtGormColumnName, nil
+	case APITokenExpiresAtJSONKeyName:
+		return APITokenExpiresAtGormColumnName, nil
+	case APITokenIDJSONKeyName:
+		return APITokenIDGormColumnName, nil
+	case APITokenLastUsedAtJSONKeyName:
+		return APITokenLastUsedAtGormColumnName, nil
+	case APITokenNameJSONKeyName:
+		return APITokenNameGormColumnName, nil
+	case APITokenOwnerJSONKeyName:
+		return APITokenOwnerGormColumnName, nil
+	case APITokenOwnerEmailJSONKeyName:
+		return APITokenOwnerEmailGormColumnName, nil
+	case APITokenOwnerGroupsJSONKeyName:
+		return APITokenOwnerGroupsGormColumnName, nil
+	case APITokenOwnerIssuerJSONKeyName:
+		return APITokenOwnerIssuerGormColumnName, nil
+	case APITokenOwnerNameJSONKeyName:
+		return APITokenOwnerNameGormColumnName, nil
+	case APITokenOwnerRolesJSONKeyName:
+		return APITokenOwnerRolesGormColumnName, nil
+	case APITokenOwnerTenantJSONKeyName:
+		return APITokenOwnerTenantGormColumnName, nil
+	case APITokenRevokedAtJSONKeyName:
+		return APITokenRevokedAtGormColumnName, nil
+	case APITokenScopesJSONKeyName:
+		return APITokenScopesGormColumnName, nil
+	case APITokenTokenHashJSONKeyName:
+		return APITokenTokenHashGormColumnName, nil
+	case APITokenTokenPrefixJSONKeyName:
+		return APITokenTokenPrefixGormColumnName, nil
+	case APITokenUpdatedAtJSONKeyName:
+		return APITokenUpdatedAtGormColumnName, nil
+	default:
+		return "", errors.WithStack(ErrAPITokenUnsupportedJSONKey)
+	}
+}
+
+// Transform APIToken JSON Key map To Gorm Column map
+func TransformAPITokenJSONKeyMapToGormColumnMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformAPITokenJSONKeyToGormColumn(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrAPITokenUnsupportedJSONKey) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform APIToken Gorm Column map To JSON Key map
+func TransformAPITokenGormColumnMapToJSONKeyMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformAPITokenGormColumnToJSONKey(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrAPITokenUnsupportedGormColumn) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform APIToken Gorm Column To Struct Key Name
+func TransformAPITokenGormColumnToStructKeyName(gormColumn string) (string, error) {
+	switch gormColumn {
+	case APITokenCreatedAtGormColumnName:
+		return APITokenCreatedAtStructKeyName, nil
+	case APITokenDeletedAtGormColumnName:
+		return APITokenDeletedAtStructKeyName, nil
+	case APITokenExpiresAtGormColumnName:
+		return APITokenExpiresAtStructKeyName, nil
+	case APITokenIDGormColumnName:
+		return APITokenIDStructKeyName, nil
+	case APITokenLastUsedAtGormColumnName:
+		return APITokenLastUsedAtStructKeyName, nil
+	case APITokenNameGormColumnName:
+		return APITokenNameStructKeyName, nil
+	case APITokenOwnerGormColumnName:
+		return APITokenOwnerStructKeyName, nil
+	case APITokenOwnerEmailGormColumnName:
+		return APITokenOwnerEmailStructKeyName, nil
+	case APITokenOwnerGroupsGormColumnName:
+		return APITokenOwnerGroupsStructKeyName, nil
+	case APITokenOwnerIssuerGormColumnName:
+		return APITokenOwnerIssuerStructKeyName, nil
+	case APITokenOwnerNameGormColumnName:
+		return APITokenOwnerNameStructKeyName, nil
+	case APITokenOwnerRolesGormColumnName:
+		return APITokenOwnerRolesStructKeyName, nil
+	case APITokenOwnerTenantGormColumnName:
+		return APITokenOwnerTenantStructKeyName, nil
+	case APITokenRevokedAtGormColumnName:
+		return APITokenRevokedAtStructKeyName, nil
+	case APITokenScopesGormColumnName:
+		return APITokenScopesStructKeyName, nil
+	case APITokenTokenHashGormColumnName:
+		return APITokenTokenHashStructKeyName, nil
+	case APITokenTokenPrefixGormColumnName:
+		return APITokenTokenPrefixStructKeyName, nil
+	case APITokenUpdatedAtGormColumnName:
+		return APITokenUpdatedAtStructKeyName, nil
+	default:
+		return "", errors.WithStack(ErrAPITokenUnsupportedGormColumn)
+	}
+}
+
+// Transform APIToken Struct Key Name To Gorm Column
+func TransformAPITokenStructKeyNameToGormColumn(structKey string) (string, error) {
+	switch structKey {
+	case APITokenCreatedAtStructKeyName:
+		return APITokenCreatedAtGormColumnName, nil
+	case APITokenDeletedAtStructKeyName:
+		return APITokenDeletedAtGormColumnName, nil
+	case APITokenExpiresAtStructKeyName:
+		return APITokenExpiresAtGormColumnName, nil
+	case APITokenIDStructKeyName:
+		return APITokenIDGormColumnName, nil
+	case APITokenLastUsedAtStructKeyName:
+		return APITokenLastUsedAtGormColumnName, nil
+	case APITokenNameStructKeyName:
+		return APITokenNameGormColumnName, nil
+	case APITokenOwnerStructKeyName:
+		return APITokenOwnerGormColumnName, nil
+	case APITokenOwnerEmailStructKeyName:
+		return APITokenOwnerEmailGormColumnName, nil
+	case APITokenOwnerGroupsStructKeyName:
+		return APITokenOwnerGroupsGormColumnName, nil
+	case APITokenOwnerIssuerStructKeyName:
+		return APITokenOwnerIssuerGormColumnName, nil
+	case APITokenOwnerNameStructKeyName:
+		return APITokenOwnerNameGormColumnName, nil
+	case APITokenOwnerRolesStructKeyName:
+		return APITokenOwnerRolesGormColumnName, nil
+	case APITokenOwnerTenantStructKeyName:
+		return APITokenOwnerTenantGormColumnName, nil
+	case APITokenRevokedAtStructKeyName:
+		return APITokenRevokedAtGormColumnName, nil
+	case APITokenScopesStructKeyName:
+		return APITokenScopesGormColumnName, nil
+	case APITokenTokenHashStructKeyName:
+		return APITokenTokenHashGormColumnName, nil
+	case APITokenTokenPrefixStructKeyName:
+		return APITokenTokenPrefixGormColumnName, nil
+	case APITokenUpdatedAtStructKeyName:
+		return APITokenUpdatedAtGormColumnName, nil
+	default:
+		return "", errors.WithStack(ErrAPITokenUnsupportedStructKeyName)
+	}
+}
+
+// Transform APIToken Struct Key Name map To Gorm Column map
+func TransformAPITokenStructKeyNameMapToGormColumnMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformAPITokenStructKeyNameToGormColumn(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrAPITokenUnsupportedStructKeyName) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform APIToken Gorm Column map To Struct Key Name map
+func TransformAPITokenGormColumnMapToStructKeyNameMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformAPITokenGormColumnToStructKeyName(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrAPITokenUnsupportedGormColumn) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform APIToken JSON Key To Struct Key Name
+func TransformAPITokenJSONKeyToStructKeyName(jsonKey string) (string, error) {
+	switch jsonKey {
+	case APITokenCreatedAtJSONKeyName:
+		return APITokenCreatedAtStructKeyName, nil
+	case APITokenDeletedAtJSONKeyName:
+		return APITokenDeletedAtStructKeyName, nil
+	case APITokenExpiresAtJSONKeyName:
+		return APITokenExpiresAtStructKeyName, nil
+	case APITokenIDJSONKeyName:
+		return APITokenIDStructKeyName, nil
+	case APITokenLastUsedAtJSONKeyName:
+		return APITokenLastUsedAtStructKeyName, nil
+	case APITokenNameJSONKeyName:
+		return APITokenNameStructKeyName, nil
+	case APITokenOwnerJSONKeyName:
+		return APITokenOwnerStructKeyName, nil
+	case APITokenOwnerEmailJSONKeyName:
+		return APITokenOwnerEmailStructKeyName, nil
+	case APITokenOwnerGroupsJSONKeyName:
+		return APITokenOwnerGroupsStructKeyName, nil
+	case APITokenOwnerIssuerJSONKeyName:
+		return APITokenOwnerIssuerStructKeyName, nil
+	case APITokenOwnerNameJSONKeyName:
+		return APITokenOwnerNameStructKeyName, nil
+	case APITokenOwnerRolesJSONKeyName:
+		return APITokenOwnerRolesStructKeyName, nil
+	case APITokenOwnerTenantJSONKeyName:
+		return APITokenOwnerTenantStructKeyName, nil
+	case APITokenRevokedAtJSONKeyName:
+		return APITokenRevokedAtStructKeyName, nil
+	case APITokenScopesJSONKeyName:
+		return APITokenScopesStructKeyName, nil
+	case APITokenTokenHashJSONKeyName:
+		return APITokenTokenHashStructKeyName, nil
+	case APITokenTokenPrefixJSONKeyName:
+		return APITokenTokenPrefixStructKeyName, nil
+	case APITokenUpdatedAtJSONKeyName:
+		return APITokenUpdatedAtStructKeyName, nil
+	default:
+		return "", errors.WithStack(ErrAPITokenUnsupportedJSONKey)
+	}
+}
+
+// Transform APIToken Struct Key Name To JSON Key
+func TransformAPITokenStructKeyNameToJSONKey(structKey string) (string, error) {
+	switch structKey {
+	case APITokenCreatedAtStructKeyName:
+		return APITokenCreatedAtStructKeyName, nil
+	case APITokenDeletedAtStructKeyName:
+		return APITokenDeletedAtStructKeyName, nil
+	case APITokenExpiresAtStructKeyName:
+		return APITokenExpiresAtStructKeyName, nil
+	case APITokenIDStructKeyName:
+		return APITokenIDStructKeyName, nil
+	case APITokenLastUsedAtStructKeyName:
+		return APITokenLastUsedAtStructKeyName, nil
+	case APITokenNameStructKeyName:
+		return APITokenNameStructKeyName, nil
+	case APITokenOwnerStructKeyName:
+		return APITokenOwnerStructKeyName, nil
+	case APITokenOwnerEmailStructKeyName:
+		return APITokenOwnerEmailStructKeyName, nil
+	case APITokenOwnerGroupsStructKeyName:
+		return APITokenOwnerGroupsStructKeyName, nil
+	case APITokenOwnerIssuerStructKeyName:
+		return APITokenOwnerIssuerStructKeyName, nil
+	case APITokenOwnerNameStructKeyName:
+		return APITokenOwnerNameStructKeyName, nil
+	case APITokenOwnerRolesStructKeyName:
+		return APITokenOwnerRolesStructKeyName, nil
+	case APITokenOwnerTenantStructKeyName:
+		return APITokenOwnerTenantStructKeyName, nil
+	case APITokenRevokedAtStructKeyName:
+		return APITokenRevokedAtStructKeyName, nil
+	case APITokenScopesStructKeyName:
+		return APITokenScopesStructKeyName, nil
+	case APITokenTokenHashStructKeyName:
+		return APITokenTokenHashStructKeyName, nil
+	case APITokenTokenPrefixStructKeyName:
+		return APITokenTokenPrefixStructKeyName, nil
+	case APITokenUpdatedAtStructKeyName:
+		return APITokenUpdatedAtStructKeyName, nil
+	default:
+		return "", errors.WithStack(ErrAPITokenUnsupportedStructKeyName)
+	}
+}
+
+// Transform APIToken Struct Key Name map To JSON Key map
+func TransformAPITokenStructKeyNameMapToJSONKeyMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformAPITokenStructKeyNameToJSONKey(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrAPITokenUnsupportedStructKeyName) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform APIToken JSON Key map To Struct Key Name map
+func TransformAPITokenJSONKeyMapToStructKeyNameMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformAPITokenJSONKeyToStructKeyName(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrAPITokenUnsupportedJSONKey) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
@@ -0,0 +1,34 @@
+package models
+
+import "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+
+type SortOrder struct {
+	CreatedAt  *common.SortOrderEnum `dbfield:"created_at"`
+	UpdatedAt  *common.SortOrderEnum `dbfield:"updated_at"`
+	Name       *common.SortOrderEnum `dbfield:"name"`
+	ExpiresAt  *common.SortOrderEnum `dbfield:"expires_at"`
+	LastUsedAt *common.SortOrderEnum `dbfield:"last_used_at"`
+}
+
+type Filter struct {
+	ID        *common.GenericFilter `dbfield:"id"`
+	CreatedAt *common.DateFilter    `dbfield:"created_at"`
+	Name      *common.GenericFilter `dbfield:"name"`
+	Owner     *common.GenericFilter `dbfield:"owner"`
+	TokenHash *common.GenericFilter `dbfield:"token_hash"`
+	RevokedAt *common.DateFilter    `dbfield:"revoked_at"`
+	AND       []*Filter
+	OR        []*Filter
+}
+
+type Projection struct {
+	ID          bool `dbfield:"id"           graphqlfield:"id"`
+	CreatedAt   bool `dbfield:"created_at"   graphqlfield:"createdAt"`
+	UpdatedAt   bool `dbfield:"updated_at"   graphqlfield:"updatedAt"`
+	ExpiresAt   bool `dbfield:"expires_at"   graphqlfield:"expiresAt"`
+	RevokedAt   bool `dbfield:"revoked_at"   graphqlfield:"revokedAt"`
+	LastUsedAt  bool `dbfield:"last_used_at" graphqlfield:"lastUsedAt"`
+	Name        bool `dbfield:"name"         graphqlfield:"name"`
+	TokenPrefix bool `dbfield:"token_prefix" graphqlfield:"tokenPrefix"`
+	Scopes      bool `dbfield:"scopes"       graphqlfield:"scopes"`
+}
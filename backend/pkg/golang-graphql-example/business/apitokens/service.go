@@ -0,0 +1,346 @@
+package apitokens
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/daos"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/models"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+)
+
+const (
+	mainAuthorizationPrefix = "apitoken"
+	tokenRandomBytesLength  = 32
+	tokenDisplayPrefixSize  = 12
+	nameMaxLength           = 200
+	invalidTokenMessage     = "invalid api token"
+)
+
+type service struct {
+	cfgManager config.Manager
+	dao        daos.Dao
+	authSvc    AuthorizationService
+}
+
+func (s *service) FindForCurrentUser(
+	ctx context.Context,
+	projection *models.Projection,
+) ([]*models.APIToken, error) {
+	// Check authorization
+	err := s.authSvc.CheckAuthorized(
+		ctx,
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "List"),
+		"",
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get owner
+	owner, err := getOwner(ctx)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort by creation date
+	desc := common.SortOrderEnumDesc
+
+	return s.dao.FindAllAPIToken(
+		ctx,
+		[]*models.SortOrder{{CreatedAt: &desc}},
+		&models.Filter{Owner: &common.GenericFilter{Eq: owner.GetIdentifier()}},
+		projection,
+	)
+}
+
+func (s *service) Create(ctx context.Context, inp *InputCreateAPIToken) (*models.APIToken, string, error) {
+	// Check authorization
+	err := s.authSvc.CheckAuthorized(
+		ctx,
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Create"),
+		"",
+	)
+	// Check error
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Get owner
+	owner, err := getOwner(ctx)
+	// Check error
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Forbid token creation from a token to avoid privilege extension
+	if owner.APIToken != nil {
+		return nil, "", cerrors.NewForbiddenError("api token cannot be created using an api token")
+	}
+
+	// Get maximum duration
+	maxDuration, err := s.getMaxDuration()
+	// Check error
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Validate input
+	maxExpiresAt := time.Now().Add(maxDuration)
+
+	err = validateCreateInput(inp, maxExpiresAt)
+	// Check error
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Tokens always expire so saved owner roles and groups aren't used forever
+	expiresAt := inp.ExpiresAt
+	if expiresAt == nil {
+		expiresAt = &maxExpiresAt
+	}
+
+	// Generate token
+	token, err := generateToken()
+	// Check error
+	if err != nil {
+		return nil, "", err
+	}
+
+	tt := &models.APIToken{
+		Name:        inp.Name,
+		Scopes:      inp.Scopes,
+		ExpiresAt:   expiresAt,
+		Owner:       owner.GetIdentifier(),
+		OwnerEmail:  owner.Email,
+		OwnerName:   owner.Name,
+		OwnerIssuer: owner.Issuer,
+		OwnerTenant: owner.Tenant,
+		OwnerRoles:  owner.Roles,
+		OwnerGroups: owner.Groups,
+		TokenPrefix: token[:tokenDisplayPrefixSize],
+		TokenHash:   hashToken(token),
+	}
+
+	// Save
+	res, err := s.dao.CreateOrUpdateAPIToken(ctx, tt)
+	// Check error
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res, token, nil
+}
+
+func (s *service) Revoke(
+	ctx context.Context,
+	id string,
+	projection *models.Projection,
+) (*models.APIToken, error) {
+	// Check authorization
+	err := s.authSvc.CheckAuthorized(
+		ctx,
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Revoke"),
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get owner
+	owner, err := getOwner(ctx)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure fields needed for revocation are loaded
+	if projection != nil {
+		projection.ID = true
+		projection.RevokedAt = true
+	}
+
+	// Find token owned by user
+	tt, err := s.dao.FindOneAPIToken(
+		ctx,
+		nil,
+		&models.Filter{
+			ID:    &common.GenericFilter{Eq: id},
+			Owner: &common.GenericFilter{Eq: owner.GetIdentifier()},
+		},
+		projection,
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if it hasn't been found
+	if tt == nil {
+		return nil, cerrors.NewNotFoundError("api token not found")
+	}
+
+	// Check if already revoked
+	if tt.IsRevoked() {
+		return tt, nil
+	}
+
+	// Save
+	return s.dao.PatchUpdateAPIToken(
+		ctx,
+		tt,
+		map[string]any{models.APITokenRevokedAtGormColumnName: time.Now()},
+	)
+}
+
+func (s *service) AuthenticateAPIToken(ctx context.Context, token string) (*authxmodels.OIDCUser, error) {
+	// Find token by hash
+	tt, err := s.dao.FindOneAPIToken(
+		ctx,
+		nil,
+		&models.Filter{TokenHash: &common.GenericFilter{Eq: hashToken(token)}},
+		nil,
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if token exists and is still valid
+	if tt == nil || tt.IsRevoked() || tt.IsExpired() {
+		return nil, cerrors.NewUnauthorizedError(invalidTokenMessage)
+	}
+
+	// Get maximum duration
+	maxDuration, err := s.getMaxDuration()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if token created without expiration date is too old
+	// Those tokens have been created before expiration date was required
+	if tt.ExpiresAt == nil && !tt.CreatedAt.Add(maxDuration).After(time.Now()) {
+		return nil, cerrors.NewUnauthorizedError(invalidTokenMessage)
+	}
+
+	// Update last usage
+	_, err = s.dao.PatchUpdateAPIToken(
+		ctx,
+		tt,
+		map[string]any{models.APITokenLastUsedAtGormColumnName: time.Now()},
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &authxmodels.OIDCUser{
+		PrincipalType:     authxmodels.PrincipalTypeUser,
+		PreferredUsername: tt.Owner,
+		Email:             tt.OwnerEmail,
+		Name:              tt.OwnerName,
+		Issuer:            tt.OwnerIssuer,
+		Tenant:            tt.OwnerTenant,
+		Roles:             tt.OwnerRoles,
+		Groups:            tt.OwnerGroups,
+		OriginalToken:     token,
+		APIToken: &authxmodels.APITokenInfo{
+			ID:     tt.ID,
+			Name:   tt.Name,
+			Scopes: tt.Scopes,
+		},
+	}, nil
+}
+
+func (s *service) getMaxDuration() (time.Duration, error) {
+	// Default value
+	maxDuration := config.DefaultOIDCAPITokenMaxDuration
+
+	// Get configuration
+	cfg := s.cfgManager.GetConfig().OIDCAuthentication
+	// Check if api tokens configuration exists
+	if cfg != nil && cfg.APITokens != nil {
+		maxDuration = cfg.APITokens.MaxDuration
+	}
+
+	// Parse
+	res, err := time.ParseDuration(maxDuration)
+	// Check error
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return res, nil
+}
+
+func getOwner(ctx context.Context) (*authxmodels.OIDCUser, error) {
+	// Get user from context
+	user := authentication.GetAuthenticatedUserFromContext(ctx)
+	// Check if user exists
+	if user == nil || user.GetIdentifier() == "" {
+		return nil, cerrors.NewUnauthorizedError("authenticated user is required")
+	}
+
+	return user, nil
+}
+
+func validateCreateInput(inp *InputCreateAPIToken, maxExpiresAt time.Time) error {
+	// Check name
+	if inp.Name == "" || len(inp.Name) > nameMaxLength {
+		return cerrors.NewInvalidInputError(
+			fmt.Sprintf("name must be set and contain at most %d characters", nameMaxLength),
+		)
+	}
+
+	// Check scopes
+	if len(inp.Scopes) == 0 {
+		return cerrors.NewInvalidInputError("at least one scope must be set")
+	}
+
+	// Check expiration
+	if inp.ExpiresAt != nil && !inp.ExpiresAt.After(time.Now()) {
+		return cerrors.NewInvalidInputError("expiration date must be in the future")
+	}
+
+	// Check maximum expiration
+	if inp.ExpiresAt != nil && inp.ExpiresAt.After(maxExpiresAt) {
+		return cerrors.NewInvalidInputError("expiration date is after maximum api token duration")
+	}
+
+	return nil
+}
+
+// Generate a new random plain text token.
+func generateToken() (string, error) {
+	b := make([]byte, tokenRandomBytesLength)
+
+	_, err := rand.Read(b)
+	// Check error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return authxmodels.APITokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Hash token to store it.
+// Token contains enough entropy to not need a salt or a slow hash function.
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(h[:])
+}
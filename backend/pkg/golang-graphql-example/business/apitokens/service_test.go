@@ -0,0 +1,269 @@
+//go:build unit
+
+package apitokens
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authorization"
+	daomocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/daos/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+)
+
+type fakeAuthorizationService struct{}
+
+func (*fakeAuthorizationService) CheckAuthorized(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func newTestCfgManager(ctrl *gomock.Controller) config.Manager {
+	cfgManagerMock := cmocks.NewMockManager(ctrl)
+	cfgManagerMock.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+		OIDCAuthentication: &config.OIDCAuthConfig{APITokens: &config.OIDCAPITokensConfig{MaxDuration: "24h"}},
+	})
+
+	return cfgManagerMock
+}
+
+func Test_generateToken(t *testing.T) {
+	token, err := generateToken()
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(token, authxmodels.APITokenPrefix))
+	assert.Len(t, hashToken(token), 64)
+
+	token2, err := generateToken()
+	require.NoError(t, err)
+
+	assert.NotEqual(t, token, token2)
+	assert.NotEqual(t, hashToken(token), hashToken(token2))
+}
+
+func Test_service_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dao := daomocks.NewMockDao(ctrl)
+	s := &service{cfgManager: newTestCfgManager(ctrl), dao: dao, authSvc: &fakeAuthorizationService{}}
+
+	past := time.Now().Add(-time.Hour)
+	tooFar := time.Now().Add(48 * time.Hour)
+	future := time.Now().Add(time.Hour)
+	userCtx := authentication.SetAuthenticatedUserToContext(
+		context.TODO(),
+		&authxmodels.OIDCUser{
+			PreferredUsername: "user",
+			Email:             "user@example.com",
+			Issuer:            "https://issuer",
+			Tenant:            "tenant",
+			Roles:             []string{"writer"},
+			Groups:            []string{"team"},
+		},
+	)
+	tokenCtx := authentication.SetAuthenticatedUserToContext(
+		context.TODO(),
+		&authxmodels.OIDCUser{PreferredUsername: "user", APIToken: &authxmodels.APITokenInfo{ID: "id"}},
+	)
+
+	// No user
+	_, _, err := s.Create(context.TODO(), &InputCreateAPIToken{Name: "ci", Scopes: []string{"*"}})
+	assert.EqualError(t, err, "authenticated user is required")
+
+	// Token created from token
+	_, _, err = s.Create(tokenCtx, &InputCreateAPIToken{Name: "ci", Scopes: []string{"*"}})
+	assert.EqualError(t, err, "api token cannot be created using an api token")
+
+	// Invalid inputs
+	_, _, err = s.Create(userCtx, &InputCreateAPIToken{Scopes: []string{"*"}})
+	assert.EqualError(t, err, "name must be set and contain at most 200 characters")
+	_, _, err = s.Create(userCtx, &InputCreateAPIToken{Name: "ci"})
+	assert.EqualError(t, err, "at least one scope must be set")
+	_, _, err = s.Create(userCtx, &InputCreateAPIToken{Name: "ci", Scopes: []string{"*"}, ExpiresAt: &past})
+	assert.EqualError(t, err, "expiration date must be in the future")
+	_, _, err = s.Create(userCtx, &InputCreateAPIToken{Name: "ci", Scopes: []string{"*"}, ExpiresAt: &tooFar})
+	assert.EqualError(t, err, "expiration date is after maximum api token duration")
+
+	// Valid
+	dao.EXPECT().
+		CreateOrUpdateAPIToken(gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(_ context.Context, in *models.APIToken, _ ...any) (*models.APIToken, error) {
+			return in, nil
+		})
+
+	res, _, err := s.Create(userCtx, &InputCreateAPIToken{Name: "ci", Scopes: []string{"*"}, ExpiresAt: &future})
+	require.NoError(t, err)
+	assert.Equal(t, &future, res.ExpiresAt)
+
+	before := time.Now()
+
+	res, token, err := s.Create(userCtx, &InputCreateAPIToken{Name: "ci", Scopes: []string{"todo:*"}})
+	require.NoError(t, err)
+
+	// Expiration date is set to maximum duration by default
+	require.NotNil(t, res.ExpiresAt)
+	assert.False(t, res.ExpiresAt.Before(before.Add(24*time.Hour)))
+	assert.False(t, res.ExpiresAt.After(time.Now().Add(24*time.Hour)))
+
+	assert.Equal(t, "user", res.Owner)
+	assert.Equal(t, "user@example.com", res.OwnerEmail)
+	assert.Equal(t, "https://issuer", res.OwnerIssuer)
+	assert.Equal(t, "tenant", res.OwnerTenant)
+	assert.Equal(t, []string{"writer"}, res.OwnerRoles)
+	assert.Equal(t, []string{"team"}, res.OwnerGroups)
+	assert.Equal(t, []string{"todo:*"}, res.Scopes)
+	assert.Equal(t, hashToken(token), res.TokenHash)
+	assert.Equal(t, token[:tokenDisplayPrefixSize], res.TokenPrefix)
+	assert.NotContains(t, res.TokenHash, token)
+}
+
+func Test_service_AuthenticateAPIToken(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		found     *models.APIToken
+		wantErr   bool
+		errString string
+	}{
+		{
+			name:      "not found",
+			wantErr:   true,
+			errString: "invalid api token",
+		},
+		{
+			name:      "revoked",
+			found:     &models.APIToken{RevokedAt: &past},
+			wantErr:   true,
+			errString: "invalid api token",
+		},
+		{
+			name:      "expired",
+			found:     &models.APIToken{ExpiresAt: &past},
+			wantErr:   true,
+			errString: "invalid api token",
+		},
+		{
+			name:      "without expiration and older than maximum duration",
+			found:     &models.APIToken{Base: database.Base{CreatedAt: time.Now().Add(-25 * time.Hour)}},
+			wantErr:   true,
+			errString: "invalid api token",
+		},
+		{
+			name: "valid",
+			found: &models.APIToken{
+				Base:        database.Base{ID: "id"},
+				ExpiresAt:   &future,
+				Name:        "ci",
+				Owner:       "user",
+				OwnerEmail:  "user@example.com",
+				OwnerIssuer: "https://issuer",
+				OwnerTenant: "tenant",
+				OwnerRoles:  []string{"writer"},
+				OwnerGroups: []string{"team"},
+				Scopes:      []string{"todo:*"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			dao := daomocks.NewMockDao(ctrl)
+			s := &service{cfgManager: newTestCfgManager(ctrl), dao: dao}
+
+			dao.EXPECT().
+				FindOneAPIToken(gomock.Any(), nil, gomock.Any(), nil).
+				DoAndReturn(func(_ context.Context, _ []*models.SortOrder, f *models.Filter, _ *models.Projection, _ ...any) (*models.APIToken, error) {
+					assert.Equal(t, hashToken("ggepat_TOKEN"), f.TokenHash.Eq)
+
+					return tt.found, nil
+				})
+
+			if !tt.wantErr {
+				dao.EXPECT().PatchUpdateAPIToken(gomock.Any(), tt.found, gomock.Any()).Return(tt.found, nil)
+			}
+
+			got, err := s.AuthenticateAPIToken(context.TODO(), "ggepat_TOKEN")
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errString)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, &authxmodels.OIDCUser{
+				PrincipalType:     authxmodels.PrincipalTypeUser,
+				PreferredUsername: "user",
+				Email:             "user@example.com",
+				Issuer:            "https://issuer",
+				Tenant:            "tenant",
+				Roles:             []string{"writer"},
+				Groups:            []string{"team"},
+				OriginalToken:     "ggepat_TOKEN",
+				APIToken: &authxmodels.APITokenInfo{
+					ID:     "id",
+					Name:   "ci",
+					Scopes: []string{"todo:*"},
+				},
+			}, got)
+		})
+	}
+}
+
+func Test_service_AuthenticateAPIToken_RBAC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dao := daomocks.NewMockDao(ctrl)
+	s := &service{cfgManager: newTestCfgManager(ctrl), dao: dao}
+
+	cfgManager := cmocks.NewMockManager(ctrl)
+	cfgManager.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+		RBACAuthorization: &config.RBACAuthorizationConfig{
+			Rules: []*config.RBACRuleConfig{
+				{Effect: "ALLOW", Actions: []string{"todo:*"}, Resources: []string{"*"}, Roles: []string{"writer"}},
+				{Effect: "ALLOW", Actions: []string{"apitoken:*"}, Resources: []string{"*"}, Groups: []string{"team"}},
+			},
+		},
+	})
+
+	authoSvc := authorization.NewService(cfgManager)
+	require.NoError(t, authoSvc.InitializeAndReload())
+
+	found := &models.APIToken{
+		Base:        database.Base{ID: "id", CreatedAt: time.Now()},
+		Owner:       "user",
+		OwnerRoles:  []string{"writer"},
+		OwnerGroups: []string{"team"},
+		Scopes:      []string{"todo:*"},
+	}
+	dao.EXPECT().FindOneAPIToken(gomock.Any(), nil, gomock.Any(), nil).Return(found, nil)
+	dao.EXPECT().PatchUpdateAPIToken(gomock.Any(), found, gomock.Any()).Return(found, nil)
+
+	user, err := s.AuthenticateAPIToken(context.TODO(), "ggepat_TOKEN")
+	require.NoError(t, err)
+
+	ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+	ctx = authentication.SetAuthenticatedUserToContext(ctx, user)
+
+	// Owner role allows action and token scope contains it
+	res, err := authoSvc.IsAuthorized(ctx, "todo:Create", "")
+	require.NoError(t, err)
+	assert.True(t, res)
+
+	// Owner group allows action but token scope doesn't contain it
+	res, err = authoSvc.IsAuthorized(ctx, "apitoken:List", "")
+	require.NoError(t, err)
+	assert.False(t, res)
+}
@@ -0,0 +1,200 @@
+// Code generated by daogen, DO NOT EDIT.
+package daos
+
+import (
+	"context"
+	models0 "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/models"
+	database "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	helpers "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/helpers"
+	pagination "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
+)
+
+/* Interface */
+
+// Dao for structure ImpersonationSession
+type ImpersonationSessionStructureDao interface {
+	FindImpersonationSessionByID(ctx context.Context, id string, projection *models0.Projection, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error)
+	FindOneImpersonationSession(ctx context.Context, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error)
+	FindImpersonationSessionWithPagination(ctx context.Context, page *pagination.PageInput, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) ([]*models0.ImpersonationSession, error)
+	FindImpersonationSessionPaginated(ctx context.Context, page *pagination.PageInput, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...database.TransactionOption) ([]*models0.ImpersonationSession, *pagination.PageOutput, error)
+	FindAllImpersonationSession(ctx context.Context, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) ([]*models0.ImpersonationSession, error)
+	CountImpersonationSessionPaginated(ctx context.Context, page *pagination.PageInput, filter *models0.Filter, opts ...helpers.GormOpt) (int64, error)
+	CountImpersonationSession(ctx context.Context, filter *models0.Filter, opts ...helpers.GormOpt) (int64, error)
+	CreateOrUpdateImpersonationSession(ctx context.Context, input *models0.ImpersonationSession, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error)
+	PermanentDeleteImpersonationSession(ctx context.Context, input *models0.ImpersonationSession, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error)
+	PermanentDeleteImpersonationSessionByID(ctx context.Context, id string, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error)
+	PermanentDeleteImpersonationSessionFiltered(ctx context.Context, filter *models0.Filter, opts ...helpers.GormOpt) error
+	PatchUpdateImpersonationSession(ctx context.Context, input *models0.ImpersonationSession, patch map[string]any, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error)
+	PatchUpdateImpersonationSessionByID(ctx context.Context, id string, patch map[string]any, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error)
+	PatchUpdateImpersonationSessionFiltered(ctx context.Context, filter *models0.Filter, patch map[string]any, opts ...helpers.GormOpt) error
+}
+
+// Dao for structure ImpersonationAuditEvent
+type ImpersonationAuditEventStructureDao interface {
+	FindImpersonationAuditEventByID(ctx context.Context, id string, projection *models0.AuditEventProjection, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error)
+	FindOneImpersonationAuditEvent(ctx context.Context, sorts []*models0.AuditEventSortOrder, filter *models0.AuditEventFilter, projection *models0.AuditEventProjection, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error)
+	FindImpersonationAuditEventWithPagination(ctx context.Context, page *pagination.PageInput, sorts []*models0.AuditEventSortOrder, filter *models0.AuditEventFilter, projection *models0.AuditEventProjection, opts ...helpers.GormOpt) ([]*models0.ImpersonationAuditEvent, error)
+	FindImpersonationAuditEventPaginated(ctx context.Context, page *pagination.PageInput, sorts []*models0.AuditEventSortOrder, filter *models0.AuditEventFilter, projection *models0.AuditEventProjection, opts ...database.TransactionOption) ([]*models0.ImpersonationAuditEvent, *pagination.PageOutput, error)
+	FindAllImpersonationAuditEvent(ctx context.Context, sorts []*models0.AuditEventSortOrder, filter *models0.AuditEventFilter, projection *models0.AuditEventProjection, opts ...helpers.GormOpt) ([]*models0.ImpersonationAuditEvent, error)
+	CountImpersonationAuditEventPaginated(ctx context.Context, page *pagination.PageInput, filter *models0.AuditEventFilter, opts ...helpers.GormOpt) (int64, error)
+	CountImpersonationAuditEvent(ctx context.Context, filter *models0.AuditEventFilter, opts ...helpers.GormOpt) (int64, error)
+	CreateOrUpdateImpersonationAuditEvent(ctx context.Context, input *models0.ImpersonationAuditEvent, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error)
+	PermanentDeleteImpersonationAuditEvent(ctx context.Context, input *models0.ImpersonationAuditEvent, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error)
+	PermanentDeleteImpersonationAuditEventByID(ctx context.Context, id string, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error)
+	PermanentDeleteImpersonationAuditEventFiltered(ctx context.Context, filter *models0.AuditEventFilter, opts ...helpers.GormOpt) error
+	PatchUpdateImpersonationAuditEvent(ctx context.Context, input *models0.ImpersonationAuditEvent, patch map[string]any, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error)
+	PatchUpdateImpersonationAuditEventByID(ctx context.Context, id string, patch map[string]any, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error)
+	PatchUpdateImpersonationAuditEventFiltered(ctx context.Context, filter *models0.AuditEventFilter, patch map[string]any, opts ...helpers.GormOpt) error
+}
+
+// General Dao
+type Dao interface {
+	ImpersonationSessionStructureDao
+	ImpersonationAuditEventStructureDao
+}
+
+/* New */
+
+func NewDao(db database.DB) Dao {
+	return &dao{db: db}
+}
+
+/* Structure */
+
+type dao struct {
+	db database.DB
+}
+
+/* Functions */
+
+// Starting methods for ImpersonationSession structure
+
+func (d *dao) FindImpersonationSessionByID(ctx context.Context, id string, projection *models0.Projection, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error) {
+	return helpers.FindByID(ctx, &models0.ImpersonationSession{}, d.db, id, projection, opts...)
+}
+
+func (d *dao) FindOneImpersonationSession(ctx context.Context, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error) {
+	return helpers.FindOne(ctx, &models0.ImpersonationSession{}, d.db, sorts, filter, projection, opts...)
+}
+
+func (d *dao) FindImpersonationSessionWithPagination(ctx context.Context, page *pagination.PageInput, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) ([]*models0.ImpersonationSession, error) {
+	return helpers.FindWithPagination(ctx, []*models0.ImpersonationSession{}, d.db, page, sorts, filter, projection, opts...)
+}
+
+func (d *dao) FindImpersonationSessionPaginated(ctx context.Context, page *pagination.PageInput, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...database.TransactionOption) ([]*models0.ImpersonationSession, *pagination.PageOutput, error) {
+	return helpers.GetAllPaginated(ctx, []*models0.ImpersonationSession{}, d.db, page, sorts, filter, projection, opts...)
+}
+
+func (d *dao) FindAllImpersonationSession(ctx context.Context, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) ([]*models0.ImpersonationSession, error) {
+	return helpers.Find(ctx, []*models0.ImpersonationSession{}, d.db, sorts, filter, projection, opts...)
+}
+
+func (d *dao) CountImpersonationSessionPaginated(ctx context.Context, page *pagination.PageInput, filter *models0.Filter, opts ...helpers.GormOpt) (int64, error) {
+	return helpers.CountPaginated(ctx, d.db, &models0.ImpersonationSession{}, page, filter, opts...)
+}
+
+func (d *dao) CountImpersonationSession(ctx context.Context, filter *models0.Filter, opts ...helpers.GormOpt) (int64, error) {
+	return helpers.Count(ctx, d.db, &models0.ImpersonationSession{}, filter, opts...)
+}
+
+func (d *dao) CreateOrUpdateImpersonationSession(ctx context.Context, input *models0.ImpersonationSession, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error) {
+	return helpers.CreateOrUpdate(ctx, input, d.db, opts...)
+}
+
+func (d *dao) PermanentDeleteImpersonationSession(ctx context.Context, input *models0.ImpersonationSession, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error) {
+	return helpers.PermanentDelete(ctx, input, d.db, opts...)
+}
+
+func (d *dao) PermanentDeleteImpersonationSessionByID(ctx context.Context, id string, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error) {
+	input := &models0.ImpersonationSession{}
+	input.ID = id
+
+	return helpers.PermanentDelete(ctx, input, d.db, opts...)
+}
+
+func (d *dao) PermanentDeleteImpersonationSessionFiltered(ctx context.Context, filter *models0.Filter, opts ...helpers.GormOpt) error {
+	return helpers.PermanentDeleteFiltered(ctx, &models0.ImpersonationSession{}, filter, d.db, opts...)
+}
+
+func (d *dao) PatchUpdateImpersonationSession(ctx context.Context, input *models0.ImpersonationSession, patch map[string]any, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error) {
+	return helpers.PatchUpdate(ctx, input, patch, d.db, opts...)
+}
+
+func (d *dao) PatchUpdateImpersonationSessionByID(ctx context.Context, id string, patch map[string]any, opts ...helpers.GormOpt) (*models0.ImpersonationSession, error) {
+	input := &models0.ImpersonationSession{}
+	input.ID = id
+
+	return helpers.PatchUpdate(ctx, input, patch, d.db, opts...)
+}
+
+func (d *dao) PatchUpdateImpersonationSessionFiltered(ctx context.Context, filter *models0.Filter, patch map[string]any, opts ...helpers.GormOpt) error {
+	return helpers.PatchUpdateAllFiltered(ctx, &models0.ImpersonationSession{}, patch, filter, d.db, opts...)
+}
+
+// Ending methods for ImpersonationSession structure
+
+// Starting methods for ImpersonationAuditEvent structure
+
+func (d *dao) FindImpersonationAuditEventByID(ctx context.Context, id string, projection *models0.AuditEventProjection, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error) {
+	return helpers.FindByID(ctx, &models0.ImpersonationAuditEvent{}, d.db, id, projection, opts...)
+}
+
+func (d *dao) FindOneImpersonationAuditEvent(ctx context.Context, sorts []*models0.AuditEventSortOrder, filter *models0.AuditEventFilter, projection *models0.AuditEventProjection, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error) {
+	return helpers.FindOne(ctx, &models0.ImpersonationAuditEvent{}, d.db, sorts, filter, projection, opts...)
+}
+
+func (d *dao) FindImpersonationAuditEventWithPagination(ctx context.Context, page *pagination.PageInput, sorts []*models0.AuditEventSortOrder, filter *models0.AuditEventFilter, projection *models0.AuditEventProjection, opts ...helpers.GormOpt) ([]*models0.ImpersonationAuditEvent, error) {
+	return helpers.FindWithPagination(ctx, []*models0.ImpersonationAuditEvent{}, d.db, page, sorts, filter, projection, opts...)
+}
+
+func (d *dao) FindImpersonationAuditEventPaginated(ctx context.Context, page *pagination.PageInput, sorts []*models0.AuditEventSortOrder, filter *models0.AuditEventFilter, projection *models0.AuditEventProjection, opts ...database.TransactionOption) ([]*models0.ImpersonationAuditEvent, *pagination.PageOutput, error) {
+	return helpers.GetAllPaginated(ctx, []*models0.ImpersonationAuditEvent{}, d.db, page, sorts, filter, projection, opts...)
+}
+
+func (d *dao) FindAllImpersonationAuditEvent(ctx context.Context, sorts []*models0.AuditEventSortOrder, filter *models0.AuditEventFilter, projection *models0.AuditEventProjection, opts ...helpers.GormOpt) ([]*models0.ImpersonationAuditEvent, error) {
+	return helpers.Find(ctx, []*models0.ImpersonationAuditEvent{}, d.db, sorts, filter, projection, opts...)
+}
+
+func (d *dao) CountImpersonationAuditEventPaginated(ctx context.Context, page *pagination.PageInput, filter *models0.AuditEventFilter, opts ...helpers.GormOpt) (int64, error) {
+	return helpers.CountPaginated(ctx, d.db, &models0.ImpersonationAuditEvent{}, page, filter, opts...)
+}
+
+func (d *dao) CountImpersonationAuditEvent(ctx context.Context, filter *models0.AuditEventFilter, opts ...helpers.GormOpt) (int64, error) {
+	return helpers.Count(ctx, d.db, &models0.ImpersonationAuditEvent{}, filter, opts...)
+}
+
+func (d *dao) CreateOrUpdateImpersonationAuditEvent(ctx context.Context, input *models0.ImpersonationAuditEvent, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error) {
+	return helpers.CreateOrUpdate(ctx, input, d.db, opts...)
+}
+
+func (d *dao) PermanentDeleteImpersonationAuditEvent(ctx context.Context, input *models0.ImpersonationAuditEvent, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error) {
+	return helpers.PermanentDelete(ctx, input, d.db, opts...)
+}
+
+func (d *dao) PermanentDeleteImpersonationAuditEventByID(ctx context.Context, id string, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error) {
+	input := &models0.ImpersonationAuditEvent{}
+	input.ID = id
+
+	return helpers.PermanentDelete(ctx, input, d.db, opts...)
+}
+
+func (d *dao) PermanentDeleteImpersonationAuditEventFiltered(ctx context.Context, filter *models0.AuditEventFilter, opts ...helpers.GormOpt) error {
+	return helpers.PermanentDeleteFiltered(ctx, &models0.ImpersonationAuditEvent{}, filter, d.db, opts...)
+}
+
+func (d *dao) PatchUpdateImpersonationAuditEvent(ctx context.Context, input *models0.ImpersonationAuditEvent, patch map[string]any, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error) {
+	return helpers.PatchUpdate(ctx, input, patch, d.db, opts...)
+}
+
+func (d *dao) PatchUpdateImpersonationAuditEventByID(ctx context.Context, id string, patch map[string]any, opts ...helpers.GormOpt) (*models0.ImpersonationAuditEvent, error) {
+	input := &models0.ImpersonationAuditEvent{}
+	input.ID = id
+
+	return helpers.PatchUpdate(ctx, input, patch, d.db, opts...)
+}
+
+func (d *dao) PatchUpdateImpersonationAuditEventFiltered(ctx context.Context, filter *models0.AuditEventFilter, patch map[string]any, opts ...helpers.GormOpt) error {
+	return helpers.PatchUpdateAllFiltered(ctx, &models0.ImpersonationAuditEvent{}, patch, filter, d.db, opts...)
+}
+
+// Ending methods for ImpersonationAuditEvent structure
@@ -0,0 +1,603 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/daos (interfaces: Dao)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_Dao.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/daos Dao
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/models"
+	database "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	databasehelpers "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/helpers"
+	pagination "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDao is a mock of Dao interface.
+type MockDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockDaoMockRecorder
+	isgomock struct{}
+}
+
+// MockDaoMockRecorder is the mock recorder for MockDao.
+type MockDaoMockRecorder struct {
+	mock *MockDao
+}
+
+// NewMockDao creates a new mock instance.
+func NewMockDao(ctrl *gomock.Controller) *MockDao {
+	mock := &MockDao{ctrl: ctrl}
+	mock.recorder = &MockDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDao) EXPECT() *MockDaoMockRecorder {
+	return m.recorder
+}
+
+// CountImpersonationAuditEvent mocks base method.
+func (m *MockDao) CountImpersonationAuditEvent(ctx context.Context, filter *models.AuditEventFilter, opts ...databasehelpers.GormOpt) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountImpersonationAuditEvent", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountImpersonationAuditEvent indicates an expected call of CountImpersonationAuditEvent.
+func (mr *MockDaoMockRecorder) CountImpersonationAuditEvent(ctx, filter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountImpersonationAuditEvent", reflect.TypeOf((*MockDao)(nil).CountImpersonationAuditEvent), varargs...)
+}
+
+// CountImpersonationAuditEventPaginated mocks base method.
+func (m *MockDao) CountImpersonationAuditEventPaginated(ctx context.Context, page *pagination.PageInput, filter *models.AuditEventFilter, opts ...databasehelpers.GormOpt) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, page, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountImpersonationAuditEventPaginated", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountImpersonationAuditEventPaginated indicates an expected call of CountImpersonationAuditEventPaginated.
+func (mr *MockDaoMockRecorder) CountImpersonationAuditEventPaginated(ctx, page, filter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, page, filter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountImpersonationAuditEventPaginated", reflect.TypeOf((*MockDao)(nil).CountImpersonationAuditEventPaginated), varargs...)
+}
+
+// CountImpersonationSession mocks base method.
+func (m *MockDao) CountImpersonationSession(ctx context.Context, filter *models.Filter, opts ...databasehelpers.GormOpt) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountImpersonationSession", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountImpersonationSession indicates an expected call of CountImpersonationSession.
+func (mr *MockDaoMockRecorder) CountImpersonationSession(ctx, filter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountImpersonationSession", reflect.TypeOf((*MockDao)(nil).CountImpersonationSession), varargs...)
+}
+
+// CountImpersonationSessionPaginated mocks base method.
+func (m *MockDao) CountImpersonationSessionPaginated(ctx context.Context, page *pagination.PageInput, filter *models.Filter, opts ...databasehelpers.GormOpt) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, page, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountImpersonationSessionPaginated", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountImpersonationSessionPaginated indicates an expected call of CountImpersonationSessionPaginated.
+func (mr *MockDaoMockRecorder) CountImpersonationSessionPaginated(ctx, page, filter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, page, filter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountImpersonationSessionPaginated", reflect.TypeOf((*MockDao)(nil).CountImpersonationSessionPaginated), varargs...)
+}
+
+// CreateOrUpdateImpersonationAuditEvent mocks base method.
+func (m *MockDao) CreateOrUpdateImpersonationAuditEvent(ctx context.Context, input *models.ImpersonationAuditEvent, opts ...databasehelpers.GormOpt) (*models.ImpersonationAuditEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateOrUpdateImpersonationAuditEvent", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateImpersonationAuditEvent indicates an expected call of CreateOrUpdateImpersonationAuditEvent.
+func (mr *MockDaoMockRecorder) CreateOrUpdateImpersonationAuditEvent(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateImpersonationAuditEvent", reflect.TypeOf((*MockDao)(nil).CreateOrUpdateImpersonationAuditEvent), varargs...)
+}
+
+// CreateOrUpdateImpersonationSession mocks base method.
+func (m *MockDao) CreateOrUpdateImpersonationSession(ctx context.Context, input *models.ImpersonationSession, opts ...databasehelpers.GormOpt) (*models.ImpersonationSession, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateOrUpdateImpersonationSession", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateImpersonationSession indicates an expected call of CreateOrUpdateImpersonationSession.
+func (mr *MockDaoMockRecorder) CreateOrUpdateImpersonationSession(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateImpersonationSession", reflect.TypeOf((*MockDao)(nil).CreateOrUpdateImpersonationSession), varargs...)
+}
+
+// FindAllImpersonationAuditEvent mocks base method.
+func (m *MockDao) FindAllImpersonationAuditEvent(ctx context.Context, sorts []*models.AuditEventSortOrder, filter *models.AuditEventFilter, projection *models.AuditEventProjection, opts ...databasehelpers.GormOpt) ([]*models.ImpersonationAuditEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAllImpersonationAuditEvent", varargs...)
+	ret0, _ := ret[0].([]*models.ImpersonationAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllImpersonationAuditEvent indicates an expected call of FindAllImpersonationAuditEvent.
+func (mr *MockDaoMockRecorder) FindAllImpersonationAuditEvent(ctx, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllImpersonationAuditEvent", reflect.TypeOf((*MockDao)(nil).FindAllImpersonationAuditEvent), varargs...)
+}
+
+// FindAllImpersonationSession mocks base method.
+func (m *MockDao) FindAllImpersonationSession(ctx context.Context, sorts []*models.SortOrder, filter *models.Filter, projection *models.Projection, opts ...databasehelpers.GormOpt) ([]*models.ImpersonationSession, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAllImpersonationSession", varargs...)
+	ret0, _ := ret[0].([]*models.ImpersonationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllImpersonationSession indicates an expected call of FindAllImpersonationSession.
+func (mr *MockDaoMockRecorder) FindAllImpersonationSession(ctx, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllImpersonationSession", reflect.TypeOf((*MockDao)(nil).FindAllImpersonationSession), varargs...)
+}
+
+// FindImpersonationAuditEventByID mocks base method.
+func (m *MockDao) FindImpersonationAuditEventByID(ctx context.Context, id string, projection *models.AuditEventProjection, opts ...databasehelpers.GormOpt) (*models.ImpersonationAuditEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindImpersonationAuditEventByID", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImpersonationAuditEventByID indicates an expected call of FindImpersonationAuditEventByID.
+func (mr *MockDaoMockRecorder) FindImpersonationAuditEventByID(ctx, id, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImpersonationAuditEventByID", reflect.TypeOf((*MockDao)(nil).FindImpersonationAuditEventByID), varargs...)
+}
+
+// FindImpersonationAuditEventPaginated mocks base method.
+func (m *MockDao) FindImpersonationAuditEventPaginated(ctx context.Context, page *pagination.PageInput, sorts []*models.AuditEventSortOrder, filter *models.AuditEventFilter, projection *models.AuditEventProjection, opts ...database.TransactionOption) ([]*models.ImpersonationAuditEvent, *pagination.PageOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, page, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindImpersonationAuditEventPaginated", varargs...)
+	ret0, _ := ret[0].([]*models.ImpersonationAuditEvent)
+	ret1, _ := ret[1].(*pagination.PageOutput)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindImpersonationAuditEventPaginated indicates an expected call of FindImpersonationAuditEventPaginated.
+func (mr *MockDaoMockRecorder) FindImpersonationAuditEventPaginated(ctx, page, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, page, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImpersonationAuditEventPaginated", reflect.TypeOf((*MockDao)(nil).FindImpersonationAuditEventPaginated), varargs...)
+}
+
+// FindImpersonationAuditEventWithPagination mocks base method.
+func (m *MockDao) FindImpersonationAuditEventWithPagination(ctx context.Context, page *pagination.PageInput, sorts []*models.AuditEventSortOrder, filter *models.AuditEventFilter, projection *models.AuditEventProjection, opts ...databasehelpers.GormOpt) ([]*models.ImpersonationAuditEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, page, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindImpersonationAuditEventWithPagination", varargs...)
+	ret0, _ := ret[0].([]*models.ImpersonationAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImpersonationAuditEventWithPagination indicates an expected call of FindImpersonationAuditEventWithPagination.
+func (mr *MockDaoMockRecorder) FindImpersonationAuditEventWithPagination(ctx, page, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, page, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImpersonationAuditEventWithPagination", reflect.TypeOf((*MockDao)(nil).FindImpersonationAuditEventWithPagination), varargs...)
+}
+
+// FindImpersonationSessionByID mocks base method.
+func (m *MockDao) FindImpersonationSessionByID(ctx context.Context, id string, projection *models.Projection, opts ...databasehelpers.GormOpt) (*models.ImpersonationSession, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindImpersonationSessionByID", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImpersonationSessionByID indicates an expected call of FindImpersonationSessionByID.
+func (mr *MockDaoMockRecorder) FindImpersonationSessionByID(ctx, id, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImpersonationSessionByID", reflect.TypeOf((*MockDao)(nil).FindImpersonationSessionByID), varargs...)
+}
+
+// FindImpersonationSessionPaginated mocks base method.
+func (m *MockDao) FindImpersonationSessionPaginated(ctx context.Context, page *pagination.PageInput, sorts []*models.SortOrder, filter *models.Filter, projection *models.Projection, opts ...database.TransactionOption) ([]*models.ImpersonationSession, *pagination.PageOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, page, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindImpersonationSessionPaginated", varargs...)
+	ret0, _ := ret[0].([]*models.ImpersonationSession)
+	ret1, _ := ret[1].(*pagination.PageOutput)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindImpersonationSessionPaginated indicates an expected call of FindImpersonationSessionPaginated.
+func (mr *MockDaoMockRecorder) FindImpersonationSessionPaginated(ctx, page, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, page, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImpersonationSessionPaginated", reflect.TypeOf((*MockDao)(nil).FindImpersonationSessionPaginated), varargs...)
+}
+
+// FindImpersonationSessionWithPagination mocks base method.
+func (m *MockDao) FindImpersonationSessionWithPagination(ctx context.Context, page *pagination.PageInput, sorts []*models.SortOrder, filter *models.Filter, projection *models.Projection, opts ...databasehelpers.GormOpt) ([]*models.ImpersonationSession, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, page, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindImpersonationSessionWithPagination", varargs...)
+	ret0, _ := ret[0].([]*models.ImpersonationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImpersonationSessionWithPagination indicates an expected call of FindImpersonationSessionWithPagination.
+func (mr *MockDaoMockRecorder) FindImpersonationSessionWithPagination(ctx, page, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, page, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImpersonationSessionWithPagination", reflect.TypeOf((*MockDao)(nil).FindImpersonationSessionWithPagination), varargs...)
+}
+
+// FindOneImpersonationAuditEvent mocks base method.
+func (m *MockDao) FindOneImpersonationAuditEvent(ctx context.Context, sorts []*models.AuditEventSortOrder, filter *models.AuditEventFilter, projection *models.AuditEventProjection, opts ...databasehelpers.GormOpt) (*models.ImpersonationAuditEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindOneImpersonationAuditEvent", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneImpersonationAuditEvent indicates an expected call of FindOneImpersonationAuditEvent.
+func (mr *MockDaoMockRecorder) FindOneImpersonationAuditEvent(ctx, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneImpersonationAuditEvent", reflect.TypeOf((*MockDao)(nil).FindOneImpersonationAuditEvent), varargs...)
+}
+
+// FindOneImpersonationSession mocks base method.
+func (m *MockDao) FindOneImpersonationSession(ctx context.Context, sorts []*models.SortOrder, filter *models.Filter, projection *models.Projection, opts ...databasehelpers.GormOpt) (*models.ImpersonationSession, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindOneImpersonationSession", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneImpersonationSession indicates an expected call of FindOneImpersonationSession.
+func (mr *MockDaoMockRecorder) FindOneImpersonationSession(ctx, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneImpersonationSession", reflect.TypeOf((*MockDao)(nil).FindOneImpersonationSession), varargs...)
+}
+
+// PatchUpdateImpersonationAuditEvent mocks base method.
+func (m *MockDao) PatchUpdateImpersonationAuditEvent(ctx context.Context, input *models.ImpersonationAuditEvent, patch map[string]any, opts ...databasehelpers.GormOpt) (*models.ImpersonationAuditEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchUpdateImpersonationAuditEvent", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchUpdateImpersonationAuditEvent indicates an expected call of PatchUpdateImpersonationAuditEvent.
+func (mr *MockDaoMockRecorder) PatchUpdateImpersonationAuditEvent(ctx, input, patch any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpdateImpersonationAuditEvent", reflect.TypeOf((*MockDao)(nil).PatchUpdateImpersonationAuditEvent), varargs...)
+}
+
+// PatchUpdateImpersonationAuditEventByID mocks base method.
+func (m *MockDao) PatchUpdateImpersonationAuditEventByID(ctx context.Context, id string, patch map[string]any, opts ...databasehelpers.GormOpt) (*models.ImpersonationAuditEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchUpdateImpersonationAuditEventByID", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchUpdateImpersonationAuditEventByID indicates an expected call of PatchUpdateImpersonationAuditEventByID.
+func (mr *MockDaoMockRecorder) PatchUpdateImpersonationAuditEventByID(ctx, id, patch any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpdateImpersonationAuditEventByID", reflect.TypeOf((*MockDao)(nil).PatchUpdateImpersonationAuditEventByID), varargs...)
+}
+
+// PatchUpdateImpersonationAuditEventFiltered mocks base method.
+func (m *MockDao) PatchUpdateImpersonationAuditEventFiltered(ctx context.Context, filter *models.AuditEventFilter, patch map[string]any, opts ...databasehelpers.GormOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchUpdateImpersonationAuditEventFiltered", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchUpdateImpersonationAuditEventFiltered indicates an expected call of PatchUpdateImpersonationAuditEventFiltered.
+func (mr *MockDaoMockRecorder) PatchUpdateImpersonationAuditEventFiltered(ctx, filter, patch any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpdateImpersonationAuditEventFiltered", reflect.TypeOf((*MockDao)(nil).PatchUpdateImpersonationAuditEventFiltered), varargs...)
+}
+
+// PatchUpdateImpersonationSession mocks base method.
+func (m *MockDao) PatchUpdateImpersonationSession(ctx context.Context, input *models.ImpersonationSession, patch map[string]any, opts ...databasehelpers.GormOpt) (*models.ImpersonationSession, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchUpdateImpersonationSession", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchUpdateImpersonationSession indicates an expected call of PatchUpdateImpersonationSession.
+func (mr *MockDaoMockRecorder) PatchUpdateImpersonationSession(ctx, input, patch any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpdateImpersonationSession", reflect.TypeOf((*MockDao)(nil).PatchUpdateImpersonationSession), varargs...)
+}
+
+// PatchUpdateImpersonationSessionByID mocks base method.
+func (m *MockDao) PatchUpdateImpersonationSessionByID(ctx context.Context, id string, patch map[string]any, opts ...databasehelpers.GormOpt) (*models.ImpersonationSession, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchUpdateImpersonationSessionByID", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchUpdateImpersonationSessionByID indicates an expected call of PatchUpdateImpersonationSessionByID.
+func (mr *MockDaoMockRecorder) PatchUpdateImpersonationSessionByID(ctx, id, patch any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpdateImpersonationSessionByID", reflect.TypeOf((*MockDao)(nil).PatchUpdateImpersonationSessionByID), varargs...)
+}
+
+// PatchUpdateImpersonationSessionFiltered mocks base method.
+func (m *MockDao) PatchUpdateImpersonationSessionFiltered(ctx context.Context, filter *models.Filter, patch map[string]any, opts ...databasehelpers.GormOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchUpdateImpersonationSessionFiltered", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchUpdateImpersonationSessionFiltered indicates an expected call of PatchUpdateImpersonationSessionFiltered.
+func (mr *MockDaoMockRecorder) PatchUpdateImpersonationSessionFiltered(ctx, filter, patch any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpdateImpersonationSessionFiltered", reflect.TypeOf((*MockDao)(nil).PatchUpdateImpersonationSessionFiltered), varargs...)
+}
+
+// PermanentDeleteImpersonationAuditEvent mocks base method.
+func (m *MockDao) PermanentDeleteImpersonationAuditEvent(ctx context.Context, input *models.ImpersonationAuditEvent, opts ...databasehelpers.GormOpt) (*models.ImpersonationAuditEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentDeleteImpersonationAuditEvent", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PermanentDeleteImpersonationAuditEvent indicates an expected call of PermanentDeleteImpersonationAuditEvent.
+func (mr *MockDaoMockRecorder) PermanentDeleteImpersonationAuditEvent(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteImpersonationAuditEvent", reflect.TypeOf((*MockDao)(nil).PermanentDeleteImpersonationAuditEvent), varargs...)
+}
+
+// PermanentDeleteImpersonationAuditEventByID mocks base method.
+func (m *MockDao) PermanentDeleteImpersonationAuditEventByID(ctx context.Context, id string, opts ...databasehelpers.GormOpt) (*models.ImpersonationAuditEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentDeleteImpersonationAuditEventByID", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PermanentDeleteImpersonationAuditEventByID indicates an expected call of PermanentDeleteImpersonationAuditEventByID.
+func (mr *MockDaoMockRecorder) PermanentDeleteImpersonationAuditEventByID(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteImpersonationAuditEventByID", reflect.TypeOf((*MockDao)(nil).PermanentDeleteImpersonationAuditEventByID), varargs...)
+}
+
+// PermanentDeleteImpersonationAuditEventFiltered mocks base method.
+func (m *MockDao) PermanentDeleteImpersonationAuditEventFiltered(ctx context.Context, filter *models.AuditEventFilter, opts ...databasehelpers.GormOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentDeleteImpersonationAuditEventFiltered", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PermanentDeleteImpersonationAuditEventFiltered indicates an expected call of PermanentDeleteImpersonationAuditEventFiltered.
+func (mr *MockDaoMockRecorder) PermanentDeleteImpersonationAuditEventFiltered(ctx, filter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteImpersonationAuditEventFiltered", reflect.TypeOf((*MockDao)(nil).PermanentDeleteImpersonationAuditEventFiltered), varargs...)
+}
+
+// PermanentDeleteImpersonationSession mocks base method.
+func (m *MockDao) PermanentDeleteImpersonationSession(ctx context.Context, input *models.ImpersonationSession, opts ...databasehelpers.GormOpt) (*models.ImpersonationSession, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentDeleteImpersonationSession", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PermanentDeleteImpersonationSession indicates an expected call of PermanentDeleteImpersonationSession.
+func (mr *MockDaoMockRecorder) PermanentDeleteImpersonationSession(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteImpersonationSession", reflect.TypeOf((*MockDao)(nil).PermanentDeleteImpersonationSession), varargs...)
+}
+
+// PermanentDeleteImpersonationSessionByID mocks base method.
+func (m *MockDao) PermanentDeleteImpersonationSessionByID(ctx context.Context, id string, opts ...databasehelpers.GormOpt) (*models.ImpersonationSession, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentDeleteImpersonationSessionByID", varargs...)
+	ret0, _ := ret[0].(*models.ImpersonationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PermanentDeleteImpersonationSessionByID indicates an expected call of PermanentDeleteImpersonationSessionByID.
+func (mr *MockDaoMockRecorder) PermanentDeleteImpersonationSessionByID(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteImpersonationSessionByID", reflect.TypeOf((*MockDao)(nil).PermanentDeleteImpersonationSessionByID), varargs...)
+}
+
+// PermanentDeleteImpersonationSessionFiltered mocks base method.
+func (m *MockDao) PermanentDeleteImpersonationSessionFiltered(ctx context.Context, filter *models.Filter, opts ...databasehelpers.GormOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentDeleteImpersonationSessionFiltered", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PermanentDeleteImpersonationSessionFiltered indicates an expected call of PermanentDeleteImpersonationSessionFiltered.
+func (mr *MockDaoMockRecorder) PermanentDeleteImpersonationSessionFiltered(ctx, filter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteImpersonationSessionFiltered", reflect.TypeOf((*MockDao)(nil).PermanentDeleteImpersonationSessionFiltered), varargs...)
+}
@@ -0,0 +1,3 @@
+package impersonations
+
+// This package will manage business of audited impersonation sessions
@@ -0,0 +1,42 @@
+package impersonations
+
+import (
+	"context"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/daos"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+)
+
+//go:generate mockgen -destination=./mocks/mock_AuthorizationService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations AuthorizationService
+type AuthorizationService interface {
+	CheckAuthorized(ctx context.Context, action, resource string) error
+}
+
+//go:generate mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations Service
+type Service interface {
+	// Start will start an audited impersonation of a user by the authenticated user.
+	Start(ctx context.Context, inp *InputStartImpersonation) (*models.ImpersonationSession, error)
+	// Stop will end an impersonation started by the authenticated user.
+	Stop(ctx context.Context, id string) (*models.ImpersonationSession, error)
+	// GetOrStartImpersonation will return the active impersonation of target by the authenticated user
+	// or start a new one when allowed.
+	GetOrStartImpersonation(ctx context.Context, target string) (*authxmodels.Impersonation, error)
+	// RecordImpersonatedRequest will add a request done by the impersonated user in audit log.
+	RecordImpersonatedRequest(ctx context.Context, req *authxmodels.ImpersonatedRequest) error
+}
+
+type InputStartImpersonation struct {
+	Username string
+	Reason   string
+}
+
+func NewService(cfgManager config.Manager, db database.DB, authSvc AuthorizationService) Service {
+	// Create dao
+	dao := daos.NewDao(db)
+
+	return &service{cfgManager: cfgManager, dao: dao, authSvc: authSvc}
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations Service
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	impersonations "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations"
+	models0 "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// GetOrStartImpersonation mocks base method.
+func (m *MockService) GetOrStartImpersonation(ctx context.Context, target string) (*models.Impersonation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrStartImpersonation", ctx, target)
+	ret0, _ := ret[0].(*models.Impersonation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrStartImpersonation indicates an expected call of GetOrStartImpersonation.
+func (mr *MockServiceMockRecorder) GetOrStartImpersonation(ctx, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrStartImpersonation", reflect.TypeOf((*MockService)(nil).GetOrStartImpersonation), ctx, target)
+}
+
+// RecordImpersonatedRequest mocks base method.
+func (m *MockService) RecordImpersonatedRequest(ctx context.Context, req *models.ImpersonatedRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordImpersonatedRequest", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordImpersonatedRequest indicates an expected call of RecordImpersonatedRequest.
+func (mr *MockServiceMockRecorder) RecordImpersonatedRequest(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordImpersonatedRequest", reflect.TypeOf((*MockService)(nil).RecordImpersonatedRequest), ctx, req)
+}
+
+// Start mocks base method.
+func (m *MockService) Start(ctx context.Context, inp *impersonations.InputStartImpersonation) (*models0.ImpersonationSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, inp)
+	ret0, _ := ret[0].(*models0.ImpersonationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockServiceMockRecorder) Start(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockService)(nil).Start), ctx, inp)
+}
+
+// Stop mocks base method.
+func (m *MockService) Stop(ctx context.Context, id string) (*models0.ImpersonationSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, id)
+	ret0, _ := ret[0].(*models0.ImpersonationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockServiceMockRecorder) Stop(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockService)(nil).Stop), ctx, id)
+}
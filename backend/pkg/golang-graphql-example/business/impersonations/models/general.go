@@ -0,0 +1,52 @@
+package models
+
+import "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+
+type SortOrder struct {
+	CreatedAt *common.SortOrderEnum `dbfield:"created_at"`
+	ExpiresAt *common.SortOrderEnum `dbfield:"expires_at"`
+}
+
+type Filter struct {
+	ID        *common.GenericFilter `dbfield:"id"`
+	ExpiresAt *common.DateFilter    `dbfield:"expires_at"`
+	EndedAt   *common.DateFilter    `dbfield:"ended_at"`
+	Actor     *common.GenericFilter `dbfield:"actor"`
+	Target    *common.GenericFilter `dbfield:"target"`
+	AND       []*Filter
+	OR        []*Filter
+}
+
+type Projection struct {
+	ID        bool `dbfield:"id"         graphqlfield:"id"`
+	CreatedAt bool `dbfield:"created_at" graphqlfield:"createdAt"`
+	ExpiresAt bool `dbfield:"expires_at" graphqlfield:"expiresAt"`
+	EndedAt   bool `dbfield:"ended_at"   graphqlfield:"endedAt"`
+	Actor     bool `dbfield:"actor"      graphqlfield:"actor"`
+	Target    bool `dbfield:"target"     graphqlfield:"target"`
+	Reason    bool `dbfield:"reason"     graphqlfield:"reason"`
+}
+
+type AuditEventSortOrder struct {
+	CreatedAt *common.SortOrderEnum `dbfield:"created_at"`
+}
+
+type AuditEventFilter struct {
+	ImpersonationID *common.GenericFilter `dbfield:"impersonation_id"`
+	Actor           *common.GenericFilter `dbfield:"actor"`
+	Event           *common.GenericFilter `dbfield:"event"`
+	AND             []*AuditEventFilter
+	OR              []*AuditEventFilter
+}
+
+type AuditEventProjection struct {
+	ID              bool `dbfield:"id"`
+	CreatedAt       bool `dbfield:"created_at"`
+	ImpersonationID bool `dbfield:"impersonation_id"`
+	Event           bool `dbfield:"event"`
+	Actor           bool `dbfield:"actor"`
+	Target          bool `dbfield:"target"`
+	Method          bool `dbfield:"method"`
+	URI             bool `dbfield:"uri"`
+	CorrelationID   bool `dbfield:"correlation_id"`
+}
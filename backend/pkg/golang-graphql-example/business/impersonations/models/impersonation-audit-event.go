@@ -0,0 +1,24 @@
+package models
+
+import (
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+)
+
+// Impersonation audit event types.
+const (
+	AuditEventStart   = "START"
+	AuditEventRequest = "REQUEST"
+	AuditEventStop    = "STOP"
+)
+
+//go:generate go run github.com/oxyno-zeta/golang-graphql-example/tools/generator/modeltagsgen github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/models ImpersonationAuditEvent
+type ImpersonationAuditEvent struct {
+	database.Base
+	ImpersonationID string `gorm:"type:varchar(36);index"`
+	Event           string `gorm:"type:varchar(20)"`
+	Actor           string `gorm:"type:varchar(500);index"`
+	Target          string `gorm:"type:varchar(500)"`
+	Method          string `gorm:"type:varchar(20)"`
+	URI             string `gorm:"type:varchar(2000)"`
+	CorrelationID   string `gorm:"type:varchar(100)"`
+}
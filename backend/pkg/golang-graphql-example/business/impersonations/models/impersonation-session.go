@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+)
+
+//go:generate go run github.com/oxyno-zeta/golang-graphql-example/tools/generator/modeltagsgen github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/models ImpersonationSession
+type ImpersonationSession struct {
+	database.Base
+	ExpiresAt    time.Time `gorm:"index"`
+	EndedAt      *time.Time
+	Actor        string `gorm:"type:varchar(500);index"`
+	ActorIssuer  string `gorm:"type:varchar(500)"`
+	ActorSubject string `gorm:"type:varchar(500)"`
+	Target       string `gorm:"type:varchar(500);index"`
+	Reason       string `gorm:"type:varchar(1000)"`
+}
+
+// IsActive will check if impersonation session isn't ended or expired.
+func (s *ImpersonationSession) IsActive() bool {
+	return s.EndedAt == nil && s.ExpiresAt.After(time.Now())
+}
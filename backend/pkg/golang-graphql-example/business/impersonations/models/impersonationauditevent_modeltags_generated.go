@@ -0,0 +1,409 @@
+// Code generated by ModelTags, DO NOT EDIT.
+package models
+
+import errors "emperror.dev/errors"
+
+// ErrImpersonationAuditEventUnsupportedGormColumn will be thrown when an unsupported Gorm column will be found in transform function.
+var ErrImpersonationAuditEventUnsupportedGormColumn = errors.Sentinel("unsupported gorm column")
+
+// ErrImpersonationAuditEventUnsupportedJSONKey will be thrown when an unsupported JSON key will be found in transform function.
+var ErrImpersonationAuditEventUnsupportedJSONKey = errors.Sentinel("unsupported json key")
+
+// ErrImpersonationAuditEventUnsupportedStructKeyName will be thrown when an unsupported structure key will be found in transform function.
+var ErrImpersonationAuditEventUnsupportedStructKeyName = errors.Sentinel("unsupported struct key")
+
+/* Gorm columns Names */
+// ImpersonationAuditEvent Actor Gorm Column Name
+const ImpersonationAuditEventActorGormColumnName = "actor"
+
+// ImpersonationAuditEvent CorrelationID Gorm Column Name
+const ImpersonationAuditEventCorrelationIDGormColumnName = "correlation_id"
+
+// ImpersonationAuditEvent CreatedAt Gorm Column Name
+const ImpersonationAuditEventCreatedAtGormColumnName = "created_at"
+
+// ImpersonationAuditEvent DeletedAt Gorm Column Name
+const ImpersonationAuditEventDeletedAtGormColumnName = "deleted_at"
+
+// ImpersonationAuditEvent Event Gorm Column Name
+const ImpersonationAuditEventEventGormColumnName = "event"
+
+// ImpersonationAuditEvent ID Gorm Column Name
+const ImpersonationAuditEventIDGormColumnName = "id"
+
+// ImpersonationAuditEvent ImpersonationID Gorm Column Name
+const ImpersonationAuditEventImpersonationIDGormColumnName = "impersonation_id"
+
+// ImpersonationAuditEvent Method Gorm Column Name
+const ImpersonationAuditEventMethodGormColumnName = "method"
+
+// ImpersonationAuditEvent Target Gorm Column Name
+const ImpersonationAuditEventTargetGormColumnName = "target"
+
+// ImpersonationAuditEvent URI Gorm Column Name
+const ImpersonationAuditEventURIGormColumnName = "uri"
+
+// ImpersonationAuditEvent UpdatedAt Gorm Column Name
+const ImpersonationAuditEventUpdatedAtGormColumnName = "updated_at"
+
+var ImpersonationAuditEventGormColumnNameList = []string{ImpersonationAuditEventActorGormColumnName, ImpersonationAuditEventCorrelationIDGormColumnName, ImpersonationAuditEventCreatedAtGormColumnName, ImpersonationAuditEventDeletedAtGormColumnName, ImpersonationAuditEventEventGormColumnName, ImpersonationAuditEventIDGormColumnName, ImpersonationAuditEventImpersonationIDGormColumnName, ImpersonationAuditEventMethodGormColumnName, ImpersonationAuditEventTargetGormColumnName, ImpersonationAuditEventURIGormColumnName, ImpersonationAuditEventUpdatedAtGormColumnName}
+
+/* JSON Key Names */
+// ImpersonationAuditEvent Actor JSON Key Name
+const ImpersonationAuditEventActorJSONKeyName = "Actor"
+
+// ImpersonationAuditEvent CorrelationID JSON Key Name
+const ImpersonationAuditEventCorrelationIDJSONKeyName = "CorrelationID"
+
+// ImpersonationAuditEvent CreatedAt JSON Key Name
+const ImpersonationAuditEventCreatedAtJSONKeyName = "createdAt"
+
+// ImpersonationAuditEvent DeletedAt JSON Key Name
+const ImpersonationAuditEventDeletedAtJSONKeyName = "deletedAt"
+
+// ImpersonationAuditEvent Event JSON Key Name
+const ImpersonationAuditEventEventJSONKeyName = "Event"
+
+// ImpersonationAuditEvent ID JSON Key Name
+const ImpersonationAuditEventIDJSONKeyName = "id"
+
+// ImpersonationAuditEvent ImpersonationID JSON Key Name
+const ImpersonationAuditEventImpersonationIDJSONKeyName = "ImpersonationID"
+
+// ImpersonationAuditEvent Method JSON Key Name
+const ImpersonationAuditEventMethodJSONKeyName = "Method"
+
+// ImpersonationAuditEvent Target JSON Key Name
+const ImpersonationAuditEventTargetJSONKeyName = "Target"
+
+// ImpersonationAuditEvent URI JSON Key Name
+const ImpersonationAuditEventURIJSONKeyName = "URI"
+
+// ImpersonationAuditEvent UpdatedAt JSON Key Name
+const ImpersonationAuditEventUpdatedAtJSONKeyName = "updatedAt"
+
+var ImpersonationAuditEventJSONKeyNameList = []string{ImpersonationAuditEventActorJSONKeyName, ImpersonationAuditEventCorrelationIDJSONKeyName, ImpersonationAuditEventCreatedAtJSONKeyName, ImpersonationAuditEventDeletedAtJSONKeyName, ImpersonationAuditEventEventJSONKeyName, ImpersonationAuditEventIDJSONKeyName, ImpersonationAuditEventImpersonationIDJSONKeyName, ImpersonationAuditEventMethodJSONKeyName, ImpersonationAuditEventTargetJSONKeyName, ImpersonationAuditEventURIJSONKeyName, ImpersonationAuditEventUpdatedAtJSONKeyName}
+
+/* Struct Key Names */
+// ImpersonationAuditEvent Actor Struct Key Name
+const ImpersonationAuditEventActorStructKeyName = "Actor"
+
+// ImpersonationAuditEvent CorrelationID Struct Key Name
+const ImpersonationAuditEventCorrelationIDStructKeyName = "CorrelationID"
+
+// ImpersonationAuditEvent CreatedAt Struct Key Name
+const ImpersonationAuditEventCreatedAtStructKeyName = "CreatedAt"
+
+// ImpersonationAuditEvent DeletedAt Struct Key Name
+const ImpersonationAuditEventDeletedAtStructKeyName = "DeletedAt"
+
+// ImpersonationAuditEvent Event Struct Key Name
+const ImpersonationAuditEventEventStructKeyName = "Event"
+
+// ImpersonationAuditEvent ID Struct Key Name
+const ImpersonationAuditEventIDStructKeyName = "ID"
+
+// ImpersonationAuditEvent ImpersonationID Struct Key Name
+const ImpersonationAuditEventImpersonationIDStructKeyName = "ImpersonationID"
+
+// ImpersonationAuditEvent Method Struct Key Name
+const ImpersonationAuditEventMethodStructKeyName = "Method"
+
+// ImpersonationAuditEvent Target Struct Key Name
+const ImpersonationAuditEventTargetStructKeyName = "Target"
+
+// ImpersonationAuditEvent URI Struct Key Name
+const ImpersonationAuditEventURIStructKeyName = "URI"
+
+// ImpersonationAuditEvent UpdatedAt Struct Key Name
+const ImpersonationAuditEventUpdatedAtStructKeyName = "UpdatedAt"
+
+var ImpersonationAuditEventStructKeyNameList = []string{ImpersonationAuditEventActorStructKeyName, ImpersonationAuditEventCorrelationIDStructKeyName, ImpersonationAuditEventCreatedAtStructKeyName, ImpersonationAuditEventDeletedAtStructKeyName, ImpersonationAuditEventEventStructKeyName, ImpersonationAuditEventIDStructKeyName, ImpersonationAuditEventImpersonationIDStructKeyName, ImpersonationAuditEventMethodStructKeyName, ImpersonationAuditEventTargetStructKeyName, ImpersonationAuditEventURIStructKeyName, ImpersonationAuditEventUpdatedAtStructKeyName}
+
+// Transform ImpersonationAuditEvent Gorm Column To JSON Key
+func TransformImpersonationAuditEventGormColumnToJSONKey(gormColumn string) (string, error) {
+	switch gormColumn {
+	case ImpersonationAuditEventActorGormColumnName:
+		return ImpersonationAuditEventActorJSONKeyName, nil
+	case ImpersonationAuditEventCorrelationIDGormColumnName:
+		return ImpersonationAuditEventCorrelationIDJSONKeyName, nil
+	case ImpersonationAuditEventCreatedAtGormColumnName:
+		return ImpersonationAuditEventCreatedAtJSONKeyName, nil
+	case ImpersonationAuditEventDeletedAtGormColumnName:
+		return ImpersonationAuditEventDeletedAtJSONKeyName, nil
+	case ImpersonationAuditEventEventGormColumnName:
+		return ImpersonationAuditEventEventJSONKeyName, nil
+	case ImpersonationAuditEventIDGormColumnName:
+		return ImpersonationAuditEventIDJSONKeyName, nil
+	case ImpersonationAuditEventImpersonationIDGormColumnName:
+		return ImpersonationAuditEventImpersonationIDJSONKeyName, nil
+	case ImpersonationAuditEventMethodGormColumnName:
+		return ImpersonationAuditEventMethodJSONKeyName, nil
+	case ImpersonationAuditEventTargetGormColumnName:
+		return ImpersonationAuditEventTargetJSONKeyName, nil
+	case ImpersonationAuditEventURIGormColumnName:
+		return ImpersonationAuditEventURIJSONKeyName, nil
+	case ImpersonationAuditEventUpdatedAtGormColumnName:
+		return ImpersonationAuditEventUpdatedAtJSONKeyName, nil
+	default:
+		return "", errors.WithStack(ErrImpersonationAuditEventUnsupportedGormColumn)
+	}
+}
+
+// Transform ImpersonationAuditEvent JSON Key To Gorm Column
+func TransformImpersonationAuditEventJSONKeyToGormColumn(jsonKey string) (string, error) {
+	switch jsonKey {
+	case ImpersonationAuditEventActorJSONKeyName:
+		return ImpersonationAuditEventActorGormColumnName, nil
+	case ImpersonationAuditEventCorrelationIDJSONKeyName:
+		return ImpersonationAuditEventCorrelationIDGormColumnName, nil
+	case ImpersonationAuditEventCreatedAtJSONKeyName:
+		return ImpersonationAuditEventCreatedAtGormColumnName, nil
+	case ImpersonationAuditEventDeletedAtJSONKeyName:
+		return ImpersonationAuditEventDeletedAtGormColumnName, nil
+	case ImpersonationAuditEventEventJSONKeyName:
+		return ImpersonationAuditEventEventGormColumnName, nil
+	case ImpersonationAuditEventIDJSONKeyName:
+		return ImpersonationAuditEventIDGormColumnName, nil
+	case ImpersonationAuditEventImpersonationIDJSONKeyName:
+		return ImpersonationAuditEventImpersonationIDGormColumnName, nil
+	case ImpersonationAuditEventMethodJSONKeyName:
+		return ImpersonationAuditEventMethodGormColumnName, nil
+	case ImpersonationAuditEventTargetJSONKeyName:
+		return ImpersonationAuditEventTargetGormColumnName, nil
+	case ImpersonationAuditEventURIJSONKeyName:
+		return ImpersonationAuditEventURIGormColumnName, nil
+	case ImpersonationAuditEventUpdatedAtJSONKeyName:
+		return ImpersonationAuditEventUpdatedAtGormColumnName, nil
+	default:
+		return "", errors.WithStack(ErrImpersonationAuditEventUnsupportedJSONKey)
+	}
+}
+
+// Transform ImpersonationAuditEvent JSON Key map To Gorm Column map
+func TransformImpersonationAuditEventJSONKeyMapToGormColumnMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformImpersonationAuditEventJSONKeyToGormColumn(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrImpersonationAuditEventUnsupportedJSONKey) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform ImpersonationAuditEvent Gorm Column map To JSON Key map
+func TransformImpersonationAuditEventGormColumnMapToJSONKeyMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformImpersonationAuditEventGormColumnToJSONKey(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrImpersonationAuditEventUnsupportedGormColumn) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform ImpersonationAuditEvent Gorm Column To Struct Key Name
+func TransformImpersonationAuditEventGormColumnToStructKeyName(gormColumn string) (string, error) {
+	switch gormColumn {
+	case ImpersonationAuditEventActorGormColumnName:
+		return ImpersonationAuditEventActorStructKeyName, nil
+	case ImpersonationAuditEventCorrelationIDGormColumnName:
+		return ImpersonationAuditEventCorrelationIDStructKeyName, nil
+	case ImpersonationAuditEventCreatedAtGormColumnName:
+		return ImpersonationAuditEventCreatedAtStructKeyName, nil
+	case ImpersonationAuditEventDeletedAtGormColumnName:
+		return ImpersonationAuditEventDeletedAtStructKeyName, nil
+	case ImpersonationAuditEventEventGormColumnName:
+		return ImpersonationAuditEventEventStructKeyName, nil
+	case ImpersonationAuditEventIDGormColumnName:
+		return ImpersonationAuditEventIDStructKeyName, nil
+	case ImpersonationAuditEventImpersonationIDGormColumnName:
+		return ImpersonationAuditEventImpersonationIDStructKeyName, nil
+	case ImpersonationAuditEventMethodGormColumnName:
+		return ImpersonationAuditEventMethodStructKeyName, nil
+	case ImpersonationAuditEventTargetGormColumnName:
+		return ImpersonationAuditEventTargetStructKeyName, nil
+	case ImpersonationAuditEventURIGormColumnName:
+		return ImpersonationAuditEventURIStructKeyName, nil
+	case ImpersonationAuditEventUpdatedAtGormColumnName:
+		return ImpersonationAuditEventUpdatedAtStructKeyName, nil
+	default:
+		return "", errors.WithStack(ErrImpersonationAuditEventUnsupportedGormColumn)
+	}
+}
+
+// Transform ImpersonationAuditEvent Struct Key Name To Gorm Column
+func TransformImpersonationAuditEventStructKeyNameToGormColumn(structKey string) (string, error) {
+	switch structKey {
+	case ImpersonationAuditEventActorStructKeyName:
+		return ImpersonationAuditEventActorGormColumnName, nil
+	case ImpersonationAuditEventCorrelationIDStructKeyName:
+		return ImpersonationAuditEventCorrelationIDGormColumnName, nil
+	case ImpersonationAuditEventCreatedAtStructKeyName:
+		return ImpersonationAuditEventCreatedAtGormColumnName, nil
+	case ImpersonationAuditEventDeletedAtStructKeyName:
+		return ImpersonationAuditEventDeletedAtGormColumnName, nil
+	case ImpersonationAuditEventEventStructKeyName:
+		return ImpersonationAuditEventEventGormColumnName, nil
+	case ImpersonationAuditEventIDStructKeyName:
+		return ImpersonationAuditEventIDGormColumnName, nil
+	case ImpersonationAuditEventImpersonationIDStructKeyName:
+		return ImpersonationAuditEventImpersonationIDGormColumnName, nil
+	case ImpersonationAuditEventMethodStructKeyName:
+		return ImpersonationAuditEventMethodGormColumnName, nil
+	case ImpersonationAuditEventTargetStructKeyName:
+		return ImpersonationAuditEventTargetGormColumnName, nil
+	case ImpersonationAuditEventURIStructKeyName:
+		return ImpersonationAuditEventURIGormColumnName, nil
+	case ImpersonationAuditEventUpdatedAtStructKeyName:
+		return ImpersonationAuditEventUpdatedAtGormColumnName, nil
+	default:
+		return "", errors.WithStack(ErrImpersonationAuditEventUnsupportedStructKeyName)
+	}
+}
+
+// Transform ImpersonationAuditEvent Struct Key Name map To Gorm Column map
+func TransformImpersonationAuditEventStructKeyNameMapToGormColumnMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformImpersonationAuditEventStructKeyNameToGormColumn(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrImpersonationAuditEventUnsupportedStructKeyName) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform ImpersonationAuditEvent Gorm Column map To Struct Key Name map
+func TransformImpersonationAuditEventGormColumnMapToStructKeyNameMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformImpersonationAuditEventGormColumnToStructKeyName(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrImpersonationAuditEventUnsupportedGormColumn) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform ImpersonationAuditEvent JSON Key To Struct Key Name
+func TransformImpersonationAuditEventJSONKeyToStructKeyName(jsonKey string) (string, error) {
+	switch jsonKey {
+	case ImpersonationAuditEventActorJSONKeyName:
+		return ImpersonationAuditEventActorStructKeyName, nil
+	case ImpersonationAuditEventCorrelationIDJSONKeyName:
+		return ImpersonationAuditEventCorrelationIDStructKeyName, nil
+	case ImpersonationAuditEventCreatedAtJSONKeyName:
+		return ImpersonationAuditEventCreatedAtStructKeyName, nil
+	case ImpersonationAuditEventDeletedAtJSONKeyName:
+		return ImpersonationAuditEventDeletedAtStructKeyName, nil
+	case ImpersonationAuditEventEventJSONKeyName:
+		return ImpersonationAuditEventEventStructKeyName, nil
+	case ImpersonationAuditEventIDJSONKeyName:
+		return ImpersonationAuditEventIDStructKeyName, nil
+	case ImpersonationAuditEventImpersonationIDJSONKeyName:
+		return ImpersonationAuditEventImpersonationIDStructKeyName, nil
+	case ImpersonationAuditEventMethodJSONKeyName:
+		return ImpersonationAuditEventMethodStructKeyName, nil
+	case ImpersonationAuditEventTargetJSONKeyName:
+		return ImpersonationAuditEventTargetStructKeyName, nil
+	case ImpersonationAuditEventURIJSONKeyName:
+		return ImpersonationAuditEventURIStructKeyName, nil
+	case ImpersonationAuditEventUpdatedAtJSONKeyName:
+		return ImpersonationAuditEventUpdatedAtStructKeyName, nil
+	default:
+		return "", errors.WithStack(ErrImpersonationAuditEventUnsupportedJSONKey)
+	}
+}
+
+// Transform ImpersonationAuditEvent Struct Key Name To JSON Key
+func TransformImpersonationAuditEventStructKeyNameToJSONKey(structKey string) (string, error) {
+	switch structKey {
+	case ImpersonationAuditEventActorStructKeyName:
+		return ImpersonationAuditEventActorStructKeyName, nil
+	case ImpersonationAuditEventCorrelationIDStructKeyName:
+		return ImpersonationAuditEventCorrelationIDStructKeyName, nil
+	case ImpersonationAuditEventCreatedAtStructKeyName:
+		return ImpersonationAuditEventCreatedAtStructKeyName, nil
+	case ImpersonationAuditEventDeletedAtStructKeyName:
+		return ImpersonationAuditEventDeletedAtStructKeyName, nil
+	case ImpersonationAuditEventEventStructKeyName:
+		return ImpersonationAuditEventEventStructKeyName, nil
+	case ImpersonationAuditEventIDStructKeyName:
+		return ImpersonationAuditEventIDStructKeyName, nil
+	case ImpersonationAuditEventImpersonationIDStructKeyName:
+		return ImpersonationAuditEventImpersonationIDStructKeyName, nil
+	case ImpersonationAuditEventMethodStructKeyName:
+		return ImpersonationAuditEventMethodStructKeyName, nil
+	case ImpersonationAuditEventTargetStructKeyName:
+		return ImpersonationAuditEventTargetStructKeyName, nil
+	case ImpersonationAuditEventURIStructKeyName:
+		return ImpersonationAuditEventURIStructKeyName, nil
+	case ImpersonationAuditEventUpdatedAtStructKeyName:
+		return ImpersonationAuditEventUpdatedAtStructKeyName, nil
+	default:
+		return "", errors.WithStack(ErrImpersonationAuditEventUnsupportedStructKeyName)
+	}
+}
+
+// Transform ImpersonationAuditEvent Struct Key Name map To JSON Key map
+func TransformImpersonationAuditEventStructKeyNameMapToJSONKeyMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformImpersonationAuditEventStructKeyNameToJSONKey(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrImpersonationAuditEventUnsupportedStructKeyName) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform ImpersonationAuditEvent JSON Key map To Struct Key Name map
+func TransformImpersonationAuditEventJSONKeyMapToStructKeyNameMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformImpersonationAuditEventJSONKeyToStructKeyName(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrImpersonationAuditEventUnsupportedJSONKey) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
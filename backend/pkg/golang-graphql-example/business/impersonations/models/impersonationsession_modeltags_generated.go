@@ -0,0 +1,409 @@
+// Code generated by ModelTags, DO NOT EDIT.
+package models
+
+import errors "emperror.dev/errors"
+
+// ErrImpersonationSessionUnsupportedGormColumn will be thrown when an unsupported Gorm column will be found in transform function.
+var ErrImpersonationSessionUnsupportedGormColumn = errors.Sentinel("unsupported gorm column")
+
+// ErrImpersonationSessionUnsupportedJSONKey will be thrown when an unsupported JSON key will be found in transform function.
+var ErrImpersonationSessionUnsupportedJSONKey = errors.Sentinel("unsupported json key")
+
+// ErrImpersonationSessionUnsupportedStructKeyName will be thrown when an unsupported structure key will be found in transform function.
+var ErrImpersonationSessionUnsupportedStructKeyName = errors.Sentinel("unsupported struct key")
+
+/* Gorm columns Names */
+// ImpersonationSession Actor Gorm Column Name
+const ImpersonationSessionActorGormColumnName = "actor"
+
+// ImpersonationSession ActorIssuer Gorm Column Name
+const ImpersonationSessionActorIssuerGormColumnName = "actor_issuer"
+
+// ImpersonationSession ActorSubject Gorm Column Name
+const ImpersonationSessionActorSubjectGormColumnName = "actor_subject"
+
+// ImpersonationSession CreatedAt Gorm Column Name
+const ImpersonationSessionCreatedAtGormColumnName = "created_at"
+
+// ImpersonationSession DeletedAt Gorm Column Name
+const ImpersonationSessionDeletedAtGormColumnName = "deleted_at"
+
+// ImpersonationSession EndedAt Gorm Column Name
+const ImpersonationSessionEndedAtGormColumnName = "ended_at"
+
+// ImpersonationSession ExpiresAt Gorm Column Name
+const ImpersonationSessionExpiresAtGormColumnName = "expires_at"
+
+// ImpersonationSession ID Gorm Column Name
+const ImpersonationSessionIDGormColumnName = "id"
+
+// ImpersonationSession Reason Gorm Column Name
+const ImpersonationSessionReasonGormColumnName = "reason"
+
+// ImpersonationSession Target Gorm Column Name
+const ImpersonationSessionTargetGormColumnName = "target"
+
+// ImpersonationSession UpdatedAt Gorm Column Name
+const ImpersonationSessionUpdatedAtGormColumnName = "updated_at"
+
+var ImpersonationSessionGormColumnNameList = []string{ImpersonationSessionActorGormColumnName, ImpersonationSessionActorIssuerGormColumnName, ImpersonationSessionActorSubjectGormColumnName, ImpersonationSessionCreatedAtGormColumnName, ImpersonationSessionDeletedAtGormColumnName, ImpersonationSessionEndedAtGormColumnName, ImpersonationSessionExpiresAtGormColumnName, ImpersonationSessionIDGormColumnName, ImpersonationSessionReasonGormColumnName, ImpersonationSessionTargetGormColumnName, ImpersonationSessionUpdatedAtGormColumnName}
+
+/* JSON Key Names */
+// ImpersonationSession Actor JSON Key Name
+const ImpersonationSessionActorJSONKeyName = "Actor"
+
+// ImpersonationSession ActorIssuer JSON Key Name
+const ImpersonationSessionActorIssuerJSONKeyName = "ActorIssuer"
+
+// ImpersonationSession ActorSubject JSON Key Name
+const ImpersonationSessionActorSubjectJSONKeyName = "ActorSubject"
+
+// ImpersonationSession CreatedAt JSON Key Name
+const ImpersonationSessionCreatedAtJSONKeyName = "createdAt"
+
+// ImpersonationSession DeletedAt JSON Key Name
+const ImpersonationSessionDeletedAtJSONKeyName = "deletedAt"
+
+// ImpersonationSession EndedAt JSON Key Name
+const ImpersonationSessionEndedAtJSONKeyName = "EndedAt"
+
+// ImpersonationSession ExpiresAt JSON Key Name
+const ImpersonationSessionExpiresAtJSONKeyName = "ExpiresAt"
+
+// ImpersonationSession ID JSON Key Name
+const ImpersonationSessionIDJSONKeyName = "id"
+
+// ImpersonationSession Reason JSON Key Name
+const ImpersonationSessionReasonJSONKeyName = "Reason"
+
+// ImpersonationSession Target JSON Key Name
+const ImpersonationSessionTargetJSONKeyName = "Target"
+
+// ImpersonationSession UpdatedAt JSON Key Name
+const ImpersonationSessionUpdatedAtJSONKeyName = "updatedAt"
+
+var ImpersonationSessionJSONKeyNameList = []string{ImpersonationSessionActorJSONKeyName, ImpersonationSessionActorIssuerJSONKeyName, ImpersonationSessionActorSubjectJSONKeyName, ImpersonationSessionCreatedAtJSONKeyName, ImpersonationSessionDeletedAtJSONKeyName, ImpersonationSessionEndedAtJSONKeyName, ImpersonationSessionExpiresAtJSONKeyName, ImpersonationSessionIDJSONKeyName, ImpersonationSessionReasonJSONKeyName, ImpersonationSessionTargetJSONKeyName, ImpersonationSessionUpdatedAtJSONKeyName}
+
+/* Struct Key Names */
+// ImpersonationSession Actor Struct Key Name
+const ImpersonationSessionActorStructKeyName = "Actor"
+
+// ImpersonationSession ActorIssuer Struct Key Name
+const ImpersonationSessionActorIssuerStructKeyName = "ActorIssuer"
+
+// ImpersonationSession ActorSubject Struct Key Name
+const ImpersonationSessionActorSubjectStructKeyName = "ActorSubject"
+
+// ImpersonationSession CreatedAt Struct Key Name
+const ImpersonationSessionCreatedAtStructKeyName = "CreatedAt"
+
+// ImpersonationSession DeletedAt Struct Key Name
+const ImpersonationSessionDeletedAtStructKeyName = "DeletedAt"
+
+// ImpersonationSession EndedAt Struct Key Name
+const ImpersonationSessionEndedAtStructKeyName = "EndedAt"
+
+// ImpersonationSession ExpiresAt Struct Key Name
+const ImpersonationSessionExpiresAtStructKeyName = "ExpiresAt"
+
+// ImpersonationSession ID Struct Key Name
+const ImpersonationSessionIDStructKeyName = "ID"
+
+// ImpersonationSession Reason Struct Key Name
+const ImpersonationSessionReasonStructKeyName = "Reason"
+
+// ImpersonationSession Target Struct Key Name
+const ImpersonationSessionTargetStructKeyName = "Target"
+
+// ImpersonationSession UpdatedAt Struct Key Name
+const ImpersonationSessionUpdatedAtStructKeyName = "UpdatedAt"
+
+var ImpersonationSessionStructKeyNameList = []string{ImpersonationSessionActorStructKeyName, ImpersonationSessionActorIssuerStructKeyName, ImpersonationSessionActorSubjectStructKeyName, ImpersonationSessionCreatedAtStructKeyName, ImpersonationSessionDeletedAtStructKeyName, ImpersonationSessionEndedAtStructKeyName, ImpersonationSessionExpiresAtStructKeyName, ImpersonationSessionIDStructKeyName, ImpersonationSessionReasonStructKeyName, ImpersonationSessionTargetStructKeyName, ImpersonationSessionUpdatedAtStructKeyName}
+
+// Transform ImpersonationSession Gorm Column To JSON Key
+func TransformImpersonationSessionGormColumnToJSONKey(gormColumn string) (string, error) {
+	switch gormColumn {
+	case ImpersonationSessionActorGormColumnName:
+		return ImpersonationSessionActorJSONKeyName, nil
+	case ImpersonationSessionActorIssuerGormColumnName:
+		return ImpersonationSessionActorIssuerJSONKeyName, nil
+	case ImpersonationSessionActorSubjectGormColumnName:
+		return ImpersonationSessionActorSubjectJSONKeyName, nil
+	case ImpersonationSessionCreatedAtGormColumnName:
+		return ImpersonationSessionCreatedAtJSONKeyName, nil
+	case ImpersonationSessionDeletedAtGormColumnName:
+		return ImpersonationSessionDeletedAtJSONKeyName, nil
+	case ImpersonationSessionEndedAtGormColumnName:
+		return ImpersonationSessionEndedAtJSONKeyName, nil
+	case ImpersonationSessionExpiresAtGormColumnName:
+		return ImpersonationSessionExpiresAtJSONKeyName, nil
+	case ImpersonationSessionIDGormColumnName:
+		return ImpersonationSessionIDJSONKeyName, nil
+	case ImpersonationSessionReasonGormColumnName:
+		return ImpersonationSessionReasonJSONKeyName, nil
+	case ImpersonationSessionTargetGormColumnName:
+		return ImpersonationSessionTargetJSONKeyName, nil
+	case ImpersonationSessionUpdatedAtGormColumnName:
+		return ImpersonationSessionUpdatedAtJSONKeyName, nil
+	default:
+		return "", errors.WithStack(ErrImpersonationSessionUnsupportedGormColumn)
+	}
+}
+
+// Transform ImpersonationSession JSON Key To Gorm Column
+func TransformImpersonationSessionJSONKeyToGormColumn(jsonKey string) (string, error) {
+	switch jsonKey {
+	case ImpersonationSessionActorJSONKeyName:
+		return ImpersonationSessionActorGormColumnName, nil
+	case ImpersonationSessionActorIssuerJSONKeyName:
+		return ImpersonationSessionActorIssuerGormColumnName, nil
+	case ImpersonationSessionActorSubjectJSONKeyName:
+		return ImpersonationSessionActorSubjectGormColumnName, nil
+	case ImpersonationSessionCreatedAtJSONKeyName:
+		return ImpersonationSessionCreatedAtGormColumnName, nil
+	case ImpersonationSessionDeletedAtJSONKeyName:
+		return ImpersonationSessionDeletedAtGormColumnName, nil
+	case ImpersonationSessionEndedAtJSONKeyName:
+		return ImpersonationSessionEndedAtGormColumnName, nil
+	case ImpersonationSessionExpiresAtJSONKeyName:
+		return ImpersonationSessionExpiresAtGormColumnName, nil
+	case ImpersonationSessionIDJSONKeyName:
+		return ImpersonationSessionIDGormColumnName, nil
+	case ImpersonationSessionReasonJSONKeyName:
+		return ImpersonationSessionReasonGormColumnName, nil
+	case ImpersonationSessionTargetJSONKeyName:
+		return ImpersonationSessionTargetGormColumnName, nil
+	case ImpersonationSessionUpdatedAtJSONKeyName:
+		return ImpersonationSessionUpdatedAtGormColumnName, nil
+	default:
+		return "", errors.WithStack(ErrImpersonationSessionUnsupportedJSONKey)
+	}
+}
+
+// Transform ImpersonationSession JSON Key map To Gorm Column map
+func TransformImpersonationSessionJSONKeyMapToGormColumnMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformImpersonationSessionJSONKeyToGormColumn(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrImpersonationSessionUnsupportedJSONKey) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform ImpersonationSession Gorm Column map To JSON Key map
+func TransformImpersonationSessionGormColumnMapToJSONKeyMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformImpersonationSessionGormColumnToJSONKey(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrImpersonationSessionUnsupportedGormColumn) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform ImpersonationSession Gorm Column To Struct Key Name
+func TransformImpersonationSessionGormColumnToStructKeyName(gormColumn string) (string, error) {
+	switch gormColumn {
+	case ImpersonationSessionActorGormColumnName:
+		return ImpersonationSessionActorStructKeyName, nil
+	case ImpersonationSessionActorIssuerGormColumnName:
+		return ImpersonationSessionActorIssuerStructKeyName, nil
+	case ImpersonationSessionActorSubjectGormColumnName:
+		return ImpersonationSessionActorSubjectStructKeyName, nil
+	case ImpersonationSessionCreatedAtGormColumnName:
+		return ImpersonationSessionCreatedAtStructKeyName, nil
+	case ImpersonationSessionDeletedAtGormColumnName:
+		return ImpersonationSessionDeletedAtStructKeyName, nil
+	case ImpersonationSessionEndedAtGormColumnName:
+		return ImpersonationSessionEndedAtStructKeyName, nil
+	case ImpersonationSessionExpiresAtGormColumnName:
+		return ImpersonationSessionExpiresAtStructKeyName, nil
+	case ImpersonationSessionIDGormColumnName:
+		return ImpersonationSessionIDStructKeyName, nil
+	case ImpersonationSessionReasonGormColumnName:
+		return ImpersonationSessionReasonStructKeyName, nil
+	case ImpersonationSessionTargetGormColumnName:
+		return ImpersonationSessionTargetStructKeyName, nil
+	case ImpersonationSessionUpdatedAtGormColumnName:
+		return ImpersonationSessionUpdatedAtStructKeyName, nil
+	default:
+		return "", errors.WithStack(ErrImpersonationSessionUnsupportedGormColumn)
+	}
+}
+
+// Transform ImpersonationSession Struct Key Name To Gorm Column
+func TransformImpersonationSessionStructKeyNameToGormColumn(structKey string) (string, error) {
+	switch structKey {
+	case ImpersonationSessionActorStructKeyName:
+		return ImpersonationSessionActorGormColumnName, nil
+	case ImpersonationSessionActorIssuerStructKeyName:
+		return ImpersonationSessionActorIssuerGormColumnName, nil
+	case ImpersonationSessionActorSubjectStructKeyName:
+		return ImpersonationSessionActorSubjectGormColumnName, nil
+	case ImpersonationSessionCreatedAtStructKeyName:
+		return ImpersonationSessionCreatedAtGormColumnName, nil
+	case ImpersonationSessionDeletedAtStructKeyName:
+		return ImpersonationSessionDeletedAtGormColumnName, nil
+	case ImpersonationSessionEndedAtStructKeyName:
+		return ImpersonationSessionEndedAtGormColumnName, nil
+	case ImpersonationSessionExpiresAtStructKeyName:
+		return ImpersonationSessionExpiresAtGormColumnName, nil
+	case ImpersonationSessionIDStructKeyName:
+		return ImpersonationSessionIDGormColumnName, nil
+	case ImpersonationSessionReasonStructKeyName:
+		return ImpersonationSessionReasonGormColumnName, nil
+	case ImpersonationSessionTargetStructKeyName:
+		return ImpersonationSessionTargetGormColumnName, nil
+	case ImpersonationSessionUpdatedAtStructKeyName:
+		return ImpersonationSessionUpdatedAtGormColumnName, nil
+	default:
+		return "", errors.WithStack(ErrImpersonationSessionUnsupportedStructKeyName)
+	}
+}
+
+// Transform ImpersonationSession Struct Key Name map To Gorm Column map
+func TransformImpersonationSessionStructKeyNameMapToGormColumnMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformImpersonationSessionStructKeyNameToGormColumn(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrImpersonationSessionUnsupportedStructKeyName) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform ImpersonationSession Gorm Column map To Struct Key Name map
+func TransformImpersonationSessionGormColumnMapToStructKeyNameMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformImpersonationSessionGormColumnToStructKeyName(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrImpersonationSessionUnsupportedGormColumn) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform ImpersonationSession JSON Key To Struct Key Name
+func TransformImpersonationSessionJSONKeyToStructKeyName(jsonKey string) (string, error) {
+	switch jsonKey {
+	case ImpersonationSessionActorJSONKeyName:
+		return ImpersonationSessionActorStructKeyName, nil
+	case ImpersonationSessionActorIssuerJSONKeyName:
+		return ImpersonationSessionActorIssuerStructKeyName, nil
+	case ImpersonationSessionActorSubjectJSONKeyName:
+		return ImpersonationSessionActorSubjectStructKeyName, nil
+	case ImpersonationSessionCreatedAtJSONKeyName:
+		return ImpersonationSessionCreatedAtStructKeyName, nil
+	case ImpersonationSessionDeletedAtJSONKeyName:
+		return ImpersonationSessionDeletedAtStructKeyName, nil
+	case ImpersonationSessionEndedAtJSONKeyName:
+		return ImpersonationSessionEndedAtStructKeyName, nil
+	case ImpersonationSessionExpiresAtJSONKeyName:
+		return ImpersonationSessionExpiresAtStructKeyName, nil
+	case ImpersonationSessionIDJSONKeyName:
+		return ImpersonationSessionIDStructKeyName, nil
+	case ImpersonationSessionReasonJSONKeyName:
+		return ImpersonationSessionReasonStructKeyName, nil
+	case ImpersonationSessionTargetJSONKeyName:
+		return ImpersonationSessionTargetStructKeyName, nil
+	case ImpersonationSessionUpdatedAtJSONKeyName:
+		return ImpersonationSessionUpdatedAtStructKeyName, nil
+	default:
+		return "", errors.WithStack(ErrImpersonationSessionUnsupportedJSONKey)
+	}
+}
+
+// Transform ImpersonationSession Struct Key Name To JSON Key
+func TransformImpersonationSessionStructKeyNameToJSONKey(structKey string) (string, error) {
+	switch structKey {
+	case ImpersonationSessionActorStructKeyName:
+		return ImpersonationSessionActorStructKeyName, nil
+	case ImpersonationSessionActorIssuerStructKeyName:
+		return ImpersonationSessionActorIssuerStructKeyName, nil
+	case ImpersonationSessionActorSubjectStructKeyName:
+		return ImpersonationSessionActorSubjectStructKeyName, nil
+	case ImpersonationSessionCreatedAtStructKeyName:
+		return ImpersonationSessionCreatedAtStructKeyName, nil
+	case ImpersonationSessionDeletedAtStructKeyName:
+		return ImpersonationSessionDeletedAtStructKeyName, nil
+	case ImpersonationSessionEndedAtStructKeyName:
+		return ImpersonationSessionEndedAtStructKeyName, nil
+	case ImpersonationSessionExpiresAtStructKeyName:
+		return ImpersonationSessionExpiresAtStructKeyName, nil
+	case ImpersonationSessionIDStructKeyName:
+		return ImpersonationSessionIDStructKeyName, nil
+	case ImpersonationSessionReasonStructKeyName:
+		return ImpersonationSessionReasonStructKeyName, nil
+	case ImpersonationSessionTargetStructKeyName:
+		return ImpersonationSessionTargetStructKeyName, nil
+	case ImpersonationSessionUpdatedAtStructKeyName:
+		return ImpersonationSessionUpdatedAtStructKeyName, nil
+	default:
+		return "", errors.WithStack(ErrImpersonationSessionUnsupportedStructKeyName)
+	}
+}
+
+// Transform ImpersonationSession Struct Key Name map To JSON Key map
+func TransformImpersonationSessionStructKeyNameMapToJSONKeyMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformImpersonationSessionStructKeyNameToJSONKey(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrImpersonationSessionUnsupportedStructKeyName) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform ImpersonationSession JSON Key map To Struct Key Name map
+func TransformImpersonationSessionJSONKeyMapToStructKeyNameMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformImpersonationSessionJSONKeyToStructKeyName(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrImpersonationSessionUnsupportedJSONKey) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
@@ -0,0 +1,315 @@
+package impersonations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/daos"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/models"
+	correlationid "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/correlation-id"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+)
+
+const (
+	mainAuthorizationPrefix = "impersonation"
+	usernameMaxLength       = 500
+	reasonMaxLength         = 1000
+	uriMaxLength            = 2000
+	// Reason used when impersonation is started implicitly through header.
+	headerStartReason = "Started from impersonation header"
+)
+
+type service struct {
+	cfgManager config.Manager
+	dao        daos.Dao
+	authSvc    AuthorizationService
+}
+
+func (s *service) Start(ctx context.Context, inp *InputStartImpersonation) (*models.ImpersonationSession, error) {
+	// Validate input
+	err := validateStartInput(inp)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check actor is allowed
+	actor, err := s.checkAllowed(ctx, inp.Username)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return s.start(ctx, actor, inp.Username, inp.Reason)
+}
+
+func (s *service) Stop(ctx context.Context, id string) (*models.ImpersonationSession, error) {
+	// Check authorization
+	err := s.authSvc.CheckAuthorized(
+		ctx,
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Stop"),
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get user
+	user, err := getUser(ctx)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Impersonation can be stopped while impersonating, so real user must be used
+	actor := user.GetActor()
+
+	// Find impersonation session started by actor
+	sess, err := s.dao.FindOneImpersonationSession(
+		ctx,
+		nil,
+		&models.Filter{
+			ID:    &common.GenericFilter{Eq: id},
+			Actor: &common.GenericFilter{Eq: actor.GetIdentifier()},
+		},
+		nil,
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if it hasn't been found
+	if sess == nil {
+		return nil, cerrors.NewNotFoundError("impersonation not found")
+	}
+
+	// Check if already ended
+	if !sess.IsActive() {
+		return sess, nil
+	}
+
+	// Save
+	sess, err = s.dao.PatchUpdateImpersonationSession(
+		ctx,
+		sess,
+		map[string]any{models.ImpersonationSessionEndedAtGormColumnName: time.Now()},
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Audit
+	err = s.audit(ctx, newSessionAuditEvent(sess, models.AuditEventStop))
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return sess, nil
+}
+
+func (s *service) GetOrStartImpersonation(ctx context.Context, target string) (*authxmodels.Impersonation, error) {
+	// Check actor is allowed
+	actor, err := s.checkAllowed(ctx, target)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Find active impersonation session
+	sess, err := s.dao.FindOneImpersonationSession(
+		ctx,
+		nil,
+		&models.Filter{
+			Actor:     &common.GenericFilter{Eq: actor.GetIdentifier()},
+			Target:    &common.GenericFilter{Eq: target},
+			EndedAt:   &common.DateFilter{IsNull: true},
+			ExpiresAt: &common.DateFilter{Gt: time.Now()},
+		},
+		nil,
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if a new one must be started
+	if sess == nil {
+		sess, err = s.start(ctx, actor, target, headerStartReason)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &authxmodels.Impersonation{
+		ExpiresAt: sess.ExpiresAt,
+		ID:        sess.ID,
+		Target:    sess.Target,
+	}, nil
+}
+
+func (s *service) RecordImpersonatedRequest(ctx context.Context, req *authxmodels.ImpersonatedRequest) error {
+	// Get user
+	user, err := getUser(ctx)
+	// Check error
+	if err != nil {
+		return err
+	}
+	// Check that user is impersonated
+	if !user.IsImpersonated() {
+		return errors.New("user in context isn't impersonated")
+	}
+
+	return s.audit(ctx, &models.ImpersonationAuditEvent{
+		ImpersonationID: user.ImpersonationID,
+		Event:           models.AuditEventRequest,
+		Actor:           user.Impersonator.GetIdentifier(),
+		Target:          user.GetIdentifier(),
+		Method:          req.Method,
+		URI:             req.URI,
+	})
+}
+
+func (s *service) checkAllowed(ctx context.Context, target string) (*authxmodels.OIDCUser, error) {
+	// Get configuration
+	cfg := s.cfgManager.GetConfig().OIDCAuthentication
+	// Check if impersonation is enabled
+	if cfg == nil || cfg.Impersonation == nil {
+		return nil, cerrors.NewForbiddenError("impersonation is disabled")
+	}
+
+	// Get actor
+	actor, err := getUser(ctx)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that actor isn't already impersonating someone
+	if actor.IsImpersonated() {
+		return nil, cerrors.NewForbiddenError("impersonation cannot be chained")
+	}
+	// Check that actor is a human user authenticated without api token
+	if actor.APIToken != nil || actor.IsServicePrincipal() {
+		return nil, cerrors.NewForbiddenError("impersonation is only allowed for users")
+	}
+	// Check actor roles
+	if !actor.HasAnyRole(cfg.Impersonation.Roles) {
+		return nil, cerrors.NewForbiddenError("impersonation isn't allowed for user")
+	}
+
+	// Check target
+	if target == "" || len(target) > usernameMaxLength {
+		return nil, cerrors.NewInvalidInputError(
+			fmt.Sprintf("username must be set and contain at most %d characters", usernameMaxLength),
+		)
+	}
+	// Check that actor isn't impersonating itself
+	if target == actor.GetIdentifier() {
+		return nil, cerrors.NewInvalidInputError("user cannot impersonate itself")
+	}
+
+	// Check authorization
+	err = s.authSvc.CheckAuthorized(
+		ctx,
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Start"),
+		fmt.Sprintf("%s:user:%s", mainAuthorizationPrefix, target),
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return actor, nil
+}
+
+func (s *service) start(
+	ctx context.Context,
+	actor *authxmodels.OIDCUser,
+	target, reason string,
+) (*models.ImpersonationSession, error) {
+	// Parse max duration
+	maxDuration, err := time.ParseDuration(s.cfgManager.GetConfig().OIDCAuthentication.Impersonation.MaxDuration)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Save
+	sess, err := s.dao.CreateOrUpdateImpersonationSession(ctx, &models.ImpersonationSession{
+		ExpiresAt:    time.Now().Add(maxDuration),
+		Actor:        actor.GetIdentifier(),
+		ActorIssuer:  actor.Issuer,
+		ActorSubject: actor.Subject,
+		Target:       target,
+		Reason:       reason,
+	})
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Audit
+	err = s.audit(ctx, newSessionAuditEvent(sess, models.AuditEventStart))
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return sess, nil
+}
+
+func (s *service) audit(ctx context.Context, ev *models.ImpersonationAuditEvent) error {
+	// Add correlation id to link event with logs
+	ev.CorrelationID = correlationid.GetFromContext(ctx)
+	// Truncate uri
+	if len(ev.URI) > uriMaxLength {
+		ev.URI = ev.URI[:uriMaxLength]
+	}
+
+	// Save
+	_, err := s.dao.CreateOrUpdateImpersonationAuditEvent(ctx, ev)
+
+	return err
+}
+
+func newSessionAuditEvent(sess *models.ImpersonationSession, event string) *models.ImpersonationAuditEvent {
+	return &models.ImpersonationAuditEvent{
+		ImpersonationID: sess.ID,
+		Event:           event,
+		Actor:           sess.Actor,
+		Target:          sess.Target,
+	}
+}
+
+func getUser(ctx context.Context) (*authxmodels.OIDCUser, error) {
+	// Get user from context
+	user := authentication.GetAuthenticatedUserFromContext(ctx)
+	// Check if user exists
+	if user == nil || user.GetIdentifier() == "" {
+		return nil, cerrors.NewUnauthorizedError("authenticated user is required")
+	}
+
+	return user, nil
+}
+
+func validateStartInput(inp *InputStartImpersonation) error {
+	// Check reason
+	if inp.Reason == "" || len(inp.Reason) > reasonMaxLength {
+		return cerrors.NewInvalidInputError(
+			fmt.Sprintf("reason must be set and contain at most %d characters", reasonMaxLength),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,293 @@
+//go:build unit
+
+package impersonations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	daomocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/daos/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+)
+
+type fakeAuthorizationService struct {
+	action   string
+	resource string
+}
+
+func (f *fakeAuthorizationService) CheckAuthorized(_ context.Context, action, resource string) error {
+	f.action = action
+	f.resource = resource
+
+	return nil
+}
+
+func newTestService(t *testing.T, impCfg *config.OIDCImpersonationConfig) (*service, *daomocks.MockDao) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	dao := daomocks.NewMockDao(ctrl)
+	cfgManagerMock := cmocks.NewMockManager(ctrl)
+	cfgManagerMock.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+		OIDCAuthentication: &config.OIDCAuthConfig{Impersonation: impCfg},
+	})
+
+	return &service{cfgManager: cfgManagerMock, dao: dao, authSvc: &fakeAuthorizationService{}}, dao
+}
+
+func Test_service_checkAllowed(t *testing.T) {
+	impCfg := &config.OIDCImpersonationConfig{MaxDuration: "1h", Roles: []string{"admin"}}
+	admin := &authxmodels.OIDCUser{PreferredUsername: "admin", Roles: []string{"admin"}}
+
+	tests := []struct {
+		name      string
+		cfg       *config.OIDCImpersonationConfig
+		user      *authxmodels.OIDCUser
+		target    string
+		errString string
+	}{
+		{
+			name:      "disabled",
+			user:      admin,
+			target:    "user",
+			errString: "impersonation is disabled",
+		},
+		{
+			name:      "no user",
+			cfg:       impCfg,
+			target:    "user",
+			errString: "authenticated user is required",
+		},
+		{
+			name:      "missing role",
+			cfg:       impCfg,
+			user:      &authxmodels.OIDCUser{PreferredUsername: "user2"},
+			target:    "user",
+			errString: "impersonation isn't allowed for user",
+		},
+		{
+			name: "chained",
+			cfg:  impCfg,
+			user: &authxmodels.OIDCUser{
+				PreferredUsername: "other",
+				Roles:             []string{"admin"},
+				Impersonator:      admin,
+			},
+			target:    "user",
+			errString: "impersonation cannot be chained",
+		},
+		{
+			name: "api token",
+			cfg:  impCfg,
+			user: &authxmodels.OIDCUser{
+				PreferredUsername: "admin",
+				Roles:             []string{"admin"},
+				APIToken:          &authxmodels.APITokenInfo{ID: "id"},
+			},
+			target:    "user",
+			errString: "impersonation is only allowed for users",
+		},
+		{
+			name:      "service principal",
+			cfg:       impCfg,
+			user:      &authxmodels.OIDCUser{PrincipalType: authxmodels.PrincipalTypeService, ClientID: "svc", Roles: []string{"admin"}},
+			target:    "user",
+			errString: "impersonation is only allowed for users",
+		},
+		{
+			name:      "empty target",
+			cfg:       impCfg,
+			user:      admin,
+			errString: "username must be set and contain at most 500 characters",
+		},
+		{
+			name:      "itself",
+			cfg:       impCfg,
+			user:      admin,
+			target:    "admin",
+			errString: "user cannot impersonate itself",
+		},
+		{
+			name:   "allowed",
+			cfg:    impCfg,
+			user:   admin,
+			target: "user",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newTestService(t, tt.cfg)
+
+			ctx := context.TODO()
+			if tt.user != nil {
+				ctx = authentication.SetAuthenticatedUserToContext(ctx, tt.user)
+			}
+
+			actor, err := s.checkAllowed(ctx, tt.target)
+			if tt.errString != "" {
+				assert.EqualError(t, err, tt.errString)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Same(t, tt.user, actor)
+
+			authSvc, _ := s.authSvc.(*fakeAuthorizationService)
+			assert.Equal(t, "impersonation:Start", authSvc.action)
+			assert.Equal(t, "impersonation:user:"+tt.target, authSvc.resource)
+		})
+	}
+}
+
+func Test_service_GetOrStartImpersonation(t *testing.T) {
+	s, dao := newTestService(t, &config.OIDCImpersonationConfig{MaxDuration: "1h", Roles: []string{"admin"}})
+	ctx := authentication.SetAuthenticatedUserToContext(
+		context.TODO(),
+		&authxmodels.OIDCUser{Issuer: "iss", Subject: "sub", PreferredUsername: "admin", Roles: []string{"admin"}},
+	)
+
+	// Active session is reused
+	existing := &models.ImpersonationSession{
+		Base:      database.Base{ID: "existing"},
+		ExpiresAt: time.Now().Add(time.Hour),
+		Target:    "user",
+	}
+	dao.EXPECT().FindOneImpersonationSession(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(existing, nil)
+
+	imp, err := s.GetOrStartImpersonation(ctx, "user")
+	require.NoError(t, err)
+	assert.Equal(t, "existing", imp.ID)
+
+	// New session is started and audited
+	dao.EXPECT().FindOneImpersonationSession(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	dao.EXPECT().
+		CreateOrUpdateImpersonationSession(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, in *models.ImpersonationSession, _ ...any) (*models.ImpersonationSession, error) {
+			in.ID = "new"
+
+			return in, nil
+		})
+	dao.EXPECT().
+		CreateOrUpdateImpersonationAuditEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, in *models.ImpersonationAuditEvent, _ ...any) (*models.ImpersonationAuditEvent, error) {
+			assert.Equal(t, &models.ImpersonationAuditEvent{
+				ImpersonationID: "new",
+				Event:           models.AuditEventStart,
+				Actor:           "admin",
+				Target:          "user",
+			}, in)
+
+			return in, nil
+		})
+
+	imp, err = s.GetOrStartImpersonation(ctx, "user")
+	require.NoError(t, err)
+	assert.Equal(t, "new", imp.ID)
+	assert.Equal(t, "user", imp.Target)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), imp.ExpiresAt, time.Minute)
+}
+
+func Test_service_Stop(t *testing.T) {
+	s, dao := newTestService(t, &config.OIDCImpersonationConfig{MaxDuration: "1h", Roles: []string{"admin"}})
+	admin := &authxmodels.OIDCUser{PreferredUsername: "admin", Roles: []string{"admin"}}
+	// Stop is done while impersonating
+	ctx := authentication.SetAuthenticatedUserToContext(
+		context.TODO(),
+		&authxmodels.OIDCUser{PreferredUsername: "user", Impersonator: admin, ImpersonationID: "id"},
+	)
+
+	// Not found
+	dao.EXPECT().FindOneImpersonationSession(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	_, err := s.Stop(ctx, "id")
+	assert.EqualError(t, err, "impersonation not found")
+
+	// Already ended
+	ended := time.Now()
+	dao.EXPECT().
+		FindOneImpersonationSession(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&models.ImpersonationSession{EndedAt: &ended}, nil)
+
+	_, err = s.Stop(ctx, "id")
+	require.NoError(t, err)
+
+	// Active
+	active := &models.ImpersonationSession{
+		Base:      database.Base{ID: "id"},
+		ExpiresAt: time.Now().Add(time.Hour),
+		Actor:     "admin",
+		Target:    "user",
+	}
+	dao.EXPECT().
+		FindOneImpersonationSession(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ []*models.SortOrder, filter *models.Filter, _ *models.Projection, _ ...any) (*models.ImpersonationSession, error) {
+			assert.Equal(t, "admin", filter.Actor.Eq)
+
+			return active, nil
+		})
+	dao.EXPECT().
+		PatchUpdateImpersonationSession(gomock.Any(), active, gomock.Any()).
+		DoAndReturn(func(_ context.Context, in *models.ImpersonationSession, _ map[string]any, _ ...any) (*models.ImpersonationSession, error) {
+			in.EndedAt = &ended
+
+			return in, nil
+		})
+	dao.EXPECT().
+		CreateOrUpdateImpersonationAuditEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, in *models.ImpersonationAuditEvent, _ ...any) (*models.ImpersonationAuditEvent, error) {
+			assert.Equal(t, models.AuditEventStop, in.Event)
+
+			return in, nil
+		})
+
+	res, err := s.Stop(ctx, "id")
+	require.NoError(t, err)
+	assert.NotNil(t, res.EndedAt)
+}
+
+func Test_service_RecordImpersonatedRequest(t *testing.T) {
+	s, dao := newTestService(t, &config.OIDCImpersonationConfig{MaxDuration: "1h", Roles: []string{"admin"}})
+	admin := &authxmodels.OIDCUser{PreferredUsername: "admin", Roles: []string{"admin"}}
+
+	// Not impersonated
+	ctx := authentication.SetAuthenticatedUserToContext(context.TODO(), admin)
+
+	err := s.RecordImpersonatedRequest(ctx, &authxmodels.ImpersonatedRequest{Method: "GET", URI: "/api/todos"})
+	assert.EqualError(t, err, "user in context isn't impersonated")
+
+	// Target identifier is used even when target doesn't have any username
+	ctx = authentication.SetAuthenticatedUserToContext(
+		context.TODO(),
+		&authxmodels.OIDCUser{Email: "user@example.com", Impersonator: admin, ImpersonationID: "id"},
+	)
+
+	dao.EXPECT().
+		CreateOrUpdateImpersonationAuditEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, in *models.ImpersonationAuditEvent, _ ...any) (*models.ImpersonationAuditEvent, error) {
+			assert.Equal(t, &models.ImpersonationAuditEvent{
+				ImpersonationID: "id",
+				Event:           models.AuditEventRequest,
+				Actor:           "admin",
+				Target:          "user@example.com",
+				Method:          "GET",
+				URI:             "/api/todos",
+			}, in)
+
+			return in, nil
+		})
+
+	err = s.RecordImpersonatedRequest(ctx, &authxmodels.ImpersonatedRequest{Method: "GET", URI: "/api/todos"})
+	require.NoError(t, err)
+}
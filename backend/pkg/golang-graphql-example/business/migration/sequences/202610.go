@@ -0,0 +1,173 @@
+package sequences
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+)
+
+var Seq202610List = []*gormigrate.Migration{
+	// Add email outbox messages
+	{
+		ID: "202610191000",
+		Migrate: func(tx *gorm.DB) error {
+			type EmailOutboxMessage struct {
+				database.Base
+				NextAttemptAt time.Time `gorm:"index"`
+				From          string
+				Message       string `gorm:"type:text"`
+				Status        string `gorm:"type:varchar(20);index"`
+				LastError     string `gorm:"type:varchar(2000)"`
+				Recipients    string `gorm:"type:text"`
+				Attempts      int
+			}
+
+			return tx.AutoMigrate(&EmailOutboxMessage{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("email_outbox_messages")
+		},
+	},
+	// Add personal access tokens
+	{
+		ID: "202610191100",
+		Migrate: func(tx *gorm.DB) error {
+			type APIToken struct {
+				database.Base
+				ExpiresAt   *time.Time
+				RevokedAt   *time.Time
+				LastUsedAt  *time.Time
+				Name        string `gorm:"type:varchar(200)"`
+				Owner       string `gorm:"type:varchar(500);index"`
+				OwnerEmail  string `gorm:"type:varchar(500)"`
+				OwnerName   string `gorm:"type:varchar(500)"`
+				TokenPrefix string `gorm:"type:varchar(20)"`
+				TokenHash   string `gorm:"type:varchar(64);uniqueIndex"`
+				Scopes      string `gorm:"type:text"`
+			}
+
+			return tx.AutoMigrate(&APIToken{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("api_tokens")
+		},
+	},
+	// Add server-side sessions
+	{
+		ID: "202610191200",
+		Migrate: func(tx *gorm.DB) error {
+			type Session struct {
+				database.Base
+				ExpiresAt    time.Time `gorm:"index"`
+				TokenExpiry  time.Time
+				Owner        string `gorm:"type:varchar(500);index"`
+				Issuer       string `gorm:"type:varchar(500)"`
+				Subject      string `gorm:"type:varchar(500)"`
+				UserAgent    string `gorm:"type:varchar(500)"`
+				IPAddress    string `gorm:"type:varchar(100)"`
+				TokenHash    string `gorm:"type:varchar(64);uniqueIndex"`
+				IDToken      string `gorm:"type:text"`
+				AccessToken  string `gorm:"type:text"`
+				RefreshToken string `gorm:"type:text"`
+			}
+
+			return tx.AutoMigrate(&Session{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("sessions")
+		},
+	},
+	// Add impersonation sessions and audit events
+	{
+		ID: "202610191300",
+		Migrate: func(tx *gorm.DB) error {
+			type ImpersonationSession struct {
+				database.Base
+				ExpiresAt    time.Time `gorm:"index"`
+				EndedAt      *time.Time
+				Actor        string `gorm:"type:varchar(500);index"`
+				ActorIssuer  string `gorm:"type:varchar(500)"`
+				ActorSubject string `gorm:"type:varchar(500)"`
+				Target       string `gorm:"type:varchar(500);index"`
+				Reason       string `gorm:"type:varchar(1000)"`
+			}
+
+			type ImpersonationAuditEvent struct {
+				database.Base
+				ImpersonationID string `gorm:"type:varchar(36);index"`
+				Event           string `gorm:"type:varchar(20)"`
+				Actor           string `gorm:"type:varchar(500);index"`
+				Target          string `gorm:"type:varchar(500)"`
+				Method          string `gorm:"type:varchar(20)"`
+				URI             string `gorm:"type:varchar(2000)"`
+				CorrelationID   string `gorm:"type:varchar(100)"`
+			}
+
+			return tx.AutoMigrate(&ImpersonationSession{}, &ImpersonationAuditEvent{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("impersonation_audit_events", "impersonation_sessions")
+		},
+	},
+	// Add rate limit buckets used by database rate limit store
+	{
+		ID: "202610191400",
+		Migrate: func(tx *gorm.DB) error {
+			type RateLimitBucket struct {
+				RefilledAt time.Time
+				ExpiresAt  time.Time `gorm:"index"`
+				Key        string    `gorm:"type:varchar(500);primaryKey"`
+				Tokens     float64
+			}
+
+			return tx.AutoMigrate(&RateLimitBucket{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("rate_limit_buckets")
+		},
+	},
+	// Add trusted documents used by database trusted documents source
+	{
+		ID: "202610191500",
+		Migrate: func(tx *gorm.DB) error {
+			type TrustedDocument struct {
+				CreatedAt time.Time
+				Hash      string `gorm:"type:varchar(64);primaryKey"`
+				Query     string `gorm:"type:text"`
+			}
+
+			return tx.AutoMigrate(&TrustedDocument{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("trusted_documents")
+		},
+	},
+	// Add personal access token owner claims used by authorization
+	{
+		ID: "202610191600",
+		Migrate: func(tx *gorm.DB) error {
+			type APIToken struct {
+				OwnerIssuer string `gorm:"type:varchar(500)"`
+				OwnerTenant string `gorm:"type:varchar(500)"`
+				OwnerRoles  string `gorm:"type:text"`
+				OwnerGroups string `gorm:"type:text"`
+			}
+
+			return tx.AutoMigrate(&APIToken{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, c := range []string{"owner_issuer", "owner_tenant", "owner_roles", "owner_groups"} {
+				err := tx.Migrator().DropColumn("api_tokens", c)
+				// Check error
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	},
+}
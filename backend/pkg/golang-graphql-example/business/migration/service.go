@@ -25,6 +25,7 @@ func (s *service) Migrate(ctx context.Context) error {
 	sequencesList := [][]*gormigrate.Migration{
 		sequences.Seq201608List,
 		sequences.Seq202108List,
+		sequences.Seq202610List,
 	}
 
 	// Create migrationSequences
@@ -4,16 +4,23 @@ import (
 	"context"
 
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authorization"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/migration"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
 )
 
 type Services struct {
-	db           database.DB
-	systemLogger log.Logger
-	TodoSvc      todos.Service
+	db               database.DB
+	systemLogger     log.Logger
+	TodoSvc          todos.Service
+	APITokenSvc      apitokens.Service
+	SessionSvc       sessions.Service
+	ImpersonationSvc impersonations.Service
 }
 
 func (s *Services) MigrateDB(ctx context.Context) error {
@@ -23,13 +30,27 @@ func (s *Services) MigrateDB(ctx context.Context) error {
 	return migrationSvc.Migrate(ctx)
 }
 
-func NewServices(systemLogger log.Logger, db database.DB, authSvc authorization.Service) *Services {
+func NewServices(
+	systemLogger log.Logger,
+	cfgManager config.Manager,
+	db database.DB,
+	authSvc authorization.Service,
+) *Services {
 	// Create todos service
 	todoSvc := todos.NewService(db, authSvc)
+	// Create personal access tokens service
+	apiTokenSvc := apitokens.NewService(cfgManager, db, authSvc)
+	// Create server-side sessions service
+	sessionSvc := sessions.NewService(cfgManager, db, authSvc)
+	// Create impersonations service
+	impersonationSvc := impersonations.NewService(cfgManager, db, authSvc)
 
 	return &Services{
-		db:           db,
-		systemLogger: systemLogger,
-		TodoSvc:      todoSvc,
+		db:               db,
+		systemLogger:     systemLogger,
+		TodoSvc:          todoSvc,
+		APITokenSvc:      apiTokenSvc,
+		SessionSvc:       sessionSvc,
+		ImpersonationSvc: impersonationSvc,
 	}
 }
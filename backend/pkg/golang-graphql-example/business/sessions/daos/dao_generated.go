@@ -0,0 +1,115 @@
+// Code generated by daogen, DO NOT EDIT.
+package daos
+
+import (
+	"context"
+	models0 "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions/models"
+	database "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	helpers "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/helpers"
+	pagination "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
+)
+
+/* Interface */
+
+// Dao for structure Session
+type SessionStructureDao interface {
+	FindSessionByID(ctx context.Context, id string, projection *models0.Projection, opts ...helpers.GormOpt) (*models0.Session, error)
+	FindOneSession(ctx context.Context, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) (*models0.Session, error)
+	FindSessionWithPagination(ctx context.Context, page *pagination.PageInput, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) ([]*models0.Session, error)
+	FindSessionPaginated(ctx context.Context, page *pagination.PageInput, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...database.TransactionOption) ([]*models0.Session, *pagination.PageOutput, error)
+	FindAllSession(ctx context.Context, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) ([]*models0.Session, error)
+	CountSessionPaginated(ctx context.Context, page *pagination.PageInput, filter *models0.Filter, opts ...helpers.GormOpt) (int64, error)
+	CountSession(ctx context.Context, filter *models0.Filter, opts ...helpers.GormOpt) (int64, error)
+	CreateOrUpdateSession(ctx context.Context, input *models0.Session, opts ...helpers.GormOpt) (*models0.Session, error)
+	PermanentDeleteSession(ctx context.Context, input *models0.Session, opts ...helpers.GormOpt) (*models0.Session, error)
+	PermanentDeleteSessionByID(ctx context.Context, id string, opts ...helpers.GormOpt) (*models0.Session, error)
+	PermanentDeleteSessionFiltered(ctx context.Context, filter *models0.Filter, opts ...helpers.GormOpt) error
+	PatchUpdateSession(ctx context.Context, input *models0.Session, patch map[string]any, opts ...helpers.GormOpt) (*models0.Session, error)
+	PatchUpdateSessionByID(ctx context.Context, id string, patch map[string]any, opts ...helpers.GormOpt) (*models0.Session, error)
+	PatchUpdateSessionFiltered(ctx context.Context, filter *models0.Filter, patch map[string]any, opts ...helpers.GormOpt) error
+}
+
+// General Dao
+type Dao interface {
+	SessionStructureDao
+}
+
+/* New */
+
+func NewDao(db database.DB) Dao {
+	return &dao{db: db}
+}
+
+/* Structure */
+
+type dao struct {
+	db database.DB
+}
+
+/* Functions */
+
+// Starting methods for Session structure
+
+func (d *dao) FindSessionByID(ctx context.Context, id string, projection *models0.Projection, opts ...helpers.GormOpt) (*models0.Session, error) {
+	return helpers.FindByID(ctx, &models0.Session{}, d.db, id, projection, opts...)
+}
+
+func (d *dao) FindOneSession(ctx context.Context, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) (*models0.Session, error) {
+	return helpers.FindOne(ctx, &models0.Session{}, d.db, sorts, filter, projection, opts...)
+}
+
+func (d *dao) FindSessionWithPagination(ctx context.Context, page *pagination.PageInput, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) ([]*models0.Session, error) {
+	return helpers.FindWithPagination(ctx, []*models0.Session{}, d.db, page, sorts, filter, projection, opts...)
+}
+
+func (d *dao) FindSessionPaginated(ctx context.Context, page *pagination.PageInput, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...database.TransactionOption) ([]*models0.Session, *pagination.PageOutput, error) {
+	return helpers.GetAllPaginated(ctx, []*models0.Session{}, d.db, page, sorts, filter, projection, opts...)
+}
+
+func (d *dao) FindAllSession(ctx context.Context, sorts []*models0.SortOrder, filter *models0.Filter, projection *models0.Projection, opts ...helpers.GormOpt) ([]*models0.Session, error) {
+	return helpers.Find(ctx, []*models0.Session{}, d.db, sorts, filter, projection, opts...)
+}
+
+func (d *dao) CountSessionPaginated(ctx context.Context, page *pagination.PageInput, filter *models0.Filter, opts ...helpers.GormOpt) (int64, error) {
+	return helpers.CountPaginated(ctx, d.db, &models0.Session{}, page, filter, opts...)
+}
+
+func (d *dao) CountSession(ctx context.Context, filter *models0.Filter, opts ...helpers.GormOpt) (int64, error) {
+	return helpers.Count(ctx, d.db, &models0.Session{}, filter, opts...)
+}
+
+func (d *dao) CreateOrUpdateSession(ctx context.Context, input *models0.Session, opts ...helpers.GormOpt) (*models0.Session, error) {
+	return helpers.CreateOrUpdate(ctx, input, d.db, opts...)
+}
+
+func (d *dao) PermanentDeleteSession(ctx context.Context, input *models0.Session, opts ...helpers.GormOpt) (*models0.Session, error) {
+	return helpers.PermanentDelete(ctx, input, d.db, opts...)
+}
+
+func (d *dao) PermanentDeleteSessionByID(ctx context.Context, id string, opts ...helpers.GormOpt) (*models0.Session, error) {
+	input := &models0.Session{}
+	input.ID = id
+
+	return helpers.PermanentDelete(ctx, input, d.db, opts...)
+}
+
+func (d *dao) PermanentDeleteSessionFiltered(ctx context.Context, filter *models0.Filter, opts ...helpers.GormOpt) error {
+	return helpers.PermanentDeleteFiltered(ctx, &models0.Session{}, filter, d.db, opts...)
+}
+
+func (d *dao) PatchUpdateSession(ctx context.Context, input *models0.Session, patch map[string]any, opts ...helpers.GormOpt) (*models0.Session, error) {
+	return helpers.PatchUpdate(ctx, input, patch, d.db, opts...)
+}
+
+func (d *dao) PatchUpdateSessionByID(ctx context.Context, id string, patch map[string]any, opts ...helpers.GormOpt) (*models0.Session, error) {
+	input := &models0.Session{}
+	input.ID = id
+
+	return helpers.PatchUpdate(ctx, input, patch, d.db, opts...)
+}
+
+func (d *dao) PatchUpdateSessionFiltered(ctx context.Context, filter *models0.Filter, patch map[string]any, opts ...helpers.GormOpt) error {
+	return helpers.PatchUpdateAllFiltered(ctx, &models0.Session{}, patch, filter, d.db, opts...)
+}
+
+// Ending methods for Session structure
@@ -0,0 +1,3 @@
+package daos
+
+// This package will manage dao for server-side sessions
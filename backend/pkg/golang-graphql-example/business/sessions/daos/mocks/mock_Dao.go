@@ -0,0 +1,324 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions/daos (interfaces: Dao)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_Dao.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions/daos Dao
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions/models"
+	database "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	databasehelpers "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/helpers"
+	pagination "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDao is a mock of Dao interface.
+type MockDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockDaoMockRecorder
+	isgomock struct{}
+}
+
+// MockDaoMockRecorder is the mock recorder for MockDao.
+type MockDaoMockRecorder struct {
+	mock *MockDao
+}
+
+// NewMockDao creates a new mock instance.
+func NewMockDao(ctrl *gomock.Controller) *MockDao {
+	mock := &MockDao{ctrl: ctrl}
+	mock.recorder = &MockDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDao) EXPECT() *MockDaoMockRecorder {
+	return m.recorder
+}
+
+// CountSession mocks base method.
+func (m *MockDao) CountSession(ctx context.Context, filter *models.Filter, opts ...databasehelpers.GormOpt) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountSession", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSession indicates an expected call of CountSession.
+func (mr *MockDaoMockRecorder) CountSession(ctx, filter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSession", reflect.TypeOf((*MockDao)(nil).CountSession), varargs...)
+}
+
+// CountSessionPaginated mocks base method.
+func (m *MockDao) CountSessionPaginated(ctx context.Context, page *pagination.PageInput, filter *models.Filter, opts ...databasehelpers.GormOpt) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, page, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountSessionPaginated", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSessionPaginated indicates an expected call of CountSessionPaginated.
+func (mr *MockDaoMockRecorder) CountSessionPaginated(ctx, page, filter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, page, filter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSessionPaginated", reflect.TypeOf((*MockDao)(nil).CountSessionPaginated), varargs...)
+}
+
+// CreateOrUpdateSession mocks base method.
+func (m *MockDao) CreateOrUpdateSession(ctx context.Context, input *models.Session, opts ...databasehelpers.GormOpt) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateOrUpdateSession", varargs...)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateSession indicates an expected call of CreateOrUpdateSession.
+func (mr *MockDaoMockRecorder) CreateOrUpdateSession(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateSession", reflect.TypeOf((*MockDao)(nil).CreateOrUpdateSession), varargs...)
+}
+
+// FindAllSession mocks base method.
+func (m *MockDao) FindAllSession(ctx context.Context, sorts []*models.SortOrder, filter *models.Filter, projection *models.Projection, opts ...databasehelpers.GormOpt) ([]*models.Session, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAllSession", varargs...)
+	ret0, _ := ret[0].([]*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllSession indicates an expected call of FindAllSession.
+func (mr *MockDaoMockRecorder) FindAllSession(ctx, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllSession", reflect.TypeOf((*MockDao)(nil).FindAllSession), varargs...)
+}
+
+// FindOneSession mocks base method.
+func (m *MockDao) FindOneSession(ctx context.Context, sorts []*models.SortOrder, filter *models.Filter, projection *models.Projection, opts ...databasehelpers.GormOpt) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindOneSession", varargs...)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneSession indicates an expected call of FindOneSession.
+func (mr *MockDaoMockRecorder) FindOneSession(ctx, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneSession", reflect.TypeOf((*MockDao)(nil).FindOneSession), varargs...)
+}
+
+// FindSessionByID mocks base method.
+func (m *MockDao) FindSessionByID(ctx context.Context, id string, projection *models.Projection, opts ...databasehelpers.GormOpt) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindSessionByID", varargs...)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSessionByID indicates an expected call of FindSessionByID.
+func (mr *MockDaoMockRecorder) FindSessionByID(ctx, id, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSessionByID", reflect.TypeOf((*MockDao)(nil).FindSessionByID), varargs...)
+}
+
+// FindSessionPaginated mocks base method.
+func (m *MockDao) FindSessionPaginated(ctx context.Context, page *pagination.PageInput, sorts []*models.SortOrder, filter *models.Filter, projection *models.Projection, opts ...database.TransactionOption) ([]*models.Session, *pagination.PageOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, page, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindSessionPaginated", varargs...)
+	ret0, _ := ret[0].([]*models.Session)
+	ret1, _ := ret[1].(*pagination.PageOutput)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindSessionPaginated indicates an expected call of FindSessionPaginated.
+func (mr *MockDaoMockRecorder) FindSessionPaginated(ctx, page, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, page, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSessionPaginated", reflect.TypeOf((*MockDao)(nil).FindSessionPaginated), varargs...)
+}
+
+// FindSessionWithPagination mocks base method.
+func (m *MockDao) FindSessionWithPagination(ctx context.Context, page *pagination.PageInput, sorts []*models.SortOrder, filter *models.Filter, projection *models.Projection, opts ...databasehelpers.GormOpt) ([]*models.Session, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, page, sorts, filter, projection}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindSessionWithPagination", varargs...)
+	ret0, _ := ret[0].([]*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSessionWithPagination indicates an expected call of FindSessionWithPagination.
+func (mr *MockDaoMockRecorder) FindSessionWithPagination(ctx, page, sorts, filter, projection any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, page, sorts, filter, projection}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSessionWithPagination", reflect.TypeOf((*MockDao)(nil).FindSessionWithPagination), varargs...)
+}
+
+// PatchUpdateSession mocks base method.
+func (m *MockDao) PatchUpdateSession(ctx context.Context, input *models.Session, patch map[string]any, opts ...databasehelpers.GormOpt) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchUpdateSession", varargs...)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchUpdateSession indicates an expected call of PatchUpdateSession.
+func (mr *MockDaoMockRecorder) PatchUpdateSession(ctx, input, patch any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpdateSession", reflect.TypeOf((*MockDao)(nil).PatchUpdateSession), varargs...)
+}
+
+// PatchUpdateSessionByID mocks base method.
+func (m *MockDao) PatchUpdateSessionByID(ctx context.Context, id string, patch map[string]any, opts ...databasehelpers.GormOpt) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchUpdateSessionByID", varargs...)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchUpdateSessionByID indicates an expected call of PatchUpdateSessionByID.
+func (mr *MockDaoMockRecorder) PatchUpdateSessionByID(ctx, id, patch any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpdateSessionByID", reflect.TypeOf((*MockDao)(nil).PatchUpdateSessionByID), varargs...)
+}
+
+// PatchUpdateSessionFiltered mocks base method.
+func (m *MockDao) PatchUpdateSessionFiltered(ctx context.Context, filter *models.Filter, patch map[string]any, opts ...databasehelpers.GormOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchUpdateSessionFiltered", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchUpdateSessionFiltered indicates an expected call of PatchUpdateSessionFiltered.
+func (mr *MockDaoMockRecorder) PatchUpdateSessionFiltered(ctx, filter, patch any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpdateSessionFiltered", reflect.TypeOf((*MockDao)(nil).PatchUpdateSessionFiltered), varargs...)
+}
+
+// PermanentDeleteSession mocks base method.
+func (m *MockDao) PermanentDeleteSession(ctx context.Context, input *models.Session, opts ...databasehelpers.GormOpt) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentDeleteSession", varargs...)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PermanentDeleteSession indicates an expected call of PermanentDeleteSession.
+func (mr *MockDaoMockRecorder) PermanentDeleteSession(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteSession", reflect.TypeOf((*MockDao)(nil).PermanentDeleteSession), varargs...)
+}
+
+// PermanentDeleteSessionByID mocks base method.
+func (m *MockDao) PermanentDeleteSessionByID(ctx context.Context, id string, opts ...databasehelpers.GormOpt) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentDeleteSessionByID", varargs...)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PermanentDeleteSessionByID indicates an expected call of PermanentDeleteSessionByID.
+func (mr *MockDaoMockRecorder) PermanentDeleteSessionByID(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteSessionByID", reflect.TypeOf((*MockDao)(nil).PermanentDeleteSessionByID), varargs...)
+}
+
+// PermanentDeleteSessionFiltered mocks base method.
+func (m *MockDao) PermanentDeleteSessionFiltered(ctx context.Context, filter *models.Filter, opts ...databasehelpers.GormOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentDeleteSessionFiltered", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PermanentDeleteSessionFiltered indicates an expected call of PermanentDeleteSessionFiltered.
+func (mr *MockDaoMockRecorder) PermanentDeleteSessionFiltered(ctx, filter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteSessionFiltered", reflect.TypeOf((*MockDao)(nil).PermanentDeleteSessionFiltered), varargs...)
+}
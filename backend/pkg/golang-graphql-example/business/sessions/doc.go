@@ -0,0 +1,3 @@
+package sessions
+
+// This package will manage business of server-side authentication sessions
@@ -0,0 +1,52 @@
+package sessions
+
+import (
+	"context"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions/daos"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+)
+
+//go:generate mockgen -destination=./mocks/mock_AuthorizationService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions AuthorizationService
+type AuthorizationService interface {
+	CheckAuthorized(ctx context.Context, action, resource string) error
+}
+
+//go:generate mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions Service
+type Service interface {
+	// FindForCurrentUser will list active sessions of the authenticated user.
+	FindForCurrentUser(ctx context.Context, projection *models.Projection) ([]*models.Session, error)
+	// Revoke will revoke a session of the authenticated user.
+	Revoke(ctx context.Context, id string) (*models.Session, error)
+	// RevokeAllForUser will revoke all sessions of a user.
+	// This is an administration operation.
+	RevokeAllForUser(ctx context.Context, owner string) (int, error)
+	// CreateSession will create a server-side session and return the opaque session token.
+	CreateSession(ctx context.Context, inp *authxmodels.SessionInput) (string, error)
+	// GetSession will return the session linked to an opaque session token.
+	// Nil is returned when session doesn't exist or is expired.
+	GetSession(ctx context.Context, token string) (*authxmodels.Session, error)
+	// GetLatestSessionForOwner will return the latest active session of an owner.
+	// Nil is returned when owner doesn't have any active session.
+	GetLatestSessionForOwner(ctx context.Context, owner string) (*authxmodels.Session, error)
+	// UpdateSessionTokens will save refreshed tokens in session.
+	UpdateSessionTokens(ctx context.Context, id string, tokens *authxmodels.SessionTokens) error
+	// DeleteSession will delete the session linked to an opaque session token.
+	DeleteSession(ctx context.Context, token string) error
+}
+
+func NewService(cfgManager config.Manager, db database.DB, authSvc AuthorizationService) Service {
+	// Create dao
+	dao := daos.NewDao(db)
+
+	return &service{
+		cfgManager: cfgManager,
+		dbStore:    &dbStore{dao: dao},
+		memStore:   newMemoryStore(),
+		authSvc:    authSvc,
+	}
+}
@@ -0,0 +1,27 @@
+package models
+
+import "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+
+type SortOrder struct {
+	CreatedAt *common.SortOrderEnum `dbfield:"created_at"`
+	UpdatedAt *common.SortOrderEnum `dbfield:"updated_at"`
+	ExpiresAt *common.SortOrderEnum `dbfield:"expires_at"`
+}
+
+type Filter struct {
+	ID        *common.GenericFilter `dbfield:"id"`
+	ExpiresAt *common.DateFilter    `dbfield:"expires_at"`
+	Owner     *common.GenericFilter `dbfield:"owner"`
+	TokenHash *common.GenericFilter `dbfield:"token_hash"`
+	AND       []*Filter
+	OR        []*Filter
+}
+
+type Projection struct {
+	ID        bool `dbfield:"id"         graphqlfield:"id"`
+	CreatedAt bool `dbfield:"created_at" graphqlfield:"createdAt"`
+	UpdatedAt bool `dbfield:"updated_at" graphqlfield:"updatedAt"`
+	ExpiresAt bool `dbfield:"expires_at" graphqlfield:"expiresAt"`
+	UserAgent bool `dbfield:"user_agent" graphqlfield:"userAgent"`
+	IPAddress bool `dbfield:"ip_address" graphqlfield:"ipAddress"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+)
+
+// Session server-side session.
+// Provider ID, access and refresh tokens are stored in plain text (only the session token is hashed),
+// so database access and backups must be restricted like secrets.
+//
+//go:generate go run github.com/oxyno-zeta/golang-graphql-example/tools/generator/modeltagsgen github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions/models Session
+type Session struct {
+	database.Base
+	ExpiresAt    time.Time `gorm:"index"`
+	TokenExpiry  time.Time
+	Owner        string `gorm:"type:varchar(500);index"`
+	Issuer       string `gorm:"type:varchar(500)"`
+	Subject      string `gorm:"type:varchar(500)"`
+	UserAgent    string `gorm:"type:varchar(500)"`
+	IPAddress    string `gorm:"type:varchar(100)"`
+	TokenHash    string `gorm:"type:varchar(64);uniqueIndex"`
+	IDToken      string `gorm:"type:text"`
+	AccessToken  string `gorm:"type:text"`
+	RefreshToken string `gorm:"type:text"`
+}
+
+// IsExpired will check if session is expired.
+func (s *Session) IsExpired() bool {
+	return !s.ExpiresAt.After(time.Now())
+}
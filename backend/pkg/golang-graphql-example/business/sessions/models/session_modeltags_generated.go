@@ -0,0 +1,493 @@
+// Code generated by ModelTags, DO NOT EDIT.
+package models
+
+import errors "emperror.dev/errors"
+
+// ErrSessionUnsupportedGormColumn will be thrown when an unsupported Gorm column will be found in transform function.
+var ErrSessionUnsupportedGormColumn = errors.Sentinel("unsupported gorm column")
+
+// ErrSessionUnsupportedJSONKey will be thrown when an unsupported JSON key will be found in transform function.
+var ErrSessionUnsupportedJSONKey = errors.Sentinel("unsupported json key")
+
+// ErrSessionUnsupportedStructKeyName will be thrown when an unsupported structure key will be found in transform function.
+var ErrSessionUnsupportedStructKeyName = errors.Sentinel("unsupported struct key")
+
+/* Gorm columns Names */
+// Session AccessToken Gorm Column Name
+const SessionAccessTokenGormColumnName = "access_token"
+
+// Session CreatedAt Gorm Column Name
+const SessionCreatedAtGormColumnName = "created_at"
+
+// Session DeletedAt Gorm Column Name
+const SessionDeletedAtGormColumnName = "deleted_at"
+
+// Session ExpiresAt Gorm Column Name
+const SessionExpiresAtGormColumnName = "expires_at"
+
+// Session ID Gorm Column Name
+const SessionIDGormColumnName = "id"
+
+// Session IDToken Gorm Column Name
+const SessionIDTokenGormColumnName = "id_token"
+
+// Session IPAddress Gorm Column Name
+const SessionIPAddressGormColumnName = "ip_address"
+
+// Session Issuer Gorm Column Name
+const SessionIssuerGormColumnName = "issuer"
+
+// Session Owner Gorm Column Name
+const SessionOwnerGormColumnName = "owner"
+
+// Session RefreshToken Gorm Column Name
+const SessionRefreshTokenGormColumnName = "refresh_token"
+
+// Session Subject Gorm Column Name
+const SessionSubjectGormColumnName = "subject"
+
+// Session TokenExpiry Gorm Column Name
+const SessionTokenExpiryGormColumnName = "token_expiry"
+
+// Session TokenHash Gorm Column Name
+const SessionTokenHashGormColumnName = "token_hash"
+
+// Session UpdatedAt Gorm Column Name
+const SessionUpdatedAtGormColumnName = "updated_at"
+
+// Session UserAgent Gorm Column Name
+const SessionUserAgentGormColumnName = "user_agent"
+
+var SessionGormColumnNameList = []string{SessionAccessTokenGormColumnName, SessionCreatedAtGormColumnName, SessionDeletedAtGormColumnName, SessionExpiresAtGormColumnName, SessionIDGormColumnName, SessionIDTokenGormColumnName, SessionIPAddressGormColumnName, SessionIssuerGormColumnName, SessionOwnerGormColumnName, SessionRefreshTokenGormColumnName, SessionSubjectGormColumnName, SessionTokenExpiryGormColumnName, SessionTokenHashGormColumnName, SessionUpdatedAtGormColumnName, SessionUserAgentGormColumnName}
+
+/* JSON Key Names */
+// Session AccessToken JSON Key Name
+const SessionAccessTokenJSONKeyName = "AccessToken"
+
+// Session CreatedAt JSON Key Name
+const SessionCreatedAtJSONKeyName = "createdAt"
+
+// Session DeletedAt JSON Key Name
+const SessionDeletedAtJSONKeyName = "deletedAt"
+
+// Session ExpiresAt JSON Key Name
+const SessionExpiresAtJSONKeyName = "ExpiresAt"
+
+// Session ID JSON Key Name
+const SessionIDJSONKeyName = "id"
+
+// Session IDToken JSON Key Name
+const SessionIDTokenJSONKeyName = "IDToken"
+
+// Session IPAddress JSON Key Name
+const SessionIPAddressJSONKeyName = "IPAddress"
+
+// Session Issuer JSON Key Name
+const SessionIssuerJSONKeyName = "Issuer"
+
+// Session Owner JSON Key Name
+const SessionOwnerJSONKeyName = "Owner"
+
+// Session RefreshToken JSON Key Name
+const SessionRefreshTokenJSONKeyName = "RefreshToken"
+
+// Session Subject JSON Key Name
+const SessionSubjectJSONKeyName = "Subject"
+
+// Session TokenExpiry JSON Key Name
+const SessionTokenExpiryJSONKeyName = "TokenExpiry"
+
+// Session TokenHash JSON Key Name
+const SessionTokenHashJSONKeyName = "TokenHash"
+
+// Session UpdatedAt JSON Key Name
+const SessionUpdatedAtJSONKeyName = "updatedAt"
+
+// Session UserAgent JSON Key Name
+const SessionUserAgentJSONKeyName = "UserAgent"
+
+var SessionJSONKeyNameList = []string{SessionAccessTokenJSONKeyName, SessionCreatedAtJSONKeyName, SessionDeletedAtJSONKeyName, SessionExpiresAtJSONKeyName, SessionIDJSONKeyName, SessionIDTokenJSONKeyName, SessionIPAddressJSONKeyName, SessionIssuerJSONKeyName, SessionOwnerJSONKeyName, SessionRefreshTokenJSONKeyName, SessionSubjectJSONKeyName, SessionTokenExpiryJSONKeyName, SessionTokenHashJSONKeyName, SessionUpdatedAtJSONKeyName, SessionUserAgentJSONKeyName}
+
+/* Struct Key Names */
+// Session AccessToken Struct Key Name
+const SessionAccessTokenStructKeyName = "AccessToken"
+
+// Session CreatedAt Struct Key Name
+const SessionCreatedAtStructKeyName = "CreatedAt"
+
+// Session DeletedAt Struct Key Name
+const SessionDeletedAtStructKeyName = "DeletedAt"
+
+// Session ExpiresAt Struct Key Name
+const SessionExpiresAtStructKeyName = "ExpiresAt"
+
+// Session ID Struct Key Name
+const SessionIDStructKeyName = "ID"
+
+// Session IDToken Struct Key Name
+const SessionIDTokenStructKeyName = "IDToken"
+
+// Session IPAddress Struct Key Name
+const SessionIPAddressStructKeyName = "IPAddress"
+
+// Session Issuer Struct Key Name
+const SessionIssuerStructKeyName = "Issuer"
+
+// Session Owner Struct Key Name
+const SessionOwnerStructKeyName = "Owner"
+
+// Session RefreshToken Struct Key Name
+const SessionRefreshTokenStructKeyName = "RefreshToken"
+
+// Session Subject Struct Key Name
+const SessionSubjectStructKeyName = "Subject"
+
+// Session TokenExpiry Struct Key Name
+const SessionTokenExpiryStructKeyName = "TokenExpiry"
+
+// Session TokenHash Struct Key Name
+const SessionTokenHashStructKeyName = "TokenHash"
+
+// Session UpdatedAt Struct Key Name
+const SessionUpdatedAtStructKeyName = "UpdatedAt"
+
+// Session UserAgent Struct Key Name
+const SessionUserAgentStructKeyName = "UserAgent"
+
+var SessionStructKeyNameList = []string{SessionAccessTokenStructKeyName, SessionCreatedAtStructKeyName, SessionDeletedAtStructKeyName, SessionExpiresAtStructKeyName, SessionIDStructKeyName, SessionIDTokenStructKeyName, SessionIPAddressStructKeyName, SessionIssuerStructKeyName, SessionOwnerStructKeyName, SessionRefreshTokenStructKeyName, SessionSubjectStructKeyName, SessionTokenExpiryStructKeyName, SessionTokenHashStructKeyName, SessionUpdatedAtStructKeyName, SessionUserAgentStructKeyName}
+
+// Transform Session Gorm Column To JSON Key
+func TransformSessionGormColumnToJSONKey(gormColumn string) (string, error) {
+	switch gormColumn {
+	case SessionAccessTokenGormColumnName:
+		return SessionAccessTokenJSONKeyName, nil
+	case SessionCreatedAtGormColumnName:
+		return SessionCreatedAtJSONKeyName, nil
+	case SessionDeletedAtGormColumnName:
+		return SessionDeletedAtJSONKeyName, nil
+	case SessionExpiresAtGormColumnName:
+		return SessionExpiresAtJSONKeyName, nil
+	case SessionIDGormColumnName:
+		return SessionIDJSONKeyName, nil
+	case SessionIDTokenGormColumnName:
+		return SessionIDTokenJSONKeyName, nil
+	case SessionIPAddressGormColumnName:
+		return SessionIPAddressJSONKeyName, nil
+	case SessionIssuerGormColumnName:
+		return SessionIssuerJSONKeyName, nil
+	case SessionOwnerGormColumnName:
+		return SessionOwnerJSONKeyName, nil
+	case SessionRefreshTokenGormColumnName:
+		return SessionRefreshTokenJSONKeyName, nil
+	case SessionSubjectGormColumnName:
+		return SessionSubjectJSONKeyName, nil
+	case SessionTokenExpiryGormColumnName:
+		return SessionTokenExpiryJSONKeyName, nil
+	case SessionTokenHashGormColumnName:
+		return SessionTokenHashJSONKeyName, nil
+	case SessionUpdatedAtGormColumnName:
+		return SessionUpdatedAtJSONKeyName, nil
+	case SessionUserAgentGormColumnName:
+		return SessionUserAgentJSONKeyName, nil
+	default:
+		return "", errors.WithStack(ErrSessionUnsupportedGormColumn)
+	}
+}
+
+// Transform Session JSON Key To Gorm Column
+func TransformSessionJSONKeyToGormColumn(jsonKey string) (string, error) {
+	switch jsonKey {
+	case SessionAccessTokenJSONKeyName:
+		return SessionAccessTokenGormColumnName, nil
+	case SessionCreatedAtJSONKeyName:
+		return SessionCreatedAtGormColumnName, nil
+	case SessionDeletedAtJSONKeyName:
+		return SessionDeletedAtGormColumnName, nil
+	case SessionExpiresAtJSONKeyName:
+		return SessionExpiresAtGormColumnName, nil
+	case SessionIDJSONKeyName:
+		return SessionIDGormColumnName, nil
+	case SessionIDTokenJSONKeyName:
+		return SessionIDTokenGormColumnName, nil
+	case SessionIPAddressJSONKeyName:
+		return SessionIPAddressGormColumnName, nil
+	case SessionIssuerJSONKeyName:
+		return SessionIssuerGormColumnName, nil
+	case SessionOwnerJSONKeyName:
+		return SessionOwnerGormColumnName, nil
+	case SessionRefreshTokenJSONKeyName:
+		return SessionRefreshTokenGormColumnName, nil
+	case SessionSubjectJSONKeyName:
+		return SessionSubjectGormColumnName, nil
+	case SessionTokenExpiryJSONKeyName:
+		return SessionTokenExpiryGormColumnName, nil
+	case SessionTokenHashJSONKeyName:
+		return SessionTokenHashGormColumnName, nil
+	case SessionUpdatedAtJSONKeyName:
+		return SessionUpdatedAtGormColumnName, nil
+	case SessionUserAgentJSONKeyName:
+		return SessionUserAgentGormColumnName, nil
+	default:
+		return "", errors.WithStack(ErrSessionUnsupportedJSONKey)
+	}
+}
+
+// Transform Session JSON Key map To Gorm Column map
+func TransformSessionJSONKeyMapToGormColumnMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformSessionJSONKeyToGormColumn(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrSessionUnsupportedJSONKey) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform Session Gorm Column map To JSON Key map
+func TransformSessionGormColumnMapToJSONKeyMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformSessionGormColumnToJSONKey(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrSessionUnsupportedGormColumn) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform Session Gorm Column To Struct Key Name
+func TransformSessionGormColumnToStructKeyName(gormColumn string) (string, error) {
+	switch gormColumn {
+	case SessionAccessTokenGormColumnName:
+		return SessionAccessTokenStructKeyName, nil
+	case SessionCreatedAtGormColumnName:
+		return SessionCreatedAtStructKeyName, nil
+	case SessionDeletedAtGormColumnName:
+		return SessionDeletedAtStructKeyName, nil
+	case SessionExpiresAtGormColumnName:
+		return SessionExpiresAtStructKeyName, nil
+	case SessionIDGormColumnName:
+		return SessionIDStructKeyName, nil
+	case SessionIDTokenGormColumnName:
+		return SessionIDTokenStructKeyName, nil
+	case SessionIPAddressGormColumnName:
+		return SessionIPAddressStructKeyName, nil
+	case SessionIssuerGormColumnName:
+		return SessionIssuerStructKeyName, nil
+	case SessionOwnerGormColumnName:
+		return SessionOwnerStructKeyName, nil
+	case SessionRefreshTokenGormColumnName:
+		return SessionRefreshTokenStructKeyName, nil
+	case SessionSubjectGormColumnName:
+		return SessionSubjectStructKeyName, nil
+	case SessionTokenExpiryGormColumnName:
+		return SessionTokenExpiryStructKeyName, nil
+	case SessionTokenHashGormColumnName:
+		return SessionTokenHashStructKeyName, nil
+	case SessionUpdatedAtGormColumnName:
+		return SessionUpdatedAtStructKeyName, nil
+	case SessionUserAgentGormColumnName:
+		return SessionUserAgentStructKeyName, nil
+	default:
+		return "", errors.WithStack(ErrSessionUnsupportedGormColumn)
+	}
+}
+
+// Transform Session Struct Key Name To Gorm Column
+func TransformSessionStructKeyNameToGormColumn(structKey string) (string, error) {
+	switch structKey {
+	case SessionAccessTokenStructKeyName:
+		return SessionAccessTokenGormColumnName, nil
+	case SessionCreatedAtStructKeyName:
+		return SessionCreatedAtGormColumnName, nil
+	case SessionDeletedAtStructKeyName:
+		return SessionDeletedAtGormColumnName, nil
+	case SessionExpiresAtStructKeyName:
+		return SessionExpiresAtGormColumnName, nil
+	case SessionIDStructKeyName:
+		return SessionIDGormColumnName, nil
+	case SessionIDTokenStructKeyName:
+		return SessionIDTokenGormColumnName, nil
+	case SessionIPAddressStructKeyName:
+		return SessionIPAddressGormColumnName, nil
+	case SessionIssuerStructKeyName:
+		return SessionIssuerGormColumnName, nil
+	case SessionOwnerStructKeyName:
+		return SessionOwnerGormColumnName, nil
+	case SessionRefreshTokenStructKeyName:
+		return SessionRefreshTokenGormColumnName, nil
+	case SessionSubjectStructKeyName:
+		return SessionSubjectGormColumnName, nil
+	case SessionTokenExpiryStructKeyName:
+		return SessionTokenExpiryGormColumnName, nil
+	case SessionTokenHashStructKeyName:
+		return SessionTokenHashGormColumnName, nil
+	case SessionUpdatedAtStructKeyName:
+		return SessionUpdatedAtGormColumnName, nil
+	case SessionUserAgentStructKeyName:
+		return SessionUserAgentGormColumnName, nil
+	default:
+		return "", errors.WithStack(ErrSessionUnsupportedStructKeyName)
+	}
+}
+
+// Transform Session Struct Key Name map To Gorm Column map
+func TransformSessionStructKeyNameMapToGormColumnMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformSessionStructKeyNameToGormColumn(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrSessionUnsupportedStructKeyName) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform Session Gorm Column map To Struct Key Name map
+func TransformSessionGormColumnMapToStructKeyNameMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformSessionGormColumnToStructKeyName(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrSessionUnsupportedGormColumn) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform Session JSON Key To Struct Key Name
+func TransformSessionJSONKeyToStructKeyName(jsonKey string) (string, error) {
+	switch jsonKey {
+	case SessionAccessTokenJSONKeyName:
+		return SessionAccessTokenStructKeyName, nil
+	case SessionCreatedAtJSONKeyName:
+		return SessionCreatedAtStructKeyName, nil
+	case SessionDeletedAtJSONKeyName:
+		return SessionDeletedAtStructKeyName, nil
+	case SessionExpiresAtJSONKeyName:
+		return SessionExpiresAtStructKeyName, nil
+	case SessionIDJSONKeyName:
+		return SessionIDStructKeyName, nil
+	case SessionIDTokenJSONKeyName:
+		return SessionIDTokenStructKeyName, nil
+	case SessionIPAddressJSONKeyName:
+		return SessionIPAddressStructKeyName, nil
+	case SessionIssuerJSONKeyName:
+		return SessionIssuerStructKeyName, nil
+	case SessionOwnerJSONKeyName:
+		return SessionOwnerStructKeyName, nil
+	case SessionRefreshTokenJSONKeyName:
+		return SessionRefreshTokenStructKeyName, nil
+	case SessionSubjectJSONKeyName:
+		return SessionSubjectStructKeyName, nil
+	case SessionTokenExpiryJSONKeyName:
+		return SessionTokenExpiryStructKeyName, nil
+	case SessionTokenHashJSONKeyName:
+		return SessionTokenHashStructKeyName, nil
+	case SessionUpdatedAtJSONKeyName:
+		return SessionUpdatedAtStructKeyName, nil
+	case SessionUserAgentJSONKeyName:
+		return SessionUserAgentStructKeyName, nil
+	default:
+		return "", errors.WithStack(ErrSessionUnsupportedJSONKey)
+	}
+}
+
+// Transform Session Struct Key Name To JSON Key
+func TransformSessionStructKeyNameToJSONKey(structKey string) (string, error) {
+	switch structKey {
+	case SessionAccessTokenStructKeyName:
+		return SessionAccessTokenStructKeyName, nil
+	case SessionCreatedAtStructKeyName:
+		return SessionCreatedAtStructKeyName, nil
+	case SessionDeletedAtStructKeyName:
+		return SessionDeletedAtStructKeyName, nil
+	case SessionExpiresAtStructKeyName:
+		return SessionExpiresAtStructKeyName, nil
+	case SessionIDStructKeyName:
+		return SessionIDStructKeyName, nil
+	case SessionIDTokenStructKeyName:
+		return SessionIDTokenStructKeyName, nil
+	case SessionIPAddressStructKeyName:
+		return SessionIPAddressStructKeyName, nil
+	case SessionIssuerStructKeyName:
+		return SessionIssuerStructKeyName, nil
+	case SessionOwnerStructKeyName:
+		return SessionOwnerStructKeyName, nil
+	case SessionRefreshTokenStructKeyName:
+		return SessionRefreshTokenStructKeyName, nil
+	case SessionSubjectStructKeyName:
+		return SessionSubjectStructKeyName, nil
+	case SessionTokenExpiryStructKeyName:
+		return SessionTokenExpiryStructKeyName, nil
+	case SessionTokenHashStructKeyName:
+		return SessionTokenHashStructKeyName, nil
+	case SessionUpdatedAtStructKeyName:
+		return SessionUpdatedAtStructKeyName, nil
+	case SessionUserAgentStructKeyName:
+		return SessionUserAgentStructKeyName, nil
+	default:
+		return "", errors.WithStack(ErrSessionUnsupportedStructKeyName)
+	}
+}
+
+// Transform Session Struct Key Name map To JSON Key map
+func TransformSessionStructKeyNameMapToJSONKeyMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformSessionStructKeyNameToJSONKey(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrSessionUnsupportedStructKeyName) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
+
+// Transform Session JSON Key map To Struct Key Name map
+func TransformSessionJSONKeyMapToStructKeyNameMap(input map[string]any, ignoreUnsupportedError bool) (map[string]any, error) {
+	m := map[string]any{}
+
+	for k, v := range input {
+		r, err := TransformSessionJSONKeyToStructKeyName(k)
+		if err != nil {
+			if ignoreUnsupportedError && errors.Is(err, ErrSessionUnsupportedJSONKey) {
+				continue
+			}
+			return nil, err
+		}
+		m[r] = v
+	}
+
+	return m, nil
+}
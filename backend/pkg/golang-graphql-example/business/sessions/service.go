@@ -0,0 +1,312 @@
+package sessions
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions/models"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+)
+
+const (
+	mainAuthorizationPrefix = "session"
+	tokenRandomBytesLength  = 32
+	maxUserAgentLength      = 500
+	maxIPAddressLength      = 100
+)
+
+type service struct {
+	cfgManager config.Manager
+	dbStore    store
+	memStore   store
+	authSvc    AuthorizationService
+}
+
+func (s *service) FindForCurrentUser(
+	ctx context.Context,
+	projection *models.Projection,
+) ([]*models.Session, error) {
+	// Check authorization
+	err := s.authSvc.CheckAuthorized(
+		ctx,
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "List"),
+		"",
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get owner
+	owner, err := getOwner(ctx)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getStore().findAllActiveByOwner(ctx, owner.GetIdentifier(), projection)
+}
+
+func (s *service) Revoke(ctx context.Context, id string) (*models.Session, error) {
+	// Check authorization
+	err := s.authSvc.CheckAuthorized(
+		ctx,
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Revoke"),
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get owner
+	owner, err := getOwner(ctx)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get store
+	st := s.getStore()
+
+	// Find session owned by user
+	sess, err := st.findOneByIDAndOwner(ctx, id, owner.GetIdentifier())
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if it hasn't been found
+	if sess == nil {
+		return nil, cerrors.NewNotFoundError("session not found")
+	}
+
+	// Delete it
+	err = st.deleteByID(ctx, sess.ID)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return sess, nil
+}
+
+func (s *service) RevokeAllForUser(ctx context.Context, owner string) (int, error) {
+	// Check authorization
+	err := s.authSvc.CheckAuthorized(
+		ctx,
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "RevokeAll"),
+		fmt.Sprintf("%s:owner:%s", mainAuthorizationPrefix, owner),
+	)
+	// Check error
+	if err != nil {
+		return 0, err
+	}
+
+	// Check owner
+	if owner == "" {
+		return 0, cerrors.NewInvalidInputError("owner must be set")
+	}
+
+	return s.getStore().deleteByOwner(ctx, owner)
+}
+
+func (s *service) CreateSession(ctx context.Context, inp *authxmodels.SessionInput) (string, error) {
+	// Get session configuration
+	cfg := s.getConfig()
+	// Check if sessions are enabled
+	if cfg == nil {
+		return "", errors.New("server-side sessions aren't enabled")
+	}
+
+	// Parse max lifetime
+	maxLifetime, err := time.ParseDuration(cfg.MaxLifetime)
+	// Check error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	// Generate token
+	token, err := generateToken()
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	sess := &models.Session{
+		ExpiresAt:    time.Now().Add(maxLifetime),
+		TokenExpiry:  inp.Tokens.Expiry,
+		Owner:        inp.User.GetIdentifier(),
+		Issuer:       inp.User.Issuer,
+		Subject:      inp.User.Subject,
+		UserAgent:    truncate(inp.UserAgent, maxUserAgentLength),
+		IPAddress:    truncate(inp.IPAddress, maxIPAddressLength),
+		TokenHash:    hashToken(token),
+		IDToken:      inp.Tokens.IDToken,
+		AccessToken:  inp.Tokens.AccessToken,
+		RefreshToken: inp.Tokens.RefreshToken,
+	}
+
+	// Save
+	_, err = s.getStore().create(ctx, sess)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (s *service) GetSession(ctx context.Context, token string) (*authxmodels.Session, error) {
+	// Get store
+	st := s.getStore()
+
+	// Find session by hash
+	sess, err := st.findByTokenHash(ctx, hashToken(token))
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if it exists
+	if sess == nil {
+		return nil, nil
+	}
+
+	// Check if it is expired
+	if sess.IsExpired() {
+		// Clean it
+		err = st.deleteByID(ctx, sess.ID)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	return toAuthxSession(sess), nil
+}
+
+func (s *service) GetLatestSessionForOwner(ctx context.Context, owner string) (*authxmodels.Session, error) {
+	// Find active sessions sorted by creation date
+	list, err := s.getStore().findAllActiveByOwner(ctx, owner, nil)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if owner doesn't have any active session
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return toAuthxSession(list[0]), nil
+}
+
+func (s *service) UpdateSessionTokens(ctx context.Context, id string, tokens *authxmodels.SessionTokens) error {
+	return s.getStore().updateTokens(ctx, id, tokens)
+}
+
+func (s *service) DeleteSession(ctx context.Context, token string) error {
+	// Get store
+	st := s.getStore()
+
+	// Find session by hash
+	sess, err := st.findByTokenHash(ctx, hashToken(token))
+	// Check error
+	if err != nil {
+		return err
+	}
+	// Check if it exists
+	if sess == nil {
+		return nil
+	}
+
+	return st.deleteByID(ctx, sess.ID)
+}
+
+func (s *service) getConfig() *config.OIDCSessionConfig {
+	// Get configuration
+	cfg := s.cfgManager.GetConfig()
+	// Check if oidc is enabled
+	if cfg.OIDCAuthentication == nil {
+		return nil
+	}
+
+	return cfg.OIDCAuthentication.Session
+}
+
+func (s *service) getStore() store {
+	// Get configuration
+	cfg := s.getConfig()
+	// Check if memory store is selected
+	if cfg != nil && cfg.Store == config.OIDCSessionStoreMemory {
+		return s.memStore
+	}
+
+	return s.dbStore
+}
+
+func getOwner(ctx context.Context) (*authxmodels.OIDCUser, error) {
+	// Get user from context
+	user := authentication.GetAuthenticatedUserFromContext(ctx)
+	// Check if user exists
+	if user == nil || user.GetIdentifier() == "" {
+		return nil, cerrors.NewUnauthorizedError("authenticated user is required")
+	}
+
+	return user, nil
+}
+
+// Transform stored session to the one used by authentication.
+func toAuthxSession(sess *models.Session) *authxmodels.Session {
+	return &authxmodels.Session{
+		ID:        sess.ID,
+		ExpiresAt: sess.ExpiresAt,
+		Tokens: &authxmodels.SessionTokens{
+			Expiry:       sess.TokenExpiry,
+			IDToken:      sess.IDToken,
+			AccessToken:  sess.AccessToken,
+			RefreshToken: sess.RefreshToken,
+		},
+	}
+}
+
+// Generate a new random opaque session token.
+func generateToken() (string, error) {
+	b := make([]byte, tokenRandomBytesLength)
+
+	_, err := rand.Read(b)
+	// Check error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return authxmodels.SessionTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Hash token to store it.
+// Token contains enough entropy to not need a salt or a slow hash function.
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(h[:])
+}
+
+// Truncate value to fit in database column.
+func truncate(s string, size int) string {
+	if len(s) > size {
+		return s[:size]
+	}
+
+	return s
+}
@@ -0,0 +1,168 @@
+//go:build unit
+
+package sessions
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+)
+
+type fakeAuthorizationService struct{}
+
+func (*fakeAuthorizationService) CheckAuthorized(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func newTestMemoryService(t *testing.T, maxLifetime string) *service {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	cfgManager := cmocks.NewMockManager(ctrl)
+
+	cfgManager.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+		OIDCAuthentication: &config.OIDCAuthConfig{
+			Session: &config.OIDCSessionConfig{
+				Store:         config.OIDCSessionStoreMemory,
+				MaxLifetime:   maxLifetime,
+				RefreshBefore: "1m",
+			},
+		},
+	})
+
+	return &service{
+		cfgManager: cfgManager,
+		memStore:   newMemoryStore(),
+		authSvc:    &fakeAuthorizationService{},
+	}
+}
+
+func Test_generateToken(t *testing.T) {
+	token, err := generateToken()
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(token, authxmodels.SessionTokenPrefix))
+	assert.Len(t, hashToken(token), 64)
+
+	token2, err := generateToken()
+	require.NoError(t, err)
+
+	assert.NotEqual(t, token, token2)
+}
+
+func Test_service_memoryStore(t *testing.T) {
+	s := newTestMemoryService(t, "1h")
+	ctx := context.TODO()
+
+	user := &authxmodels.OIDCUser{PreferredUsername: "user", Subject: "s"}
+	userCtx := authentication.SetAuthenticatedUserToContext(ctx, user)
+	otherCtx := authentication.SetAuthenticatedUserToContext(ctx, &authxmodels.OIDCUser{PreferredUsername: "other"})
+
+	// Create sessions
+	token1, err := s.CreateSession(ctx, &authxmodels.SessionInput{
+		User:      user,
+		Tokens:    &authxmodels.SessionTokens{IDToken: "id1", RefreshToken: "refresh1"},
+		UserAgent: "browser",
+	})
+	require.NoError(t, err)
+	token2, err := s.CreateSession(ctx, &authxmodels.SessionInput{
+		User:   user,
+		Tokens: &authxmodels.SessionTokens{IDToken: "id2"},
+	})
+	require.NoError(t, err)
+
+	// Get session
+	sess, err := s.GetSession(ctx, token1)
+	require.NoError(t, err)
+	require.NotNil(t, sess)
+	assert.Equal(t, "id1", sess.Tokens.IDToken)
+	assert.Equal(t, "refresh1", sess.Tokens.RefreshToken)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), sess.ExpiresAt, time.Minute)
+
+	// Unknown token
+	res, err := s.GetSession(ctx, authxmodels.SessionTokenPrefix+"unknown")
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	// Update tokens
+	err = s.UpdateSessionTokens(ctx, sess.ID, &authxmodels.SessionTokens{IDToken: "id1-refreshed"})
+	require.NoError(t, err)
+
+	sess, err = s.GetSession(ctx, token1)
+	require.NoError(t, err)
+	assert.Equal(t, "id1-refreshed", sess.Tokens.IDToken)
+
+	// List
+	list, err := s.FindForCurrentUser(userCtx, nil)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	list, err = s.FindForCurrentUser(otherCtx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	// Revoke session of another user
+	_, err = s.Revoke(otherCtx, sess.ID)
+	require.EqualError(t, err, "session not found")
+
+	// Revoke own session
+	revoked, err := s.Revoke(userCtx, sess.ID)
+	require.NoError(t, err)
+	assert.Equal(t, sess.ID, revoked.ID)
+
+	res, err = s.GetSession(ctx, token1)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	// Get latest session
+	res, err = s.GetLatestSessionForOwner(ctx, "user")
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, "id2", res.Tokens.IDToken)
+
+	res, err = s.GetLatestSessionForOwner(ctx, "other")
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	// Revoke all
+	count, err := s.RevokeAllForUser(ctx, "user")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	res, err = s.GetSession(ctx, token2)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	// Delete unknown session
+	require.NoError(t, s.DeleteSession(ctx, token2))
+}
+
+func Test_service_GetSession_expired(t *testing.T) {
+	s := newTestMemoryService(t, "-1m")
+	ctx := context.TODO()
+
+	token, err := s.CreateSession(ctx, &authxmodels.SessionInput{
+		User:   &authxmodels.OIDCUser{PreferredUsername: "user"},
+		Tokens: &authxmodels.SessionTokens{IDToken: "id"},
+	})
+	require.NoError(t, err)
+
+	res, err := s.GetSession(ctx, token)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	// Expired session must have been cleaned
+	ms, _ := s.memStore.(*memoryStore)
+	assert.Empty(t, ms.sessions)
+}
@@ -0,0 +1,282 @@
+package sessions
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gofrs/uuid"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions/daos"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+)
+
+// store is the persistence layer of sessions.
+type store interface {
+	create(ctx context.Context, sess *models.Session) (*models.Session, error)
+	findByTokenHash(ctx context.Context, tokenHash string) (*models.Session, error)
+	findOneByIDAndOwner(ctx context.Context, id, owner string) (*models.Session, error)
+	findAllActiveByOwner(ctx context.Context, owner string, projection *models.Projection) ([]*models.Session, error)
+	updateTokens(ctx context.Context, id string, tokens *authxmodels.SessionTokens) error
+	deleteByID(ctx context.Context, id string) error
+	deleteByOwner(ctx context.Context, owner string) (int, error)
+}
+
+type dbStore struct {
+	dao daos.Dao
+}
+
+func (s *dbStore) create(ctx context.Context, sess *models.Session) (*models.Session, error) {
+	return s.dao.CreateOrUpdateSession(ctx, sess)
+}
+
+func (s *dbStore) findByTokenHash(ctx context.Context, tokenHash string) (*models.Session, error) {
+	return s.dao.FindOneSession(
+		ctx,
+		nil,
+		&models.Filter{TokenHash: &common.GenericFilter{Eq: tokenHash}},
+		nil,
+	)
+}
+
+func (s *dbStore) findOneByIDAndOwner(ctx context.Context, id, owner string) (*models.Session, error) {
+	return s.dao.FindOneSession(
+		ctx,
+		nil,
+		&models.Filter{
+			ID:    &common.GenericFilter{Eq: id},
+			Owner: &common.GenericFilter{Eq: owner},
+		},
+		nil,
+	)
+}
+
+func (s *dbStore) findAllActiveByOwner(
+	ctx context.Context,
+	owner string,
+	projection *models.Projection,
+) ([]*models.Session, error) {
+	// Sort by creation date
+	desc := common.SortOrderEnumDesc
+
+	return s.dao.FindAllSession(
+		ctx,
+		[]*models.SortOrder{{CreatedAt: &desc}},
+		&models.Filter{
+			Owner:     &common.GenericFilter{Eq: owner},
+			ExpiresAt: &common.DateFilter{Gt: time.Now()},
+		},
+		projection,
+	)
+}
+
+func (s *dbStore) updateTokens(ctx context.Context, id string, tokens *authxmodels.SessionTokens) error {
+	_, err := s.dao.PatchUpdateSessionByID(ctx, id, map[string]any{
+		models.SessionTokenExpiryGormColumnName:  tokens.Expiry,
+		models.SessionIDTokenGormColumnName:      tokens.IDToken,
+		models.SessionAccessTokenGormColumnName:  tokens.AccessToken,
+		models.SessionRefreshTokenGormColumnName: tokens.RefreshToken,
+	})
+
+	return err
+}
+
+func (s *dbStore) deleteByID(ctx context.Context, id string) error {
+	_, err := s.dao.PermanentDeleteSessionByID(ctx, id)
+
+	return err
+}
+
+func (s *dbStore) deleteByOwner(ctx context.Context, owner string) (int, error) {
+	// Build filter
+	filter := &models.Filter{Owner: &common.GenericFilter{Eq: owner}}
+
+	// Count sessions
+	count, err := s.dao.CountSession(ctx, filter)
+	// Check error
+	if err != nil {
+		return 0, err
+	}
+
+	// Delete them
+	err = s.dao.PermanentDeleteSessionFiltered(ctx, filter)
+	// Check error
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// memoryStore keeps sessions in process memory.
+// Sessions are lost on restart and aren't shared between instances.
+// They are indexed by token hash because this is the lookup done on each request.
+type memoryStore struct {
+	sessions map[string]*models.Session
+	mutex    sync.RWMutex
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{sessions: map[string]*models.Session{}}
+}
+
+func (s *memoryStore) create(_ context.Context, sess *models.Session) (*models.Session, error) {
+	// Generate id
+	id, err := uuid.NewV7()
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Copy session
+	res := *sess
+	res.ID = id.String()
+	res.CreatedAt = time.Now()
+	res.UpdatedAt = res.CreatedAt
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Prune expired sessions to avoid keeping them forever
+	for k, v := range s.sessions {
+		if v.IsExpired() {
+			delete(s.sessions, k)
+		}
+	}
+
+	// Save copy
+	cp := res
+	s.sessions[res.TokenHash] = &cp
+
+	return &res, nil
+}
+
+func (s *memoryStore) findByTokenHash(_ context.Context, tokenHash string) (*models.Session, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	// Find session
+	sess, ok := s.sessions[tokenHash]
+	// Check if it exists
+	if !ok {
+		return nil, nil
+	}
+
+	res := *sess
+
+	return &res, nil
+}
+
+func (s *memoryStore) findOneByIDAndOwner(_ context.Context, id, owner string) (*models.Session, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	// Find session
+	sess := s.findByID(id)
+	// Check if it exists
+	if sess == nil || sess.Owner != owner {
+		return nil, nil
+	}
+
+	res := *sess
+
+	return &res, nil
+}
+
+func (s *memoryStore) findAllActiveByOwner(
+	_ context.Context,
+	owner string,
+	_ *models.Projection,
+) ([]*models.Session, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	// Initialize
+	res := []*models.Session{}
+
+	// Loop over sessions
+	for _, sess := range s.sessions {
+		if sess.Owner == owner && !sess.IsExpired() {
+			cp := *sess
+			res = append(res, &cp)
+		}
+	}
+
+	// Sort by creation date
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].CreatedAt.After(res[j].CreatedAt)
+	})
+
+	return res, nil
+}
+
+func (s *memoryStore) updateTokens(_ context.Context, id string, tokens *authxmodels.SessionTokens) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Find session
+	sess := s.findByID(id)
+	// Check if it exists
+	if sess == nil {
+		return nil
+	}
+
+	// Update
+	sess.TokenExpiry = tokens.Expiry
+	sess.IDToken = tokens.IDToken
+	sess.AccessToken = tokens.AccessToken
+	sess.RefreshToken = tokens.RefreshToken
+	sess.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (s *memoryStore) deleteByID(_ context.Context, id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Find session
+	sess := s.findByID(id)
+	// Check if it exists
+	if sess != nil {
+		delete(s.sessions, sess.TokenHash)
+	}
+
+	return nil
+}
+
+func (s *memoryStore) deleteByOwner(_ context.Context, owner string) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Initialize
+	count := 0
+
+	// Loop over sessions
+	for k, sess := range s.sessions {
+		if sess.Owner == owner {
+			delete(s.sessions, k)
+
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// findByID must be called with lock held.
+func (s *memoryStore) findByID(id string) *models.Session {
+	// Loop over sessions
+	for _, sess := range s.sessions {
+		if sess.ID == id {
+			return sess
+		}
+	}
+
+	return nil
+}
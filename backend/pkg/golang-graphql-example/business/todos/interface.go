@@ -3,6 +3,7 @@ package todos
 import (
 	"context"
 
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/daos"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
@@ -12,10 +13,17 @@ import (
 //go:generate mockgen -destination=./mocks/mock_AuthorizationService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos AuthorizationService
 type AuthorizationService interface {
 	CheckAuthorized(ctx context.Context, action, resource string) error
+	AreAuthorized(ctx context.Context, checks []*authxmodels.AuthorizationCheck) ([]bool, error)
+	GetRowFilter(ctx context.Context, action string) (*authxmodels.RowFilter, error)
 }
 
+// MutationHook is called after todos have been created or updated.
+type MutationHook func(ctx context.Context)
+
 //go:generate mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos Service
 type Service interface {
+	// Find will return todos matching filter with row level authorization of the "todo:Get" action.
+	// Authorization must be checked by caller.
 	Find(
 		ctx context.Context,
 		sort []*models.SortOrder,
@@ -30,9 +38,15 @@ type Service interface {
 		projection *models.Projection,
 	) ([]*models.Todo, *pagination.PageOutput, error)
 	FindByID(ctx context.Context, id string, projection *models.Projection) (*models.Todo, error)
+	// FindByIDs will return authorized todos from ids list, missing or not authorized todos are ignored.
+	// Each todo is checked with the "todo:Get" action in one authorization request.
+	FindByIDs(ctx context.Context, ids []string, projection *models.Projection) ([]*models.Todo, error)
 	Create(ctx context.Context, inp *InputCreateTodo) (*models.Todo, error)
 	Update(ctx context.Context, inp *InputUpdateTodo) (*models.Todo, error)
 	Close(ctx context.Context, id string, projection *models.Projection) (*models.Todo, error)
+	// AddMutationHook will add a hook called after each successful todo creation or update.
+	// Hooks are called whatever the API used (GraphQL, REST or gRPC), so caches containing todos can be invalidated.
+	AddMutationHook(hook MutationHook)
 }
 
 type InputCreateTodo struct {
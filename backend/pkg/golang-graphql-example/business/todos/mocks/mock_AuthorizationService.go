@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	models "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,6 +41,21 @@ func (m *MockAuthorizationService) EXPECT() *MockAuthorizationServiceMockRecorde
 	return m.recorder
 }
 
+// AreAuthorized mocks base method.
+func (m *MockAuthorizationService) AreAuthorized(ctx context.Context, checks []*models.AuthorizationCheck) ([]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AreAuthorized", ctx, checks)
+	ret0, _ := ret[0].([]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AreAuthorized indicates an expected call of AreAuthorized.
+func (mr *MockAuthorizationServiceMockRecorder) AreAuthorized(ctx, checks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AreAuthorized", reflect.TypeOf((*MockAuthorizationService)(nil).AreAuthorized), ctx, checks)
+}
+
 // CheckAuthorized mocks base method.
 func (m *MockAuthorizationService) CheckAuthorized(ctx context.Context, action, resource string) error {
 	m.ctrl.T.Helper()
@@ -53,3 +69,18 @@ func (mr *MockAuthorizationServiceMockRecorder) CheckAuthorized(ctx, action, res
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAuthorized", reflect.TypeOf((*MockAuthorizationService)(nil).CheckAuthorized), ctx, action, resource)
 }
+
+// GetRowFilter mocks base method.
+func (m *MockAuthorizationService) GetRowFilter(ctx context.Context, action string) (*models.RowFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRowFilter", ctx, action)
+	ret0, _ := ret[0].(*models.RowFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRowFilter indicates an expected call of GetRowFilter.
+func (mr *MockAuthorizationServiceMockRecorder) GetRowFilter(ctx, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRowFilter", reflect.TypeOf((*MockAuthorizationService)(nil).GetRowFilter), ctx, action)
+}
@@ -43,6 +43,18 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AddMutationHook mocks base method.
+func (m *MockService) AddMutationHook(hook todos.MutationHook) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddMutationHook", hook)
+}
+
+// AddMutationHook indicates an expected call of AddMutationHook.
+func (mr *MockServiceMockRecorder) AddMutationHook(hook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMutationHook", reflect.TypeOf((*MockService)(nil).AddMutationHook), hook)
+}
+
 // Close mocks base method.
 func (m *MockService) Close(ctx context.Context, id string, projection *models.Projection) (*models.Todo, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +115,21 @@ func (mr *MockServiceMockRecorder) FindByID(ctx, id, projection any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockService)(nil).FindByID), ctx, id, projection)
 }
 
+// FindByIDs mocks base method.
+func (m *MockService) FindByIDs(ctx context.Context, ids []string, projection *models.Projection) ([]*models.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, ids, projection)
+	ret0, _ := ret[0].([]*models.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockServiceMockRecorder) FindByIDs(ctx, ids, projection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockService)(nil).FindByIDs), ctx, ids, projection)
+}
+
 // GetAllPaginated mocks base method.
 func (m *MockService) GetAllPaginated(ctx context.Context, page *pagination.PageInput, sort []*models.SortOrder, filter *models.Filter, projection *models.Projection) ([]*models.Todo, *pagination.PageOutput, error) {
 	m.ctrl.T.Helper()
@@ -8,3 +8,6 @@ type Todo struct {
 	Text string `gorm:"type:varchar(2000)"`
 	Done bool
 }
+
+// IsEntity marks todo as a GraphQL federation entity.
+func (Todo) IsEntity() {}
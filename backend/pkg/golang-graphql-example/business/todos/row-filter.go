@@ -0,0 +1,63 @@
+package todos
+
+import (
+	"emperror.dev/errors"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+)
+
+// addRowFilter will AND the row level authorization constraints with the user filter.
+func addRowFilter(filter *models.Filter, rowFilter *authxmodels.RowFilter) (*models.Filter, error) {
+	// Check if all rows are authorized
+	if rowFilter.AllowAll {
+		return filter, nil
+	}
+
+	// Initialize
+	orFilter := &models.Filter{}
+
+	// Loop over alternatives
+	for _, conditions := range rowFilter.Conditions {
+		andFilter := &models.Filter{}
+
+		// Loop over conditions
+		for _, cond := range conditions {
+			// Transform condition
+			f, err := conditionToFilter(cond)
+			// Check error
+			if err != nil {
+				return nil, err
+			}
+
+			andFilter.AND = append(andFilter.AND, f)
+		}
+
+		orFilter.OR = append(orFilter.OR, andFilter)
+	}
+
+	// Check if there isn't any user filter
+	if filter == nil {
+		return orFilter, nil
+	}
+
+	return &models.Filter{AND: []*models.Filter{filter, orFilter}}, nil
+}
+
+func conditionToFilter(cond *authxmodels.RowFilterCondition) (*models.Filter, error) {
+	switch cond.Field {
+	case models.TodoIDGormColumnName:
+		return &models.Filter{ID: cond.Filter}, nil
+	case models.TodoTextGormColumnName:
+		return &models.Filter{Text: cond.Filter}, nil
+	case models.TodoDoneGormColumnName:
+		return &models.Filter{Done: cond.Filter}, nil
+	case models.TodoCreatedAtGormColumnName:
+		return &models.Filter{CreatedAt: common.NewDateFilterFromGenericFilter(cond.Filter)}, nil
+	case models.TodoUpdatedAtGormColumnName:
+		return &models.Filter{UpdatedAt: common.NewDateFilterFromGenericFilter(cond.Filter)}, nil
+	default:
+		return nil, errors.Wrapf(models.ErrTodoUnsupportedGormColumn, "row filter field %s", cond.Field)
+	}
+}
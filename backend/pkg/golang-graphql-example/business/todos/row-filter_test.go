@@ -0,0 +1,84 @@
+//go:build unit
+
+package todos
+
+import (
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+)
+
+func Test_addRowFilter(t *testing.T) {
+	userFilter := &models.Filter{Text: &common.GenericFilter{Contains: "a"}}
+
+	tests := []struct {
+		name      string
+		filter    *models.Filter
+		rowFilter *authxmodels.RowFilter
+		want      *models.Filter
+		wantErr   error
+	}{
+		{
+			name:      "allow all",
+			filter:    userFilter,
+			rowFilter: &authxmodels.RowFilter{AllowAll: true},
+			want:      userFilter,
+		},
+		{
+			name: "without user filter",
+			rowFilter: &authxmodels.RowFilter{Conditions: [][]*authxmodels.RowFilterCondition{
+				{{Field: "done", Filter: &common.GenericFilter{Eq: false}}},
+			}},
+			want: &models.Filter{OR: []*models.Filter{
+				{AND: []*models.Filter{{Done: &common.GenericFilter{Eq: false}}}},
+			}},
+		},
+		{
+			name:   "with user filter",
+			filter: userFilter,
+			rowFilter: &authxmodels.RowFilter{Conditions: [][]*authxmodels.RowFilterCondition{
+				{
+					{Field: "done", Filter: &common.GenericFilter{Eq: false}},
+					{Field: "created_at", Filter: &common.GenericFilter{Gte: "2026-01-01T00:00:00Z"}},
+				},
+				{{Field: "id", Filter: &common.GenericFilter{In: []any{"1"}}}},
+			}},
+			want: &models.Filter{AND: []*models.Filter{
+				userFilter,
+				{OR: []*models.Filter{
+					{AND: []*models.Filter{
+						{Done: &common.GenericFilter{Eq: false}},
+						{CreatedAt: &common.DateFilter{Gte: "2026-01-01T00:00:00Z"}},
+					}},
+					{AND: []*models.Filter{{ID: &common.GenericFilter{In: []any{"1"}}}}},
+				}},
+			}},
+		},
+		{
+			name: "unsupported field",
+			rowFilter: &authxmodels.RowFilter{Conditions: [][]*authxmodels.RowFilterCondition{
+				{{Field: "owner", Filter: &common.GenericFilter{Eq: "me"}}},
+			}},
+			wantErr: models.ErrTodoUnsupportedGormColumn,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addRowFilter(tt.filter, tt.rowFilter)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,19 +3,42 @@ package todos
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/daos"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
 )
 
 const mainAuthorizationPrefix = "todo"
 
 type service struct {
-	dao     daos.Dao
-	authSvc AuthorizationService
-	dbSvc   database.DB
+	dao           daos.Dao
+	authSvc       AuthorizationService
+	dbSvc         database.DB
+	mutationHooks []MutationHook
+	hooksMutex    sync.RWMutex
+}
+
+func (s *service) AddMutationHook(hook MutationHook) {
+	s.hooksMutex.Lock()
+	defer s.hooksMutex.Unlock()
+
+	s.mutationHooks = append(s.mutationHooks, hook)
+}
+
+// runMutationHooks will call mutation hooks after a successful mutation.
+func (s *service) runMutationHooks(ctx context.Context) {
+	s.hooksMutex.RLock()
+	defer s.hooksMutex.RUnlock()
+
+	for _, hook := range s.mutationHooks {
+		hook(ctx)
+	}
 }
 
 func (s *service) FindByID(
@@ -23,19 +46,24 @@ func (s *service) FindByID(
 	id string,
 	projection *models.Projection,
 ) (*models.Todo, error) {
+	// Compute action
+	action := fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Get")
 	// Check authorization
-	err := s.authSvc.CheckAuthorized(
-		ctx,
-		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Get"),
-		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
-	)
+	err := s.authSvc.CheckAuthorized(ctx, action, fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id))
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Add row level authorization to filter
+	filter, err := s.applyRowFilter(ctx, action, &models.Filter{ID: &common.GenericFilter{Eq: id}})
 	// Check error
 	if err != nil {
 		return nil, err
 	}
 
 	// Find by id
-	res, err := s.dao.FindTodoByID(ctx, id, projection)
+	res, err := s.dao.FindOneTodo(ctx, nil, filter, projection)
 	// Check error
 	if err != nil {
 		return nil, err
@@ -44,12 +72,65 @@ func (s *service) FindByID(
 	return res, nil
 }
 
+func (s *service) FindByIDs(
+	ctx context.Context,
+	ids []string,
+	projection *models.Projection,
+) ([]*models.Todo, error) {
+	// Compute action
+	action := fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Get")
+
+	// Build checks for all todos
+	checks := make([]*authxmodels.AuthorizationCheck, 0, len(ids))
+	for _, id := range ids {
+		checks = append(checks, &authxmodels.AuthorizationCheck{
+			Action:   action,
+			Resource: fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
+		})
+	}
+
+	// Check authorizations at once
+	authorized, err := s.authSvc.AreAuthorized(ctx, checks)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep only authorized ids
+	authorizedIDs := make([]string, 0, len(ids))
+	for i, id := range ids {
+		if authorized[i] {
+			authorizedIDs = append(authorizedIDs, id)
+		}
+	}
+	// Check if there isn't any authorized todo
+	if len(authorizedIDs) == 0 {
+		return []*models.Todo{}, nil
+	}
+
+	// Add row level authorization to filter
+	filter, err := s.applyRowFilter(ctx, action, &models.Filter{ID: &common.GenericFilter{In: authorizedIDs}})
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return s.dao.FindAllTodo(ctx, nil, filter, projection)
+}
+
 func (s *service) Find(
 	ctx context.Context,
 	sort []*models.SortOrder,
 	filter *models.Filter,
 	projection *models.Projection,
 ) ([]*models.Todo, error) {
+	// Add row level authorization of the get action to filter
+	filter, err := s.applyRowFilter(ctx, fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Get"), filter)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
 	return s.dao.FindAllTodo(ctx, sort, filter, projection)
 }
 
@@ -60,12 +141,17 @@ func (s *service) GetAllPaginated(
 	filter *models.Filter,
 	projection *models.Projection,
 ) ([]*models.Todo, *pagination.PageOutput, error) {
+	// Compute action
+	action := fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "List")
 	// Check authorization
-	err := s.authSvc.CheckAuthorized(
-		ctx,
-		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "List"),
-		"",
-	)
+	err := s.authSvc.CheckAuthorized(ctx, action, "")
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Add row level authorization to filter
+	filter, err = s.applyRowFilter(ctx, action, filter)
 	// Check error
 	if err != nil {
 		return nil, nil, err
@@ -90,30 +176,58 @@ func (s *service) Create(ctx context.Context, inp *InputCreateTodo) (*models.Tod
 		Text: inp.Text,
 	}
 
-	return s.dao.CreateOrUpdateTodo(ctx, tt)
+	// Save
+	res, err := s.dao.CreateOrUpdateTodo(ctx, tt)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Notify mutation
+	s.runMutationHooks(ctx)
+
+	return res, nil
 }
 
 func (s *service) Update(ctx context.Context, inp *InputUpdateTodo) (*models.Todo, error) {
+	// Compute action
+	action := fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Update")
 	// Check authorization
-	err := s.authSvc.CheckAuthorized(
-		ctx,
-		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Update"),
-		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, inp.ID),
-	)
+	err := s.authSvc.CheckAuthorized(ctx, action, fmt.Sprintf("%s:%s", mainAuthorizationPrefix, inp.ID))
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Add row level authorization to filter
+	filter, err := s.applyRowFilter(ctx, action, &models.Filter{ID: &common.GenericFilter{Eq: inp.ID}})
 	// Check error
 	if err != nil {
 		return nil, err
 	}
 
 	// Search by id first
-	tt, err := s.dao.FindTodoByID(ctx, inp.ID, nil)
+	tt, err := s.dao.FindOneTodo(ctx, nil, filter, nil)
 	if err != nil {
 		return nil, err
 	}
+	// Check if todo exists
+	if tt == nil {
+		return nil, errors.NewNotFoundError("todo not found")
+	}
 	// Update text in existing result
 	tt.Text = inp.Text
 	// Save
-	return s.dao.CreateOrUpdateTodo(ctx, tt)
+	res, err := s.dao.CreateOrUpdateTodo(ctx, tt)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Notify mutation
+	s.runMutationHooks(ctx)
+
+	return res, nil
 }
 
 func (s *service) Close(
@@ -121,12 +235,17 @@ func (s *service) Close(
 	id string,
 	projection *models.Projection,
 ) (*models.Todo, error) {
+	// Compute action
+	action := fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Close")
 	// Check authorization
-	err := s.authSvc.CheckAuthorized(
-		ctx,
-		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, "Close"),
-		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
-	)
+	err := s.authSvc.CheckAuthorized(ctx, action, fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id))
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Add row level authorization to filter
+	filter, err := s.applyRowFilter(ctx, action, &models.Filter{ID: &common.GenericFilter{Eq: id}})
 	// Check error
 	if err != nil {
 		return nil, err
@@ -138,11 +257,15 @@ func (s *service) Close(
 	// Create transaction
 	err = s.dbSvc.ExecuteTransaction(ctx, func(ctx context.Context) error {
 		// Search by id first
-		tt, err2 := s.dao.FindTodoByID(ctx, id, projection)
+		tt, err2 := s.dao.FindOneTodo(ctx, nil, filter, projection)
 		// Check error
 		if err2 != nil {
 			return err2
 		}
+		// Check if todo exists
+		if tt == nil {
+			return errors.NewNotFoundError("todo not found")
+		}
 		// Save
 		res, err2 = s.dao.PatchUpdateTodo(
 			ctx,
@@ -157,5 +280,25 @@ func (s *service) Close(
 		return nil, err
 	}
 
+	// Notify mutation
+	s.runMutationHooks(ctx)
+
 	return res, nil
 }
+
+// applyRowFilter will get row level authorization filter of action and AND it with filter.
+// Forbidden error is returned when no row is authorized.
+func (s *service) applyRowFilter(ctx context.Context, action string, filter *models.Filter) (*models.Filter, error) {
+	// Get row level authorization filter
+	rowFilter, err := s.authSvc.GetRowFilter(ctx, action)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if no row is authorized
+	if rowFilter.IsDenied() {
+		return nil, errors.NewForbiddenError("forbidden")
+	}
+
+	return addRowFilter(filter, rowFilter)
+}
@@ -0,0 +1,268 @@
+//go:build unit
+
+package todos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	authxmodels "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	daomocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/daos/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+	dbmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/mocks"
+)
+
+type fakeAuthorizationService struct {
+	rowFilter       *authxmodels.RowFilter
+	deniedResources map[string]bool
+	rowFilterAction string
+	checks          []*authxmodels.AuthorizationCheck
+}
+
+func (*fakeAuthorizationService) CheckAuthorized(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (f *fakeAuthorizationService) AreAuthorized(
+	_ context.Context,
+	checks []*authxmodels.AuthorizationCheck,
+) ([]bool, error) {
+	f.checks = append(f.checks, checks...)
+
+	res := make([]bool, 0, len(checks))
+	for _, c := range checks {
+		res = append(res, !f.deniedResources[c.Resource])
+	}
+
+	return res, nil
+}
+
+func (f *fakeAuthorizationService) GetRowFilter(_ context.Context, action string) (*authxmodels.RowFilter, error) {
+	f.rowFilterAction = action
+
+	return f.rowFilter, nil
+}
+
+func Test_service_rowFilter(t *testing.T) {
+	// Only not done todos are authorized
+	rowFilter := &authxmodels.RowFilter{Conditions: [][]*authxmodels.RowFilterCondition{
+		{{Field: "done", Filter: &common.GenericFilter{Eq: false}}},
+	}}
+	rowOrFilter := &models.Filter{OR: []*models.Filter{
+		{AND: []*models.Filter{{Done: &common.GenericFilter{Eq: false}}}},
+	}}
+	idFilter := &models.Filter{AND: []*models.Filter{{ID: &common.GenericFilter{Eq: "id"}}, rowOrFilter}}
+
+	tests := []struct {
+		name            string
+		action          string
+		rowFilter       *authxmodels.RowFilter
+		deniedResources map[string]bool
+		setup           func(dao *daomocks.MockDao, db *dbmocks.MockDB)
+		run             func(s Service) (any, error)
+		want            any
+		wantChecks      []*authxmodels.AuthorizationCheck
+	}{
+		{
+			name:      "find by id",
+			action:    "todo:Get",
+			rowFilter: rowFilter,
+			setup: func(dao *daomocks.MockDao, _ *dbmocks.MockDB) {
+				dao.EXPECT().FindOneTodo(gomock.Any(), nil, idFilter, nil).Return(nil, nil)
+			},
+			run: func(s Service) (any, error) {
+				return s.FindByID(context.TODO(), "id", nil)
+			},
+			want: (*models.Todo)(nil),
+		},
+		{
+			name:      "find",
+			action:    "todo:Get",
+			rowFilter: rowFilter,
+			setup: func(dao *daomocks.MockDao, _ *dbmocks.MockDB) {
+				dao.EXPECT().FindAllTodo(
+					gomock.Any(),
+					nil,
+					&models.Filter{AND: []*models.Filter{{ID: &common.GenericFilter{In: []string{"id"}}}, rowOrFilter}},
+					nil,
+				).Return([]*models.Todo{}, nil)
+			},
+			run: func(s Service) (any, error) {
+				return s.Find(context.TODO(), nil, &models.Filter{ID: &common.GenericFilter{In: []string{"id"}}}, nil)
+			},
+			want: []*models.Todo{},
+		},
+		{
+			name:      "update not authorized row",
+			action:    "todo:Update",
+			rowFilter: rowFilter,
+			setup: func(dao *daomocks.MockDao, _ *dbmocks.MockDB) {
+				dao.EXPECT().FindOneTodo(gomock.Any(), nil, idFilter, nil).Return(nil, nil)
+			},
+			run: func(s Service) (any, error) {
+				return s.Update(context.TODO(), &InputUpdateTodo{ID: "id", Text: "text"})
+			},
+			want: cerrors.NewNotFoundError("todo not found"),
+		},
+		{
+			name:      "close not authorized row",
+			action:    "todo:Close",
+			rowFilter: rowFilter,
+			setup: func(dao *daomocks.MockDao, db *dbmocks.MockDB) {
+				db.EXPECT().ExecuteTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, cb func(context.Context) error, _ ...any) error {
+						return cb(ctx)
+					},
+				)
+				dao.EXPECT().FindOneTodo(gomock.Any(), nil, idFilter, nil).Return(nil, nil)
+			},
+			run: func(s Service) (any, error) {
+				return s.Close(context.TODO(), "id", nil)
+			},
+			want: cerrors.NewNotFoundError("todo not found"),
+		},
+		{
+			name:      "find by id with all rows denied",
+			action:    "todo:Get",
+			rowFilter: &authxmodels.RowFilter{},
+			run: func(s Service) (any, error) {
+				return s.FindByID(context.TODO(), "id", nil)
+			},
+			want: cerrors.NewForbiddenError("forbidden"),
+		},
+		{
+			name:            "find by ids",
+			action:          "todo:Get",
+			rowFilter:       rowFilter,
+			deniedResources: map[string]bool{"todo:2": true},
+			setup: func(dao *daomocks.MockDao, _ *dbmocks.MockDB) {
+				dao.EXPECT().FindAllTodo(
+					gomock.Any(),
+					nil,
+					&models.Filter{AND: []*models.Filter{{ID: &common.GenericFilter{In: []string{"1", "3"}}}, rowOrFilter}},
+					nil,
+				).Return([]*models.Todo{}, nil)
+			},
+			run: func(s Service) (any, error) {
+				return s.FindByIDs(context.TODO(), []string{"1", "2", "3"}, nil)
+			},
+			want: []*models.Todo{},
+			wantChecks: []*authxmodels.AuthorizationCheck{
+				{Action: "todo:Get", Resource: "todo:1"},
+				{Action: "todo:Get", Resource: "todo:2"},
+				{Action: "todo:Get", Resource: "todo:3"},
+			},
+		},
+		{
+			name:            "find by ids without any authorized todo",
+			deniedResources: map[string]bool{"todo:1": true},
+			run: func(s Service) (any, error) {
+				return s.FindByIDs(context.TODO(), []string{"1"}, nil)
+			},
+			want:       []*models.Todo{},
+			wantChecks: []*authxmodels.AuthorizationCheck{{Action: "todo:Get", Resource: "todo:1"}},
+		},
+		{
+			name:      "find by ids with all rows denied",
+			action:    "todo:Get",
+			rowFilter: &authxmodels.RowFilter{},
+			run: func(s Service) (any, error) {
+				return s.FindByIDs(context.TODO(), []string{"id"}, nil)
+			},
+			want:       cerrors.NewForbiddenError("forbidden"),
+			wantChecks: []*authxmodels.AuthorizationCheck{{Action: "todo:Get", Resource: "todo:id"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc := &fakeAuthorizationService{rowFilter: tt.rowFilter, deniedResources: tt.deniedResources}
+			dao := daomocks.NewMockDao(ctrl)
+			db := dbmocks.NewMockDB(ctrl)
+
+			if tt.setup != nil {
+				tt.setup(dao, db)
+			}
+
+			s := &service{dao: dao, authSvc: authSvc, dbSvc: db}
+
+			got, err := tt.run(s)
+
+			// Row filter of the checked action must be used
+			assert.Equal(t, tt.action, authSvc.rowFilterAction)
+			assert.Equal(t, tt.wantChecks, authSvc.checks)
+
+			// Check if an error is expected
+			if wantErr, ok := tt.want.(cerrors.Error); ok {
+				require.Error(t, err)
+
+				var cerr cerrors.Error
+
+				require.ErrorAs(t, err, &cerr)
+				assert.Equal(t, wantErr.Error(), cerr.Error())
+				assert.Equal(t, wantErr.StatusCode(), cerr.StatusCode())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_service_mutationHooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dao := daomocks.NewMockDao(ctrl)
+	db := dbmocks.NewMockDB(ctrl)
+	authSvc := &fakeAuthorizationService{rowFilter: &authxmodels.RowFilter{AllowAll: true}}
+	s := &service{dao: dao, authSvc: authSvc, dbSvc: db}
+
+	calls := 0
+	s.AddMutationHook(func(_ context.Context) { calls++ })
+
+	// Failed mutations mustn't call hooks
+	dao.EXPECT().CreateOrUpdateTodo(gomock.Any(), gomock.Any()).Return(nil, cerrors.NewInternalServerError("fake"))
+
+	_, err := s.Create(context.TODO(), &InputCreateTodo{Text: "text"})
+	require.Error(t, err)
+	assert.Equal(t, 0, calls)
+
+	// Create
+	dao.EXPECT().CreateOrUpdateTodo(gomock.Any(), gomock.Any()).Return(&models.Todo{Text: "text"}, nil)
+
+	_, err = s.Create(context.TODO(), &InputCreateTodo{Text: "text"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	// Update
+	dao.EXPECT().FindOneTodo(gomock.Any(), nil, gomock.Any(), nil).Return(&models.Todo{Text: "text"}, nil)
+	dao.EXPECT().CreateOrUpdateTodo(gomock.Any(), gomock.Any()).Return(&models.Todo{Text: "new"}, nil)
+
+	_, err = s.Update(context.TODO(), &InputUpdateTodo{ID: "id", Text: "new"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	// Close
+	db.EXPECT().ExecuteTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, cb func(context.Context) error, _ ...any) error {
+			return cb(ctx)
+		},
+	)
+	dao.EXPECT().FindOneTodo(gomock.Any(), nil, gomock.Any(), nil).Return(&models.Todo{}, nil)
+	dao.EXPECT().PatchUpdateTodo(gomock.Any(), gomock.Any(), gomock.Any()).Return(&models.Todo{}, nil)
+
+	_, err = s.Close(context.TODO(), "id", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
@@ -0,0 +1,73 @@
+package correlationid
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+// gRPC metadata keys are lower case headers.
+var (
+	requestIDMetadataKey     = strings.ToLower(requestIDHeader)
+	correlationIDMetadataKey = strings.ToLower(correlationIDHeader)
+)
+
+func GRPCUnaryInterceptor(logger log.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		// Get metadata
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		// Get correlation id from metadata
+		correlationID := getFirstMetadataValue(md, correlationIDMetadataKey)
+
+		// Check if correlation id metadata have been set
+		if correlationID == "" {
+			// Get request id metadata
+			correlationID = getFirstMetadataValue(md, requestIDMetadataKey)
+		}
+
+		// Check if correlation id exists
+		if correlationID == "" {
+			// Generate uuid
+			uuid, err := Generate()
+			// Check error
+			if err != nil {
+				// Log error
+				logger.Error(err)
+
+				return nil, status.Error(codes.Internal, "internal server error")
+			}
+			// Save it in variable
+			correlationID = uuid
+		}
+
+		// Store it in context
+		ctx = SetInContext(ctx, correlationID)
+
+		// Put it on response headers
+		_ = grpc.SetHeader(ctx, metadata.Pairs(
+			requestIDMetadataKey, correlationID,
+			correlationIDMetadataKey, correlationID,
+		))
+
+		// Next
+		return handler(ctx, req)
+	}
+}
+
+func getFirstMetadataValue(md metadata.MD, key string) string {
+	// Get values
+	values := md.Get(key)
+	// Check if values exist
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+)
+
+// ToGRPCError will transform an error into a gRPC status error.
+// This is the gRPC equivalent of AnswerWithError: common errors are answered with their public message,
+// a gRPC code matching their status code and an error info detail containing the error code and extensions.
+func ToGRPCError(err error) error {
+	// Try to cast as common error
+	//nolint: errorlint // Ignore this because the aim is to catch project error at first level
+	err2, ok := err.(errors.Error)
+	// Check if cast wasn't a success
+	if !ok {
+		// Manage non common error
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	// Create status
+	st := status.New(GRPCCodeFromHTTPStatus(err2.StatusCode()), err2.PublicMessage())
+
+	// Build error info metadata from extensions
+	md := map[string]string{}
+	for k, v := range err2.Extensions() {
+		md[k] = fmt.Sprint(v)
+	}
+
+	// Add details
+	st2, err3 := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   err2.Code(),
+		Metadata: md,
+	})
+	// Check error
+	if err3 != nil {
+		// Ignore details
+		return st.Err()
+	}
+
+	return st2.Err()
+}
+
+// GRPCCodeFromHTTPStatus will return the gRPC code corresponding to an HTTP status code.
+func GRPCCodeFromHTTPStatus(st int) codes.Code {
+	switch st {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusLocked:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+type serverStreamWithContext struct {
+	grpc.ServerStream
+	ctx context.Context //nolint:containedctx // Context must be overridden for stream handlers
+}
+
+func (s *serverStreamWithContext) Context() context.Context {
+	return s.ctx
+}
+
+// WrapServerStreamWithContext will return a gRPC server stream using the given context.
+// This is the stream interceptor equivalent of calling unary handler with a new context.
+func WrapServerStreamWithContext(ss grpc.ServerStream, ctx context.Context) grpc.ServerStream { //nolint:revive // Stream is the main argument
+	return &serverStreamWithContext{ServerStream: ss, ctx: ctx}
+}
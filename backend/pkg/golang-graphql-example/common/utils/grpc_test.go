@@ -0,0 +1,84 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+
+	gerrors "errors"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+)
+
+func TestToGRPCError(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		expectedCode    codes.Code
+		expectedMessage string
+		expectedDetails []any
+	}{
+		{
+			name:            "non common error",
+			err:             gerrors.New("fake"),
+			expectedCode:    codes.Internal,
+			expectedMessage: "internal server error",
+		},
+		{
+			name:            "not found error",
+			err:             errors.NewNotFoundError("todo not found"),
+			expectedCode:    codes.NotFound,
+			expectedMessage: "not found",
+			expectedDetails: []any{&errdetails.ErrorInfo{
+				Reason:   errors.NotFoundErrorCode,
+				Metadata: map[string]string{"code": errors.NotFoundErrorCode},
+			}},
+		},
+		{
+			name: "forbidden error with extension",
+			err: errors.NewForbiddenError(
+				"forbidden",
+				errors.WithPublicErrorMessage("public"),
+				errors.AddExtension("retryAfter", 10),
+			),
+			expectedCode:    codes.PermissionDenied,
+			expectedMessage: "public",
+			expectedDetails: []any{&errdetails.ErrorInfo{
+				Reason:   errors.ForbiddenErrorCode,
+				Metadata: map[string]string{"code": errors.ForbiddenErrorCode, "retryAfter": "10"},
+			}},
+		},
+		{
+			name:            "too many requests error",
+			err:             errors.NewTooManyRequestsError("limited"),
+			expectedCode:    codes.ResourceExhausted,
+			expectedMessage: "too many requests",
+			expectedDetails: []any{&errdetails.ErrorInfo{
+				Reason:   errors.TooManyRequestsErrorCode,
+				Metadata: map[string]string{"code": errors.TooManyRequestsErrorCode},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(ToGRPCError(tt.err))
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, st.Code())
+			assert.Equal(t, tt.expectedMessage, st.Message())
+
+			if tt.expectedDetails == nil {
+				assert.Empty(t, st.Details())
+			} else {
+				assert.Len(t, st.Details(), len(tt.expectedDetails))
+				assert.Equal(t, tt.expectedDetails[0].(*errdetails.ErrorInfo).Reason, st.Details()[0].(*errdetails.ErrorInfo).Reason)
+				assert.Equal(t, tt.expectedDetails[0].(*errdetails.ErrorInfo).Metadata, st.Details()[0].(*errdetails.ErrorInfo).Metadata)
+			}
+		})
+	}
+}
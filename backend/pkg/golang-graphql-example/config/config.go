@@ -12,6 +12,12 @@ const DefaultPort = 8080
 // DefaultInternalPort Default internal port.
 const DefaultInternalPort = 9090
 
+// DefaultGRPCPort Default gRPC port.
+const DefaultGRPCPort = 8081
+
+// MinLoginStateSecretLength Minimum length of OIDC login state cookie secret.
+const MinLoginStateSecretLength = 32
+
 // Default lock distributor table name.
 const DefaultLockDistributorTableName = "locks"
 
@@ -21,12 +27,149 @@ const DefaultLockDistributorLeaseDuration = "3s"
 // Default lock distributor heartbeat frequency.
 const DefaultLockDistributionHeartbeatFrequency = "1s"
 
+// Default email template locale.
+const DefaultEmailTemplateLocale = "en"
+
+// Default DKIM values.
+const DefaultSMTPDKIMCanonicalization = "relaxed/relaxed"
+
+// DefaultSMTPDKIMHeaders Default DKIM signed headers.
+var DefaultSMTPDKIMHeaders = []string{
+	"from", "to", "cc", "reply-to", "subject", "date", "message-id", "mime-version", "content-type",
+}
+
+// Default email file transport format.
+const DefaultEmailFileTransportFormat = "EML"
+
+// Default email outbox values.
+const (
+	DefaultEmailOutboxPollInterval   = "5s"
+	DefaultEmailOutboxInitialBackoff = "30s"
+	DefaultEmailOutboxMaxBackoff     = "1h"
+	DefaultEmailOutboxMaxAttempts    = 10
+	DefaultEmailOutboxBatchSize      = 50
+)
+
 // DefaultOIDCScopes Default OIDC scopes.
 var DefaultOIDCScopes = []string{"openid", "email", "profile"}
 
 // Default cookie name.
 const DefaultCookieName = "oidc"
 
+// OIDC principal types.
+const (
+	OIDCPrincipalTypeAuto    = "AUTO"
+	OIDCPrincipalTypeUser    = "USER"
+	OIDCPrincipalTypeService = "SERVICE"
+)
+
+// Default OIDC claim mapping.
+const (
+	DefaultOIDCPrincipalType        = OIDCPrincipalTypeAuto
+	DefaultOIDCClaimMappingUsername = "preferred_username"
+	DefaultOIDCClaimMappingEmail    = "email"
+	DefaultOIDCClaimMappingName     = "name"
+	DefaultOIDCClaimMappingClientID = "azp"
+)
+
+// Fake OIDC provider values.
+const (
+	FakeOIDCProviderPath                 = "/fake-oidc"
+	DefaultFakeOIDCProviderTokenDuration = "1h"
+)
+
+// OIDC session store types.
+const (
+	OIDCSessionStoreDatabase = "DATABASE"
+	OIDCSessionStoreMemory   = "MEMORY"
+)
+
+// Default OIDC session values.
+const (
+	DefaultOIDCSessionStore         = OIDCSessionStoreDatabase
+	DefaultOIDCSessionMaxLifetime   = "720h"
+	DefaultOIDCSessionRefreshBefore = "1m"
+)
+
+// Default OIDC impersonation values.
+const (
+	DefaultOIDCImpersonationMaxDuration = "1h"
+)
+
+// Default OIDC API token values.
+const (
+	DefaultOIDCAPITokenMaxDuration = "2160h"
+)
+
+// DefaultOIDCImpersonationRoles Default OIDC impersonation roles.
+var DefaultOIDCImpersonationRoles = []string{"admin"}
+
+// Server CSRF protection modes.
+const (
+	ServerCSRFModeHeader       = "HEADER"
+	ServerCSRFModeDoubleSubmit = "DOUBLE_SUBMIT"
+)
+
+// Default server CSRF protection values.
+const (
+	DefaultServerCSRFMode       = ServerCSRFModeHeader
+	DefaultServerCSRFHeaderName = "X-CSRF-Token"
+	DefaultServerCSRFCookieName = "csrf_token"
+)
+
+// Server rate limit store types.
+const (
+	ServerRateLimitStoreMemory   = "MEMORY"
+	ServerRateLimitStoreDatabase = "DATABASE"
+)
+
+// Default server rate limit values.
+const (
+	DefaultServerRateLimitStore  = ServerRateLimitStoreMemory
+	DefaultServerRateLimitPeriod = "1m"
+)
+
+// Server trusted documents sources.
+const (
+	ServerTrustedDocumentsSourceFile     = "FILE"
+	ServerTrustedDocumentsSourceDatabase = "DATABASE"
+)
+
+// DefaultServerTrustedDocumentsSource Default server trusted documents source.
+const DefaultServerTrustedDocumentsSource = ServerTrustedDocumentsSourceFile
+
+// Default server GraphQL limits values.
+const (
+	DefaultServerGraphQLMaxComplexity = 2000
+	DefaultServerGraphQLMaxDepth      = 15
+	DefaultServerGraphQLMaxAliases    = 30
+)
+
+// DefaultServerResponseCacheMaxEntries Default server response cache maximum entries.
+const DefaultServerResponseCacheMaxEntries = 1000
+
+// Default OPA server authorization values.
+const (
+	DefaultOPAServerTimeout                 = "5s"
+	DefaultOPAServerRetryWaitDuration       = "100ms"
+	DefaultOPAServerCacheTTL                = "5s"
+	DefaultOPAServerCacheMaxSize            = 10000
+	DefaultOPAServerCircuitBreakerThreshold = 5
+	DefaultOPAServerCircuitBreakerDuration  = "30s"
+)
+
+// RBAC rule effects.
+const (
+	RBACRuleEffectAllow = "ALLOW"
+	RBACRuleEffectDeny  = "DENY"
+)
+
+// Default RBAC rule values.
+const DefaultRBACRuleEffect = RBACRuleEffectAllow
+
+// DefaultRBACRuleResources Default RBAC rule resources.
+var DefaultRBACRuleResources = []string{"*"}
+
 // Default Database driver.
 const DefaultDatabaseDriver = "POSTGRES"
 
@@ -45,16 +188,22 @@ const (
 
 // Config Configuration object.
 type Config struct {
-	Log                    *LogConfig              `mapstructure:"log"                    json:"log,omitempty"`
-	Tracing                *TracingConfig          `mapstructure:"tracing"                json:"tracing,omitempty"`
-	Server                 *ServerConfig           `mapstructure:"server"                 json:"server,omitempty"`
-	InternalServer         *ServerConfig           `mapstructure:"internalServer"         json:"internalServer,omitempty"`
-	Database               *DatabaseConfig         `mapstructure:"database"               json:"database,omitempty"               validate:"required"`
-	LockDistributor        *LockDistributorConfig  `mapstructure:"lockDistributor"        json:"lockDistributor,omitempty"        validate:"required"`
-	OIDCAuthentication     *OIDCAuthConfig         `mapstructure:"oidcAuthentication"     json:"oidcAuthentication,omitempty"`
-	OPAServerAuthorization *OPAServerAuthorization `mapstructure:"opaServerAuthorization" json:"opaServerAuthorization,omitempty"`
-	SMTP                   *SMTPConfig             `mapstructure:"smtp"                   json:"smtp,omitempty"                   validate:"omitempty"`
-	AMQP                   *AMQPConfig             `mapstructure:"amqp"                   json:"amqp,omitempty"                   validate:"omitempty"`
+	Log                    *LogConfig               `mapstructure:"log"                    json:"log,omitempty"`
+	Tracing                *TracingConfig           `mapstructure:"tracing"                json:"tracing,omitempty"`
+	Server                 *ServerConfig            `mapstructure:"server"                 json:"server,omitempty"`
+	InternalServer         *ServerConfig            `mapstructure:"internalServer"         json:"internalServer,omitempty"`
+	GRPCServer             *GRPCServerConfig        `mapstructure:"grpcServer"             json:"grpcServer,omitempty"`
+	Database               *DatabaseConfig          `mapstructure:"database"               json:"database,omitempty"               validate:"required"`
+	LockDistributor        *LockDistributorConfig   `mapstructure:"lockDistributor"        json:"lockDistributor,omitempty"        validate:"required"`
+	OIDCAuthentication     *OIDCAuthConfig          `mapstructure:"oidcAuthentication"     json:"oidcAuthentication,omitempty"`
+	OPAServerAuthorization *OPAServerAuthorization  `mapstructure:"opaServerAuthorization" json:"opaServerAuthorization,omitempty"`
+	RBACAuthorization      *RBACAuthorizationConfig `mapstructure:"rbacAuthorization"      json:"rbacAuthorization,omitempty"      validate:"omitempty,excluded_with=OPAServerAuthorization"`
+	SMTP                   *SMTPConfig              `mapstructure:"smtp"                   json:"smtp,omitempty"                   validate:"omitempty"`
+	EmailTransport         *EmailTransportConfig    `mapstructure:"emailTransport"         json:"emailTransport,omitempty"         validate:"omitempty"`
+	EmailTemplates         *EmailTemplatesConfig    `mapstructure:"emailTemplates"         json:"emailTemplates,omitempty"         validate:"omitempty"`
+	EmailOutbox            *EmailOutboxConfig       `mapstructure:"emailOutbox"            json:"emailOutbox,omitempty"            validate:"omitempty"`
+	AMQP                   *AMQPConfig              `mapstructure:"amqp"                   json:"amqp,omitempty"                   validate:"omitempty"`
+	FakeOIDCProvider       *FakeOIDCProviderConfig  `mapstructure:"fakeOidcProvider"       json:"fakeOidcProvider,omitempty"       validate:"omitempty"`
 }
 
 // AMQPConfig AMQP Message Bus configuration.
@@ -122,23 +271,134 @@ type LockDistributorConfig struct {
 }
 
 // OIDCAuthConfig OpenID Connect authentication configurations.
+// LoginStateSecret is used to sign login state cookie and must contain at least MinLoginStateSecretLength characters.
+// State is deprecated and is only used as login state cookie secret when LoginStateSecret isn't set.
 type OIDCAuthConfig struct {
-	ClientSecret      *CredentialConfig `mapstructure:"clientSecret"      validate:"omitempty"     json:"clientSecret,omitempty"`
-	ClientID          string            `mapstructure:"clientId"          validate:"required"      json:"clientId,omitempty"`
-	IssuerURL         string            `mapstructure:"issuerUrl"         validate:"required,url"  json:"issuerUrl,omitempty"`
-	RedirectURL       string            `mapstructure:"redirectUrl"       validate:"required,url"  json:"redirectUrl,omitempty"`
-	LogoutRedirectURL string            `mapstructure:"logoutRedirectUrl" validate:"omitempty,url" json:"logoutRedirectUrl,omitempty"`
-	State             string            `mapstructure:"state"             validate:"required"      json:"state,omitempty"`
-	CookieName        string            `mapstructure:"cookieName"                                 json:"cookieName,omitempty"`
-	Scopes            []string          `mapstructure:"scopes"                                     json:"scopes,omitempty"`
-	EmailVerified     bool              `mapstructure:"emailVerified"                              json:"emailVerified,omitempty"`
-	CookieSecure      bool              `mapstructure:"cookieSecure"                               json:"cookieSecure,omitempty"`
+	ClientSecret      *CredentialConfig          `mapstructure:"clientSecret"      validate:"omitempty"                         json:"clientSecret,omitempty"`
+	LoginStateSecret  *CredentialConfig          `mapstructure:"loginStateSecret"  validate:"required_without=State"            json:"loginStateSecret,omitempty"`
+	ClaimMapping      *OIDCClaimMappingConfig    `mapstructure:"claimMapping"      validate:"omitempty"                         json:"claimMapping,omitempty"`
+	Session           *OIDCSessionConfig         `mapstructure:"session"           validate:"omitempty"                         json:"session,omitempty"`
+	Impersonation     *OIDCImpersonationConfig   `mapstructure:"impersonation"     validate:"omitempty"                         json:"impersonation,omitempty"`
+	APITokens         *OIDCAPITokensConfig       `mapstructure:"apiTokens"         validate:"omitempty"                         json:"apiTokens,omitempty"`
+	ClientID          string                     `mapstructure:"clientId"          validate:"required"                          json:"clientId,omitempty"`
+	IssuerURL         string                     `mapstructure:"issuerUrl"         validate:"required,url"                      json:"issuerUrl,omitempty"`
+	RedirectURL       string                     `mapstructure:"redirectUrl"       validate:"required,url"                      json:"redirectUrl,omitempty"`
+	LogoutRedirectURL string                     `mapstructure:"logoutRedirectUrl" validate:"omitempty,url"                     json:"logoutRedirectUrl,omitempty"`
+	State             string                     `mapstructure:"state"             validate:"required_without=LoginStateSecret" json:"state,omitempty"`
+	CookieName        string                     `mapstructure:"cookieName"                                                     json:"cookieName,omitempty"`
+	Scopes            []string                   `mapstructure:"scopes"                                                         json:"scopes,omitempty"`
+	TrustedIssuers    []*OIDCTrustedIssuerConfig `mapstructure:"trustedIssuers"    validate:"omitempty,dive,required"           json:"trustedIssuers,omitempty"`
+	EmailVerified     bool                       `mapstructure:"emailVerified"                                                  json:"emailVerified,omitempty"`
+	CookieSecure      bool                       `mapstructure:"cookieSecure"                                                   json:"cookieSecure,omitempty"`
+}
+
+// FakeOIDCProviderConfig Embedded fake OpenID Connect provider configuration.
+// Provider is served by internal server under FakeOIDCProviderPath. Only for local development and tests.
+type FakeOIDCProviderConfig struct {
+	Users         []*FakeOIDCProviderUserConfig   `mapstructure:"users"         validate:"required,min=1,dive,required" json:"users,omitempty"`
+	Clients       []*FakeOIDCProviderClientConfig `mapstructure:"clients"       validate:"omitempty,dive,required"      json:"clients,omitempty"`
+	IssuerURL     string                          `mapstructure:"issuerUrl"     validate:"required,url"                 json:"issuerUrl,omitempty"`
+	TokenDuration string                          `mapstructure:"tokenDuration"                                         json:"tokenDuration,omitempty"`
+}
+
+// FakeOIDCProviderUserConfig Embedded fake OpenID Connect provider test user configuration.
+type FakeOIDCProviderUserConfig struct {
+	Claims   map[string]any `mapstructure:"claims"                       json:"claims,omitempty"`
+	Username string         `mapstructure:"username" validate:"required" json:"username,omitempty"`
+	Password string         `mapstructure:"password" validate:"required" json:"-"` // Ignore this key in json marshal
+	Subject  string         `mapstructure:"subject"                      json:"subject,omitempty"`
+}
+
+// FakeOIDCProviderClientConfig Embedded fake OpenID Connect provider client configuration.
+type FakeOIDCProviderClientConfig struct {
+	ClientID     string   `mapstructure:"clientId"     validate:"required"           json:"clientId,omitempty"`
+	ClientSecret string   `mapstructure:"clientSecret"                               json:"-"` // Ignore this key in json marshal
+	RedirectURLs []string `mapstructure:"redirectUrls" validate:"omitempty,dive,url" json:"redirectUrls,omitempty"`
+}
+
+// OIDCSessionConfig OpenID Connect server-side session configuration.
+// When enabled, tokens are kept on server side and cookie only contains an opaque session token.
+type OIDCSessionConfig struct {
+	Store         string `mapstructure:"store"         validate:"oneof=DATABASE MEMORY" json:"store,omitempty"`
+	MaxLifetime   string `mapstructure:"maxLifetime"   validate:"required"              json:"maxLifetime,omitempty"`
+	RefreshBefore string `mapstructure:"refreshBefore" validate:"required"              json:"refreshBefore,omitempty"`
+}
+
+// OIDCImpersonationConfig OpenID Connect impersonation configuration.
+type OIDCImpersonationConfig struct {
+	MaxDuration string   `mapstructure:"maxDuration" validate:"required"       json:"maxDuration,omitempty"`
+	Roles       []string `mapstructure:"roles"       validate:"required,min=1" json:"roles,omitempty"`
+}
+
+// OIDCAPITokensConfig OpenID Connect API tokens configuration.
+// Owner roles and groups are saved when token is created, so MaxDuration limits how long they are used
+// after being removed from owner.
+type OIDCAPITokensConfig struct {
+	MaxDuration string `mapstructure:"maxDuration" validate:"required" json:"maxDuration,omitempty"`
+}
+
+// OIDCTrustedIssuerConfig OpenID Connect additional trusted issuer configuration.
+// Tokens coming from those issuers are only verified (no login flow).
+type OIDCTrustedIssuerConfig struct {
+	ClaimMapping  *OIDCClaimMappingConfig `mapstructure:"claimMapping"  validate:"omitempty"                    json:"claimMapping,omitempty"`
+	IssuerURL     string                  `mapstructure:"issuerUrl"     validate:"required,url"                 json:"issuerUrl,omitempty"`
+	PrincipalType string                  `mapstructure:"principalType" validate:"oneof=AUTO USER SERVICE"      json:"principalType,omitempty"`
+	Audiences     []string                `mapstructure:"audiences"     validate:"required,min=1,dive,required" json:"audiences,omitempty"`
+}
+
+// OIDCClaimMappingConfig OpenID Connect claim paths used to build the authenticated principal.
+// Paths are JSONPath-like expressions (e.g. "email", "$.realm_access.roles" or "resource_access['my-app'].roles").
+type OIDCClaimMappingConfig struct {
+	Extra    map[string]string `mapstructure:"extra"    json:"extra,omitempty"`
+	Username string            `mapstructure:"username" json:"username,omitempty"`
+	Email    string            `mapstructure:"email"    json:"email,omitempty"`
+	Name     string            `mapstructure:"name"     json:"name,omitempty"`
+	ClientID string            `mapstructure:"clientId" json:"clientId,omitempty"`
+	Roles    string            `mapstructure:"roles"    json:"roles,omitempty"`
+	Groups   string            `mapstructure:"groups"   json:"groups,omitempty"`
+	Tenant   string            `mapstructure:"tenant"   json:"tenant,omitempty"`
 }
 
 // OPAServerAuthorization OPA Server authorization.
 type OPAServerAuthorization struct {
-	Tags map[string]string `mapstructure:"tags" json:"tags,omitempty"`
-	URL  string            `mapstructure:"url"  json:"url,omitempty"  validate:"required,url"`
+	Tags              map[string]string                           `mapstructure:"tags"              json:"tags,omitempty"`
+	Cache             *OPAServerAuthorizationCacheConfig          `mapstructure:"cache"             json:"cache,omitempty"             validate:"omitempty"`
+	CircuitBreaker    *OPAServerAuthorizationCircuitBreakerConfig `mapstructure:"circuitBreaker"    json:"circuitBreaker,omitempty"    validate:"required"`
+	URL               string                                      `mapstructure:"url"               json:"url,omitempty"               validate:"required,url"`
+	BatchURL          string                                      `mapstructure:"batchUrl"          json:"batchUrl,omitempty"          validate:"omitempty,url"`
+	CompileURL        string                                      `mapstructure:"compileUrl"        json:"compileUrl,omitempty"        validate:"omitempty,url"`
+	CompileQuery      string                                      `mapstructure:"compileQuery"      json:"compileQuery,omitempty"      validate:"required_with=CompileURL"`
+	Timeout           string                                      `mapstructure:"timeout"           json:"timeout,omitempty"`
+	RetryWaitDuration string                                      `mapstructure:"retryWaitDuration" json:"retryWaitDuration,omitempty"`
+	RetryCount        int                                         `mapstructure:"retryCount"        json:"retryCount,omitempty"        validate:"gte=0"`
+}
+
+// OPAServerAuthorizationCacheConfig OPA Server authorization shared decision cache configuration.
+type OPAServerAuthorizationCacheConfig struct {
+	TTL     string `mapstructure:"ttl"     json:"ttl,omitempty"`
+	MaxSize int    `mapstructure:"maxSize" json:"maxSize,omitempty" validate:"gte=0"`
+}
+
+// OPAServerAuthorizationCircuitBreakerConfig OPA Server authorization circuit breaker configuration.
+type OPAServerAuthorizationCircuitBreakerConfig struct {
+	OpenDuration     string `mapstructure:"openDuration"     json:"openDuration,omitempty"`
+	FailureThreshold int    `mapstructure:"failureThreshold" json:"failureThreshold,omitempty" validate:"gte=0"`
+}
+
+// RBACAuthorizationConfig Built-in RBAC authorization engine configuration.
+// Deny rules take precedence over allow rules and everything not allowed is denied.
+type RBACAuthorizationConfig struct {
+	Rules []*RBACRuleConfig `mapstructure:"rules" validate:"required,dive,required" json:"rules,omitempty"`
+}
+
+// RBACRuleConfig RBAC rule configuration.
+// Actions, resources, roles and groups are patterns where "*" matches any characters (e.g. "todo:*").
+type RBACRuleConfig struct {
+	Effect    string   `mapstructure:"effect"    validate:"oneof=ALLOW DENY"                      json:"effect,omitempty"`
+	Actions   []string `mapstructure:"actions"   validate:"required,min=1,dive,required"          json:"actions,omitempty"`
+	Resources []string `mapstructure:"resources" validate:"required,min=1,dive,required"          json:"resources,omitempty"`
+	Roles     []string `mapstructure:"roles"     validate:"required_without=Groups,dive,required" json:"roles,omitempty"`
+	Groups    []string `mapstructure:"groups"    validate:"required_without=Roles,dive,required"  json:"groups,omitempty"`
 }
 
 // TracingConfig represents the Tracing configuration structure.
@@ -179,10 +439,101 @@ type LogConfig struct {
 
 // ServerConfig Server configuration.
 type ServerConfig struct {
-	CORS       *ServerCorsConfig     `mapstructure:"cors"       validate:"omitempty" json:"cors,omitempty"`
-	Compress   *ServerCompressConfig `mapstructure:"compress"                        json:"compress,omitempty"`
-	ListenAddr string                `mapstructure:"listenAddr"                      json:"listenAddr,omitempty"`
-	Port       int                   `mapstructure:"port"       validate:"required"  json:"port,omitempty"`
+	CORS             *ServerCorsConfig             `mapstructure:"cors"             validate:"omitempty" json:"cors,omitempty"`
+	CSRF             *ServerCSRFConfig             `mapstructure:"csrf"             validate:"omitempty" json:"csrf,omitempty"`
+	RateLimit        *ServerRateLimitConfig        `mapstructure:"rateLimit"        validate:"omitempty" json:"rateLimit,omitempty"`
+	TrustedDocuments *ServerTrustedDocumentsConfig `mapstructure:"trustedDocuments" validate:"omitempty" json:"trustedDocuments,omitempty"`
+	GraphQLLimits    *ServerGraphQLLimitsConfig    `mapstructure:"graphqlLimits"    validate:"omitempty" json:"graphqlLimits,omitempty"`
+	ResponseCache    *ServerResponseCacheConfig    `mapstructure:"responseCache"    validate:"omitempty" json:"responseCache,omitempty"`
+	Compress         *ServerCompressConfig         `mapstructure:"compress"                              json:"compress,omitempty"`
+	ListenAddr       string                        `mapstructure:"listenAddr"                            json:"listenAddr,omitempty"`
+	TrustedProxies   []string                      `mapstructure:"trustedProxies"                        json:"trustedProxies,omitempty"`
+	Port             int                           `mapstructure:"port"             validate:"required"  json:"port,omitempty"`
+}
+
+// GRPCServerConfig gRPC server configuration.
+type GRPCServerConfig struct {
+	ListenAddr string `mapstructure:"listenAddr"                     json:"listenAddr,omitempty"`
+	Port       int    `mapstructure:"port"       validate:"required" json:"port,omitempty"`
+	// Reflection will expose the services schema (authenticated like other calls)
+	Reflection bool `mapstructure:"reflection"                     json:"reflection,omitempty"`
+}
+
+// ServerRateLimitConfig Server rate limit configuration.
+// Requests are limited per authenticated user or per client ip when not authenticated.
+// IP limit is applied on all requests per client ip before authentication.
+type ServerRateLimitConfig struct {
+	IP                *ServerRateLimitIPConfig                 `mapstructure:"ip"                validate:"omitempty"             json:"ip,omitempty"`
+	Store             string                                   `mapstructure:"store"             validate:"oneof=MEMORY DATABASE" json:"store,omitempty"`
+	Routes            []*ServerRateLimitRouteConfig            `mapstructure:"routes"            validate:"dive,required"         json:"routes,omitempty"`
+	GraphQLOperations []*ServerRateLimitGraphQLOperationConfig `mapstructure:"graphqlOperations" validate:"dive,required"         json:"graphqlOperations,omitempty"`
+}
+
+// ServerRateLimitIPConfig Server rate limit configuration per client ip.
+type ServerRateLimitIPConfig struct {
+	Period string `mapstructure:"period" validate:"required"       json:"period,omitempty"`
+	Limit  int    `mapstructure:"limit"  validate:"required,min=1" json:"limit,omitempty"`
+	Burst  int    `mapstructure:"burst"  validate:"min=1"          json:"burst,omitempty"`
+}
+
+// ServerRateLimitRouteConfig Server rate limit configuration for routes matching path regexp and methods.
+type ServerRateLimitRouteConfig struct {
+	Path    string   `mapstructure:"path"    validate:"required"       json:"path,omitempty"`
+	Period  string   `mapstructure:"period"  validate:"required"       json:"period,omitempty"`
+	Methods []string `mapstructure:"methods"                           json:"methods,omitempty"`
+	Limit   int      `mapstructure:"limit"   validate:"required,min=1" json:"limit,omitempty"`
+	Burst   int      `mapstructure:"burst"   validate:"min=1"          json:"burst,omitempty"`
+}
+
+// ServerRateLimitGraphQLOperationConfig Server rate limit configuration for a GraphQL operation name.
+type ServerRateLimitGraphQLOperationConfig struct {
+	OperationName string `mapstructure:"operationName" validate:"required"       json:"operationName,omitempty"`
+	Period        string `mapstructure:"period"        validate:"required"       json:"period,omitempty"`
+	Limit         int    `mapstructure:"limit"         validate:"required,min=1" json:"limit,omitempty"`
+	Burst         int    `mapstructure:"burst"         validate:"min=1"          json:"burst,omitempty"`
+}
+
+// ServerTrustedDocumentsConfig Server trusted documents configuration.
+// When enabled, only GraphQL operations present in manifest are accepted.
+// Manifest path is used as manifest source for FILE source and as import source for DATABASE source.
+type ServerTrustedDocumentsConfig struct {
+	Source       string `mapstructure:"source"       validate:"oneof=FILE DATABASE"     json:"source,omitempty"`
+	ManifestPath string `mapstructure:"manifestPath" validate:"required_if=Source FILE" json:"manifestPath,omitempty"`
+}
+
+// ServerGraphQLLimitsConfig Server GraphQL operation limits configuration.
+// Complexity is computed from @cost directives, depth is the number of nested fields and aliases are counted in all selections.
+// Limits are pointers to keep a configured 0 (maxAliases set to 0 forbids aliases), unset limits get default values.
+type ServerGraphQLLimitsConfig struct {
+	Roles         []*ServerGraphQLRoleLimitsConfig `mapstructure:"roles"         validate:"dive,required"  json:"roles,omitempty"`
+	MaxComplexity *int                             `mapstructure:"maxComplexity" validate:"required,min=1" json:"maxComplexity,omitempty"`
+	MaxDepth      *int                             `mapstructure:"maxDepth"      validate:"required,min=1" json:"maxDepth,omitempty"`
+	MaxAliases    *int                             `mapstructure:"maxAliases"    validate:"required,min=0" json:"maxAliases,omitempty"`
+}
+
+// ServerGraphQLRoleLimitsConfig Server GraphQL operation limits for authenticated users having a role.
+// Unset limits are inherited from global ones and the greatest limits are used when user has multiple configured roles.
+type ServerGraphQLRoleLimitsConfig struct {
+	Role          string `mapstructure:"role"          validate:"required"       json:"role,omitempty"`
+	MaxComplexity *int   `mapstructure:"maxComplexity" validate:"omitempty,min=1" json:"maxComplexity,omitempty"`
+	MaxDepth      *int   `mapstructure:"maxDepth"      validate:"omitempty,min=1" json:"maxDepth,omitempty"`
+	MaxAliases    *int   `mapstructure:"maxAliases"    validate:"omitempty,min=0" json:"maxAliases,omitempty"`
+}
+
+// ServerResponseCacheConfig Server GraphQL in-memory response cache configuration.
+// Queries are cached using @cacheControl hints and entries are invalidated by mutations returning cached types.
+// Todo entries are invalidated by todo mutations done with REST and gRPC APIs too.
+type ServerResponseCacheConfig struct {
+	MaxEntries int `mapstructure:"maxEntries" validate:"min=1" json:"maxEntries,omitempty"`
+}
+
+// ServerCSRFConfig Server CSRF protection configuration.
+// Requests authenticated with a bearer token or an API key aren't checked.
+type ServerCSRFConfig struct {
+	Mode         string `mapstructure:"mode"         validate:"oneof=HEADER DOUBLE_SUBMIT" json:"mode,omitempty"`
+	HeaderName   string `mapstructure:"headerName"   validate:"required"                   json:"headerName,omitempty"`
+	CookieName   string `mapstructure:"cookieName"   validate:"required"                   json:"cookieName,omitempty"`
+	CookieSecure bool   `mapstructure:"cookieSecure"                                       json:"cookieSecure,omitempty"`
 }
 
 // ServerCompressConfig Server compress configuration.
@@ -221,6 +572,7 @@ type DatabaseConfig struct {
 
 // SMTPConfig SMTP Configuration.
 type SMTPConfig struct {
+	DKIM               *SMTPDKIMConfig   `mapstructure:"dkim"               json:"dkim,omitempty"               validate:"omitempty"`
 	Username           *CredentialConfig `mapstructure:"username"           json:"username,omitempty"`
 	Password           *CredentialConfig `mapstructure:"password"           json:"password,omitempty"`
 	Host               string            `mapstructure:"host"               json:"host,omitempty"               validation:"fqdn,required"`
@@ -233,6 +585,46 @@ type SMTPConfig struct {
 	TLSSkipVerify      bool              `mapstructure:"tlsSkipVerify"      json:"tlsSkipVerify,omitempty"`
 }
 
+// SMTPDKIMConfig SMTP DKIM signing configuration.
+type SMTPDKIMConfig struct {
+	PrivateKey       *CredentialConfig `mapstructure:"privateKey"       json:"privateKey,omitempty"       validate:"required"`
+	Domain           string            `mapstructure:"domain"           json:"domain,omitempty"           validate:"required"`
+	Selector         string            `mapstructure:"selector"         json:"selector,omitempty"         validate:"required"`
+	Canonicalization string            `mapstructure:"canonicalization" json:"canonicalization,omitempty" validate:"omitempty,oneof=simple/simple simple/relaxed relaxed/simple relaxed/relaxed"`
+	Headers          []string          `mapstructure:"headers"          json:"headers,omitempty"`
+}
+
+// EmailTransportConfig Email transport configuration.
+// When not set, SMTP transport is used if SMTP configuration is present.
+type EmailTransportConfig struct {
+	File *EmailFileTransportConfig `mapstructure:"file" json:"file,omitempty" validate:"required_if=Type FILE"`
+	Type string                    `mapstructure:"type" json:"type,omitempty" validate:"required,oneof=SMTP FILE CAPTURE"`
+}
+
+// EmailFileTransportConfig Email file transport configuration.
+type EmailFileTransportConfig struct {
+	Path   string `mapstructure:"path"   json:"path,omitempty"   validate:"required"`
+	Format string `mapstructure:"format" json:"format,omitempty" validate:"omitempty,oneof=EML MBOX"`
+}
+
+// EmailTemplatesConfig Email templates configuration.
+type EmailTemplatesConfig struct {
+	FolderPath     string `mapstructure:"folderPath"     json:"folderPath,omitempty"     validate:"required"`
+	DefaultLocale  string `mapstructure:"defaultLocale"  json:"defaultLocale,omitempty"`
+	From           string `mapstructure:"from"           json:"from,omitempty"`
+	InlineCSS      bool   `mapstructure:"inlineCss"      json:"inlineCss,omitempty"`
+	PreviewEnabled bool   `mapstructure:"previewEnabled" json:"previewEnabled,omitempty"`
+}
+
+// EmailOutboxConfig Email outbox configuration.
+type EmailOutboxConfig struct {
+	PollInterval   string `mapstructure:"pollInterval"   json:"pollInterval,omitempty"`
+	InitialBackoff string `mapstructure:"initialBackoff" json:"initialBackoff,omitempty"`
+	MaxBackoff     string `mapstructure:"maxBackoff"     json:"maxBackoff,omitempty"`
+	MaxAttempts    int    `mapstructure:"maxAttempts"    json:"maxAttempts,omitempty"    validate:"gte=0"`
+	BatchSize      int    `mapstructure:"batchSize"      json:"batchSize,omitempty"      validate:"gte=0"`
+}
+
 // CredentialConfig Credential Configurations.
 type CredentialConfig struct {
 	Path  string `mapstructure:"path"  validate:"required_without_all=Env Value"  json:"path,omitempty"`
@@ -10,6 +10,7 @@ func (*managerimpl) loadDefaultConfigurationValues(vip *viper.Viper) {
 	vip.SetDefault("log.format", DefaultLogFormat)
 	vip.SetDefault("server.port", DefaultPort)
 	vip.SetDefault("internalServer.port", DefaultInternalPort)
+	vip.SetDefault("grpcServer.port", DefaultGRPCPort)
 	vip.SetDefault("database.driver", DefaultDatabaseDriver)
 	vip.SetDefault("lockDistributor.tableName", DefaultLockDistributorTableName)
 	vip.SetDefault("lockDistributor.leaseDuration", DefaultLockDistributorLeaseDuration)
@@ -29,11 +30,127 @@ func loadBusinessDefaultValues(out *Config) error {
 		if out.OIDCAuthentication.CookieName == "" {
 			out.OIDCAuthentication.CookieName = DefaultCookieName
 		}
+		// Add default claim mapping
+		out.OIDCAuthentication.ClaimMapping = loadDefaultOIDCClaimMapping(out.OIDCAuthentication.ClaimMapping)
+		// Add default session values
+		if out.OIDCAuthentication.Session != nil {
+			loadDefaultOIDCSession(out.OIDCAuthentication.Session)
+		}
+		// Add default impersonation values
+		if out.OIDCAuthentication.Impersonation != nil {
+			loadDefaultOIDCImpersonation(out.OIDCAuthentication.Impersonation)
+		}
+		// Add default api tokens values
+		out.OIDCAuthentication.APITokens = loadDefaultOIDCAPITokens(out.OIDCAuthentication.APITokens)
+
+		// Loop over trusted issuers
+		for _, it := range out.OIDCAuthentication.TrustedIssuers {
+			// Add default principal type
+			if it.PrincipalType == "" {
+				it.PrincipalType = DefaultOIDCPrincipalType
+			}
+			// Add default claim mapping
+			it.ClaimMapping = loadDefaultOIDCClaimMapping(it.ClaimMapping)
+		}
+	}
+
+	// Load default email templates locale
+	if out.EmailTemplates != nil && out.EmailTemplates.DefaultLocale == "" {
+		out.EmailTemplates.DefaultLocale = DefaultEmailTemplateLocale
+	}
+
+	// Load default DKIM values
+	if out.SMTP != nil && out.SMTP.DKIM != nil {
+		if out.SMTP.DKIM.Canonicalization == "" {
+			out.SMTP.DKIM.Canonicalization = DefaultSMTPDKIMCanonicalization
+		}
+
+		if len(out.SMTP.DKIM.Headers) == 0 {
+			out.SMTP.DKIM.Headers = DefaultSMTPDKIMHeaders
+		}
+	}
+
+	// Load default email file transport format
+	if out.EmailTransport != nil && out.EmailTransport.File != nil && out.EmailTransport.File.Format == "" {
+		out.EmailTransport.File.Format = DefaultEmailFileTransportFormat
+	}
+
+	// Load default email outbox values
+	if out.EmailOutbox != nil {
+		if out.EmailOutbox.PollInterval == "" {
+			out.EmailOutbox.PollInterval = DefaultEmailOutboxPollInterval
+		}
+
+		if out.EmailOutbox.InitialBackoff == "" {
+			out.EmailOutbox.InitialBackoff = DefaultEmailOutboxInitialBackoff
+		}
+
+		if out.EmailOutbox.MaxBackoff == "" {
+			out.EmailOutbox.MaxBackoff = DefaultEmailOutboxMaxBackoff
+		}
+
+		if out.EmailOutbox.MaxAttempts == 0 {
+			out.EmailOutbox.MaxAttempts = DefaultEmailOutboxMaxAttempts
+		}
+
+		if out.EmailOutbox.BatchSize == 0 {
+			out.EmailOutbox.BatchSize = DefaultEmailOutboxBatchSize
+		}
 	}
 
-	// Load default tags for opa authorization
-	if out.OPAServerAuthorization != nil && out.OPAServerAuthorization.Tags == nil {
-		out.OPAServerAuthorization.Tags = map[string]string{}
+	// Load default values for opa authorization
+	if out.OPAServerAuthorization != nil {
+		loadDefaultOPAServerAuthorization(out.OPAServerAuthorization)
+	}
+
+	// Load default rbac rules values
+	if out.RBACAuthorization != nil {
+		for _, it := range out.RBACAuthorization.Rules {
+			// Check if it exists
+			if it == nil {
+				continue
+			}
+
+			if it.Effect == "" {
+				it.Effect = DefaultRBACRuleEffect
+			}
+
+			if len(it.Resources) == 0 {
+				it.Resources = DefaultRBACRuleResources
+			}
+		}
+	}
+
+	// Load default server csrf values
+	for _, it := range []*ServerConfig{out.Server, out.InternalServer} {
+		if it != nil && it.CSRF != nil {
+			loadDefaultServerCSRF(it.CSRF)
+		}
+	}
+
+	// Load default server rate limit values
+	if out.Server != nil && out.Server.RateLimit != nil {
+		loadDefaultServerRateLimit(out.Server.RateLimit)
+	}
+
+	// Load default server trusted documents values
+	if out.Server != nil && out.Server.TrustedDocuments != nil && out.Server.TrustedDocuments.Source == "" {
+		out.Server.TrustedDocuments.Source = DefaultServerTrustedDocumentsSource
+	}
+
+	// Load default server graphql limits values
+	if out.Server != nil && out.Server.GraphQLLimits != nil {
+		loadDefaultServerGraphQLLimits(out.Server.GraphQLLimits)
+	}
+
+	// Load default server response cache values
+	if out.Server != nil && out.Server.ResponseCache != nil && out.Server.ResponseCache.MaxEntries == 0 {
+		out.Server.ResponseCache.MaxEntries = DefaultServerResponseCacheMaxEntries
+	}
+
+	// Load default fake oidc provider values
+	if out.FakeOIDCProvider != nil && out.FakeOIDCProvider.TokenDuration == "" {
+		out.FakeOIDCProvider.TokenDuration = DefaultFakeOIDCProviderTokenDuration
 	}
 
 	// Load default tracing configuration
@@ -45,8 +162,190 @@ func loadBusinessDefaultValues(out *Config) error {
 	return nil
 }
 
+func loadDefaultOIDCClaimMapping(in *OIDCClaimMappingConfig) *OIDCClaimMappingConfig {
+	// Check if it exists
+	if in == nil {
+		in = &OIDCClaimMappingConfig{}
+	}
+
+	if in.Username == "" {
+		in.Username = DefaultOIDCClaimMappingUsername
+	}
+
+	if in.Email == "" {
+		in.Email = DefaultOIDCClaimMappingEmail
+	}
+
+	if in.Name == "" {
+		in.Name = DefaultOIDCClaimMappingName
+	}
+
+	if in.ClientID == "" {
+		in.ClientID = DefaultOIDCClaimMappingClientID
+	}
+
+	return in
+}
+
+func loadDefaultOIDCSession(in *OIDCSessionConfig) {
+	if in.Store == "" {
+		in.Store = DefaultOIDCSessionStore
+	}
+
+	if in.MaxLifetime == "" {
+		in.MaxLifetime = DefaultOIDCSessionMaxLifetime
+	}
+
+	if in.RefreshBefore == "" {
+		in.RefreshBefore = DefaultOIDCSessionRefreshBefore
+	}
+}
+
+func loadDefaultOIDCImpersonation(in *OIDCImpersonationConfig) {
+	if in.MaxDuration == "" {
+		in.MaxDuration = DefaultOIDCImpersonationMaxDuration
+	}
+
+	if len(in.Roles) == 0 {
+		in.Roles = DefaultOIDCImpersonationRoles
+	}
+}
+
+func loadDefaultOIDCAPITokens(in *OIDCAPITokensConfig) *OIDCAPITokensConfig {
+	// Check if it exists
+	if in == nil {
+		in = &OIDCAPITokensConfig{}
+	}
+
+	if in.MaxDuration == "" {
+		in.MaxDuration = DefaultOIDCAPITokenMaxDuration
+	}
+
+	return in
+}
+
+func loadDefaultOPAServerAuthorization(in *OPAServerAuthorization) {
+	// Load default tags
+	if in.Tags == nil {
+		in.Tags = map[string]string{}
+	}
+
+	if in.Timeout == "" {
+		in.Timeout = DefaultOPAServerTimeout
+	}
+
+	if in.RetryWaitDuration == "" {
+		in.RetryWaitDuration = DefaultOPAServerRetryWaitDuration
+	}
+
+	// Load default shared cache values
+	if in.Cache != nil {
+		if in.Cache.TTL == "" {
+			in.Cache.TTL = DefaultOPAServerCacheTTL
+		}
+
+		if in.Cache.MaxSize == 0 {
+			in.Cache.MaxSize = DefaultOPAServerCacheMaxSize
+		}
+	}
+
+	// Load default circuit breaker values
+	if in.CircuitBreaker == nil {
+		in.CircuitBreaker = &OPAServerAuthorizationCircuitBreakerConfig{}
+	}
+
+	if in.CircuitBreaker.FailureThreshold == 0 {
+		in.CircuitBreaker.FailureThreshold = DefaultOPAServerCircuitBreakerThreshold
+	}
+
+	if in.CircuitBreaker.OpenDuration == "" {
+		in.CircuitBreaker.OpenDuration = DefaultOPAServerCircuitBreakerDuration
+	}
+}
+
 func parseValues(_ *Config) error {
 	// TODO make any parsing here
 	// Default
 	return nil
 }
+
+func loadDefaultServerCSRF(in *ServerCSRFConfig) {
+	if in.Mode == "" {
+		in.Mode = DefaultServerCSRFMode
+	}
+
+	if in.HeaderName == "" {
+		in.HeaderName = DefaultServerCSRFHeaderName
+	}
+
+	if in.CookieName == "" {
+		in.CookieName = DefaultServerCSRFCookieName
+	}
+}
+
+func loadDefaultServerRateLimit(in *ServerRateLimitConfig) {
+	if in.Store == "" {
+		in.Store = DefaultServerRateLimitStore
+	}
+
+	if in.IP != nil {
+		if in.IP.Period == "" {
+			in.IP.Period = DefaultServerRateLimitPeriod
+		}
+
+		// Burst is the limit by default
+		if in.IP.Burst == 0 {
+			in.IP.Burst = in.IP.Limit
+		}
+	}
+
+	for _, it := range in.Routes {
+		// Check if it exists
+		if it == nil {
+			continue
+		}
+
+		if it.Period == "" {
+			it.Period = DefaultServerRateLimitPeriod
+		}
+
+		// Burst is the limit by default
+		if it.Burst == 0 {
+			it.Burst = it.Limit
+		}
+	}
+
+	for _, it := range in.GraphQLOperations {
+		// Check if it exists
+		if it == nil {
+			continue
+		}
+
+		if it.Period == "" {
+			it.Period = DefaultServerRateLimitPeriod
+		}
+
+		// Burst is the limit by default
+		if it.Burst == 0 {
+			it.Burst = it.Limit
+		}
+	}
+}
+
+func loadDefaultServerGraphQLLimits(in *ServerGraphQLLimitsConfig) {
+	// Only unset limits are changed, a configured 0 is kept
+	if in.MaxComplexity == nil {
+		v := DefaultServerGraphQLMaxComplexity
+		in.MaxComplexity = &v
+	}
+
+	if in.MaxDepth == nil {
+		v := DefaultServerGraphQLMaxDepth
+		in.MaxDepth = &v
+	}
+
+	if in.MaxAliases == nil {
+		v := DefaultServerGraphQLMaxAliases
+		in.MaxAliases = &v
+	}
+}
@@ -75,6 +75,7 @@ database:
 				},
 				Server:         &ServerConfig{Port: 8080},
 				InternalServer: &ServerConfig{Port: 9090},
+				GRPCServer:     &GRPCServerConfig{Port: 8081},
 				LockDistributor: &LockDistributorConfig{
 					HeartbeatFrequency: "1s",
 					LeaseDuration:      "3s",
@@ -215,6 +216,9 @@ tracing:
 		InternalServer: &ServerConfig{
 			Port: 9090,
 		},
+		GRPCServer: &GRPCServerConfig{
+			Port: 8081,
+		},
 		Tracing: &TracingConfig{Enabled: true, Type: TracingOtelHTTPType, OtelHTTP: &TracingOtelHTTPConfig{ServerURL: "http://localhost:4318/v1/traces"}},
 		Database: &DatabaseConfig{
 			Driver:        "POSTGRES",
@@ -265,6 +269,9 @@ log:
 		InternalServer: &ServerConfig{
 			Port: 9090,
 		},
+		GRPCServer: &GRPCServerConfig{
+			Port: 8081,
+		},
 		Tracing: &TracingConfig{Enabled: true, Type: TracingOtelHTTPType, OtelHTTP: &TracingOtelHTTPConfig{ServerURL: "http://localhost:4318/v1/traces"}},
 		Database: &DatabaseConfig{
 			Driver:        "POSTGRES",
@@ -367,6 +374,9 @@ oidcAuthentication:
 		InternalServer: &ServerConfig{
 			Port: 9090,
 		},
+		GRPCServer: &GRPCServerConfig{
+			Port: 8081,
+		},
 		Tracing: &TracingConfig{Enabled: true, Type: TracingOtelHTTPType, OtelHTTP: &TracingOtelHTTPConfig{ServerURL: "http://localhost:4318/v1/traces"}},
 		Database: &DatabaseConfig{
 			Driver:        "POSTGRES",
@@ -389,6 +399,13 @@ oidcAuthentication:
 			RedirectURL:   "http://localhost:8080/",
 			EmailVerified: true,
 			Scopes:        []string{"openid", "email", "profile"},
+			ClaimMapping: &OIDCClaimMappingConfig{
+				Username: "preferred_username",
+				Email:    "email",
+				Name:     "name",
+				ClientID: "azp",
+			},
+			APITokens: &OIDCAPITokensConfig{MaxDuration: DefaultOIDCAPITokenMaxDuration},
 		},
 	}, res)
 
@@ -428,6 +445,9 @@ oidcAuthentication:
 		InternalServer: &ServerConfig{
 			Port: 9090,
 		},
+		GRPCServer: &GRPCServerConfig{
+			Port: 8081,
+		},
 		Tracing: &TracingConfig{Enabled: true, Type: TracingOtelHTTPType, OtelHTTP: &TracingOtelHTTPConfig{ServerURL: "http://localhost:4318/v1/traces"}},
 		Database: &DatabaseConfig{
 			Driver:        "POSTGRES",
@@ -450,6 +470,13 @@ oidcAuthentication:
 			RedirectURL:   "http://localhost:8080/",
 			EmailVerified: true,
 			Scopes:        []string{"openid", "email", "profile"},
+			ClaimMapping: &OIDCClaimMappingConfig{
+				Username: "preferred_username",
+				Email:    "email",
+				Name:     "name",
+				ClientID: "azp",
+			},
+			APITokens: &OIDCAPITokensConfig{MaxDuration: DefaultOIDCAPITokenMaxDuration},
 		},
 	}, res)
 	assert.True(t, reloadHookCalled)
@@ -533,6 +560,9 @@ tracing:
 		InternalServer: &ServerConfig{
 			Port: 9090,
 		},
+		GRPCServer: &GRPCServerConfig{
+			Port: 8081,
+		},
 		Tracing: &TracingConfig{Enabled: true, Type: TracingOtelHTTPType, OtelHTTP: &TracingOtelHTTPConfig{ServerURL: "http://localhost:4318/v1/traces"}},
 		Database: &DatabaseConfig{
 			Driver:        "POSTGRES",
@@ -574,6 +604,9 @@ configuration with error
 		InternalServer: &ServerConfig{
 			Port: 9090,
 		},
+		GRPCServer: &GRPCServerConfig{
+			Port: 8081,
+		},
 		Tracing: &TracingConfig{Enabled: true, Type: TracingOtelHTTPType, OtelHTTP: &TracingOtelHTTPConfig{ServerURL: "http://localhost:4318/v1/traces"}},
 		Database: &DatabaseConfig{
 			Driver:        "POSTGRES",
@@ -676,6 +709,9 @@ opaServerAuthorization:
 		InternalServer: &ServerConfig{
 			Port: 9090,
 		},
+		GRPCServer: &GRPCServerConfig{
+			Port: 8081,
+		},
 		Tracing: &TracingConfig{Enabled: true, Type: TracingOtelHTTPType, OtelHTTP: &TracingOtelHTTPConfig{ServerURL: "http://localhost:4318/v1/traces"}},
 		Database: &DatabaseConfig{
 			Driver:        "POSTGRES",
@@ -692,6 +728,12 @@ opaServerAuthorization:
 				"t1": "v1",
 				"t2": "v2",
 			},
+			Timeout:           "5s",
+			RetryWaitDuration: "100ms",
+			CircuitBreaker: &OPAServerAuthorizationCircuitBreakerConfig{
+				FailureThreshold: 5,
+				OpenDuration:     "30s",
+			},
 		},
 	}, res)
 
@@ -733,6 +775,9 @@ opaServerAuthorization:
 		InternalServer: &ServerConfig{
 			Port: 9090,
 		},
+		GRPCServer: &GRPCServerConfig{
+			Port: 8081,
+		},
 		Tracing: &TracingConfig{Enabled: true, Type: TracingOtelHTTPType, OtelHTTP: &TracingOtelHTTPConfig{ServerURL: "http://localhost:4318/v1/traces"}},
 		Database: &DatabaseConfig{
 			Driver:        "POSTGRES",
@@ -749,6 +794,12 @@ opaServerAuthorization:
 				"t1": "v1",
 				"t3": "v3",
 			},
+			Timeout:           "5s",
+			RetryWaitDuration: "100ms",
+			CircuitBreaker: &OPAServerAuthorizationCircuitBreakerConfig{
+				FailureThreshold: 5,
+				OpenDuration:     "30s",
+			},
 		},
 	}, res)
 	assert.True(t, reloadHookCalled)
@@ -831,6 +882,9 @@ database:
 		InternalServer: &ServerConfig{
 			Port: 9090,
 		},
+		GRPCServer: &GRPCServerConfig{
+			Port: 8081,
+		},
 		Tracing: &TracingConfig{Enabled: false, Type: TracingOtelHTTPType},
 		Database: &DatabaseConfig{
 			Driver:        "POSTGRES",
@@ -935,6 +989,9 @@ opaServerAuthorization:
 		InternalServer: &ServerConfig{
 			Port: 9090,
 		},
+		GRPCServer: &GRPCServerConfig{
+			Port: 8081,
+		},
 		Tracing: &TracingConfig{Enabled: true, Type: TracingOtelHTTPType, OtelHTTP: &TracingOtelHTTPConfig{ServerURL: "http://localhost:4318/v1/traces"}},
 		Database: &DatabaseConfig{
 			Driver:        "POSTGRES",
@@ -951,6 +1008,12 @@ opaServerAuthorization:
 				"t1": "v1",
 				"t2": "v2",
 			},
+			Timeout:           "5s",
+			RetryWaitDuration: "100ms",
+			CircuitBreaker: &OPAServerAuthorizationCircuitBreakerConfig{
+				FailureThreshold: 5,
+				OpenDuration:     "30s",
+			},
 		},
 	}, res)
 
@@ -992,6 +1055,9 @@ opaServerAuthorization:
 		InternalServer: &ServerConfig{
 			Port: 9090,
 		},
+		GRPCServer: &GRPCServerConfig{
+			Port: 8081,
+		},
 		Tracing: &TracingConfig{Enabled: true, Type: TracingOtelHTTPType, OtelHTTP: &TracingOtelHTTPConfig{ServerURL: "http://localhost:4318/v1/traces"}},
 		Database: &DatabaseConfig{
 			Driver:        "POSTGRES",
@@ -1008,6 +1074,12 @@ opaServerAuthorization:
 				"t1": "v1",
 				"t3": "v3",
 			},
+			Timeout:           "5s",
+			RetryWaitDuration: "100ms",
+			CircuitBreaker: &OPAServerAuthorizationCircuitBreakerConfig{
+				FailureThreshold: 5,
+				OpenDuration:     "30s",
+			},
 		},
 	}, res)
 	assert.Equal(t, 1, reloadHookCalledCount)
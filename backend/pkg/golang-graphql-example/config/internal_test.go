@@ -37,6 +37,248 @@ func Test_loadBusinessDefaultValues(t *testing.T) {
 				OIDCAuthentication: &OIDCAuthConfig{
 					Scopes:     DefaultOIDCScopes,
 					CookieName: DefaultCookieName,
+					ClaimMapping: &OIDCClaimMappingConfig{
+						Username: DefaultOIDCClaimMappingUsername,
+						Email:    DefaultOIDCClaimMappingEmail,
+						Name:     DefaultOIDCClaimMappingName,
+						ClientID: DefaultOIDCClaimMappingClientID,
+					},
+					APITokens: &OIDCAPITokensConfig{MaxDuration: DefaultOIDCAPITokenMaxDuration},
+				},
+			},
+		},
+		{
+			name: "oidc session",
+			args: args{
+				out: &Config{
+					OIDCAuthentication: &OIDCAuthConfig{
+						Session: &OIDCSessionConfig{Store: OIDCSessionStoreMemory},
+					},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				OIDCAuthentication: &OIDCAuthConfig{
+					Scopes:     DefaultOIDCScopes,
+					CookieName: DefaultCookieName,
+					ClaimMapping: &OIDCClaimMappingConfig{
+						Username: DefaultOIDCClaimMappingUsername,
+						Email:    DefaultOIDCClaimMappingEmail,
+						Name:     DefaultOIDCClaimMappingName,
+						ClientID: DefaultOIDCClaimMappingClientID,
+					},
+					APITokens: &OIDCAPITokensConfig{MaxDuration: DefaultOIDCAPITokenMaxDuration},
+					Session: &OIDCSessionConfig{
+						Store:         OIDCSessionStoreMemory,
+						MaxLifetime:   DefaultOIDCSessionMaxLifetime,
+						RefreshBefore: DefaultOIDCSessionRefreshBefore,
+					},
+				},
+			},
+		},
+		{
+			name: "oidc impersonation",
+			args: args{
+				out: &Config{
+					OIDCAuthentication: &OIDCAuthConfig{
+						Impersonation: &OIDCImpersonationConfig{},
+					},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				OIDCAuthentication: &OIDCAuthConfig{
+					Scopes:     DefaultOIDCScopes,
+					CookieName: DefaultCookieName,
+					ClaimMapping: &OIDCClaimMappingConfig{
+						Username: DefaultOIDCClaimMappingUsername,
+						Email:    DefaultOIDCClaimMappingEmail,
+						Name:     DefaultOIDCClaimMappingName,
+						ClientID: DefaultOIDCClaimMappingClientID,
+					},
+					APITokens: &OIDCAPITokensConfig{MaxDuration: DefaultOIDCAPITokenMaxDuration},
+					Impersonation: &OIDCImpersonationConfig{
+						MaxDuration: DefaultOIDCImpersonationMaxDuration,
+						Roles:       DefaultOIDCImpersonationRoles,
+					},
+				},
+			},
+		},
+		{
+			name: "server csrf",
+			args: args{
+				out: &Config{
+					Server:         &ServerConfig{CSRF: &ServerCSRFConfig{Mode: ServerCSRFModeDoubleSubmit}},
+					InternalServer: &ServerConfig{},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				Server: &ServerConfig{
+					CSRF: &ServerCSRFConfig{
+						Mode:       ServerCSRFModeDoubleSubmit,
+						HeaderName: DefaultServerCSRFHeaderName,
+						CookieName: DefaultServerCSRFCookieName,
+					},
+				},
+				InternalServer: &ServerConfig{},
+			},
+		},
+		{
+			name: "server rate limit",
+			args: args{
+				out: &Config{
+					Server: &ServerConfig{RateLimit: &ServerRateLimitConfig{
+						IP:                &ServerRateLimitIPConfig{Limit: 100},
+						Routes:            []*ServerRateLimitRouteConfig{{Path: "^/api", Limit: 10}},
+						GraphQLOperations: []*ServerRateLimitGraphQLOperationConfig{{OperationName: "op", Limit: 5, Burst: 1}},
+					}},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				Server: &ServerConfig{RateLimit: &ServerRateLimitConfig{
+					Store: DefaultServerRateLimitStore,
+					IP:    &ServerRateLimitIPConfig{Limit: 100, Burst: 100, Period: DefaultServerRateLimitPeriod},
+					Routes: []*ServerRateLimitRouteConfig{
+						{Path: "^/api", Limit: 10, Burst: 10, Period: DefaultServerRateLimitPeriod},
+					},
+					GraphQLOperations: []*ServerRateLimitGraphQLOperationConfig{
+						{OperationName: "op", Limit: 5, Burst: 1, Period: DefaultServerRateLimitPeriod},
+					},
+				}},
+			},
+		},
+		{
+			name: "server trusted documents",
+			args: args{
+				out: &Config{
+					Server: &ServerConfig{TrustedDocuments: &ServerTrustedDocumentsConfig{ManifestPath: "manifest.json"}},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				Server: &ServerConfig{TrustedDocuments: &ServerTrustedDocumentsConfig{
+					Source:       DefaultServerTrustedDocumentsSource,
+					ManifestPath: "manifest.json",
+				}},
+			},
+		},
+		{
+			name: "server graphql limits",
+			args: args{
+				out: &Config{
+					Server: &ServerConfig{GraphQLLimits: &ServerGraphQLLimitsConfig{
+						MaxDepth: intPtr(5),
+						Roles:    []*ServerGraphQLRoleLimitsConfig{{Role: "admin", MaxComplexity: intPtr(10000)}},
+					}},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				Server: &ServerConfig{GraphQLLimits: &ServerGraphQLLimitsConfig{
+					MaxComplexity: intPtr(DefaultServerGraphQLMaxComplexity),
+					MaxDepth:      intPtr(5),
+					MaxAliases:    intPtr(DefaultServerGraphQLMaxAliases),
+					Roles:         []*ServerGraphQLRoleLimitsConfig{{Role: "admin", MaxComplexity: intPtr(10000)}},
+				}},
+			},
+		},
+		{
+			name: "server graphql limits set to 0",
+			args: args{
+				out: &Config{
+					Server: &ServerConfig{GraphQLLimits: &ServerGraphQLLimitsConfig{MaxAliases: intPtr(0)}},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				Server: &ServerConfig{GraphQLLimits: &ServerGraphQLLimitsConfig{
+					MaxComplexity: intPtr(DefaultServerGraphQLMaxComplexity),
+					MaxDepth:      intPtr(DefaultServerGraphQLMaxDepth),
+					MaxAliases:    intPtr(0),
+				}},
+			},
+		},
+		{
+			name: "server response cache",
+			args: args{
+				out: &Config{
+					Server: &ServerConfig{ResponseCache: &ServerResponseCacheConfig{}},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				Server: &ServerConfig{ResponseCache: &ServerResponseCacheConfig{
+					MaxEntries: DefaultServerResponseCacheMaxEntries,
+				}},
+			},
+		},
+		{
+			name: "fake oidc provider",
+			args: args{
+				out: &Config{
+					FakeOIDCProvider: &FakeOIDCProviderConfig{IssuerURL: "http://localhost:9090/fake-oidc"},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				FakeOIDCProvider: &FakeOIDCProviderConfig{
+					IssuerURL:     "http://localhost:9090/fake-oidc",
+					TokenDuration: DefaultFakeOIDCProviderTokenDuration,
+				},
+			},
+		},
+		{
+			name: "oidc trusted issuers",
+			args: args{
+				out: &Config{
+					OIDCAuthentication: &OIDCAuthConfig{
+						TrustedIssuers: []*OIDCTrustedIssuerConfig{
+							{IssuerURL: "https://partner.example.com"},
+							{
+								IssuerURL:     "https://m2m.example.com",
+								PrincipalType: OIDCPrincipalTypeService,
+								ClaimMapping:  &OIDCClaimMappingConfig{ClientID: "client_id"},
+							},
+						},
+					},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				OIDCAuthentication: &OIDCAuthConfig{
+					Scopes:     DefaultOIDCScopes,
+					CookieName: DefaultCookieName,
+					ClaimMapping: &OIDCClaimMappingConfig{
+						Username: DefaultOIDCClaimMappingUsername,
+						Email:    DefaultOIDCClaimMappingEmail,
+						Name:     DefaultOIDCClaimMappingName,
+						ClientID: DefaultOIDCClaimMappingClientID,
+					},
+					APITokens: &OIDCAPITokensConfig{MaxDuration: DefaultOIDCAPITokenMaxDuration},
+					TrustedIssuers: []*OIDCTrustedIssuerConfig{
+						{
+							IssuerURL:     "https://partner.example.com",
+							PrincipalType: DefaultOIDCPrincipalType,
+							ClaimMapping: &OIDCClaimMappingConfig{
+								Username: DefaultOIDCClaimMappingUsername,
+								Email:    DefaultOIDCClaimMappingEmail,
+								Name:     DefaultOIDCClaimMappingName,
+								ClientID: DefaultOIDCClaimMappingClientID,
+							},
+						},
+						{
+							IssuerURL:     "https://m2m.example.com",
+							PrincipalType: OIDCPrincipalTypeService,
+							ClaimMapping: &OIDCClaimMappingConfig{
+								Username: DefaultOIDCClaimMappingUsername,
+								Email:    DefaultOIDCClaimMappingEmail,
+								Name:     DefaultOIDCClaimMappingName,
+								ClientID: "client_id",
+							},
+						},
+					},
 				},
 			},
 		},
@@ -50,7 +292,63 @@ func Test_loadBusinessDefaultValues(t *testing.T) {
 			expectedCfg: &Config{
 				Tracing: &TracingConfig{Enabled: false},
 				OPAServerAuthorization: &OPAServerAuthorization{
-					Tags: map[string]string{},
+					Tags:              map[string]string{},
+					Timeout:           "5s",
+					RetryWaitDuration: "100ms",
+					CircuitBreaker: &OPAServerAuthorizationCircuitBreakerConfig{
+						FailureThreshold: 5,
+						OpenDuration:     "30s",
+					},
+				},
+			},
+		},
+		{
+			name: "opa with cache",
+			args: args{
+				out: &Config{
+					OPAServerAuthorization: &OPAServerAuthorization{
+						Cache:      &OPAServerAuthorizationCacheConfig{},
+						RetryCount: 1,
+					},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				OPAServerAuthorization: &OPAServerAuthorization{
+					Tags:              map[string]string{},
+					Timeout:           "5s",
+					RetryCount:        1,
+					RetryWaitDuration: "100ms",
+					Cache: &OPAServerAuthorizationCacheConfig{
+						TTL:     "5s",
+						MaxSize: 10000,
+					},
+					CircuitBreaker: &OPAServerAuthorizationCircuitBreakerConfig{
+						FailureThreshold: 5,
+						OpenDuration:     "30s",
+					},
+				},
+			},
+		},
+		{
+			name: "rbac",
+			args: args{
+				out: &Config{
+					RBACAuthorization: &RBACAuthorizationConfig{
+						Rules: []*RBACRuleConfig{
+							{Actions: []string{"todo:*"}, Roles: []string{"admin"}},
+							{Actions: []string{"todo:Close"}, Resources: []string{"todo:1"}, Groups: []string{"readers"}, Effect: "DENY"},
+						},
+					},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				RBACAuthorization: &RBACAuthorizationConfig{
+					Rules: []*RBACRuleConfig{
+						{Actions: []string{"todo:*"}, Resources: []string{"*"}, Roles: []string{"admin"}, Effect: "ALLOW"},
+						{Actions: []string{"todo:Close"}, Resources: []string{"todo:1"}, Groups: []string{"readers"}, Effect: "DENY"},
+					},
 				},
 			},
 		},
@@ -66,3 +364,7 @@ func Test_loadBusinessDefaultValues(t *testing.T) {
 		})
 	}
 }
+
+func intPtr(v int) *int {
+	return &v
+}
@@ -1,7 +1,14 @@
 package config
 
+import "emperror.dev/errors"
+
 // Validate configuration in a business way.
-func validateBusinessConfig(_ *Config) error {
-	// TODO Validate configuration in a business way
+func validateBusinessConfig(cfg *Config) error {
+	// Check OIDC login state secret length
+	if cfg.OIDCAuthentication != nil && cfg.OIDCAuthentication.LoginStateSecret != nil &&
+		len(cfg.OIDCAuthentication.LoginStateSecret.Value) < MinLoginStateSecretLength {
+		return errors.Errorf("oidcAuthentication.loginStateSecret must contain at least %d characters", MinLoginStateSecretLength)
+	}
+
 	return nil
 }
@@ -0,0 +1,50 @@
+//go:build unit
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateBusinessConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *Config
+		wantErr bool
+	}{
+		{
+			name: "without oidc authentication",
+			cfg:  &Config{},
+		},
+		{
+			name: "deprecated state secret",
+			cfg:  &Config{OIDCAuthentication: &OIDCAuthConfig{State: "short"}},
+		},
+		{
+			name: "login state secret too short",
+			cfg: &Config{OIDCAuthentication: &OIDCAuthConfig{
+				LoginStateSecret: &CredentialConfig{Value: strings.Repeat("a", MinLoginStateSecretLength-1)},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "valid login state secret",
+			cfg: &Config{OIDCAuthentication: &OIDCAuthConfig{
+				LoginStateSecret: &CredentialConfig{Value: strings.Repeat("a", MinLoginStateSecretLength)},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBusinessConfig(tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
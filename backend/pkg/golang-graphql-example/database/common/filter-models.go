@@ -287,6 +287,27 @@ func (d *DateFilter) GetGenericFilter() (*GenericFilter, error) {
 	return res, nil
 }
 
+// NewDateFilterFromGenericFilter will create a DateFilter with values of a GenericFilter.
+// String functions and case options aren't supported on dates and are ignored.
+func NewDateFilterFromGenericFilter(g *GenericFilter) *DateFilter {
+	return &DateFilter{
+		Eq:        g.Eq,
+		NotEq:     g.NotEq,
+		Gte:       g.Gte,
+		NotGte:    g.NotGte,
+		Gt:        g.Gt,
+		NotGt:     g.NotGt,
+		Lte:       g.Lte,
+		NotLte:    g.NotLte,
+		Lt:        g.Lt,
+		NotLt:     g.NotLt,
+		In:        g.In,
+		NotIn:     g.NotIn,
+		IsNull:    g.IsNull,
+		IsNotNull: g.IsNotNull,
+	}
+}
+
 func parseOrGetTime(x any) (*time.Time, error) {
 	// Get value in reflect mode
 	val := reflect.Indirect(reflect.ValueOf(x))
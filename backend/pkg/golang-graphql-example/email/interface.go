@@ -1,11 +1,14 @@
 package email
 
 import (
+	"context"
 	"time"
 
 	spmail "github.com/xhit/go-simple-mail/v2"
 
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	lockdistributor "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/lockdistributor/sql"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
 )
 
@@ -13,16 +16,52 @@ import (
 type Service interface {
 	// InitializeAndReload service.
 	// If configuration isn't set, the setup will be skipped.
+	// This will also (re)load email templates if their configuration is set.
 	InitializeAndReload() error
 	// Check service health.
+	// The check depends on the transport (SMTP connection, folder existence, ...).
 	// If configuration isn't set, the check will be skipped.
 	Check() error
 	// Send will send the email.
 	// If configuration isn't set, the send action will be skipped.
+	// If outbox configuration is set, the email will be saved in database and delivered later.
 	// NOTE: Connect SMTP server can take 1 second.
 	Send(em Email) error
 	// Create a new Email object.
 	NewEmail() Email
+	// NewTemplatedEmail will create a new Email object with subject and bodies
+	// rendered from the template for the closest available locale.
+	NewTemplatedEmail(name, locale string, data any) (Email, error)
+	// SendTemplate will render the template for the closest available locale and send it to recipients.
+	// If SMTP configuration isn't set, the send action will be skipped.
+	SendTemplate(ctx context.Context, name, locale string, data any, recipients []string) error
+	// RenderTemplate will render the template for the closest available locale.
+	RenderTemplate(name, locale string, data any) (*RenderedTemplate, error)
+	// ListTemplates will list all registered templates.
+	ListTemplates() []*TemplateDefinition
+	// ListCapturedEmails will list emails kept in memory by the capture transport.
+	// If capture transport isn't configured, nothing will be returned.
+	ListCapturedEmails() []*CapturedEmail
+	// ClearCapturedEmails will flush emails kept in memory by the capture transport.
+	ClearCapturedEmails()
+	// RunOutboxDelivery will deliver emails stored in outbox until context is cancelled.
+	// Only one instance will deliver emails at the same time thanks to the lock distributor.
+	// If outbox configuration isn't set, nothing will be delivered.
+	RunOutboxDelivery(ctx context.Context) error
+}
+
+//go:generate mockgen -destination=./mocks/mock_MetricsService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/email MetricsService
+type MetricsService interface {
+	// IncreaseQueuedEmail will increase counter of emails saved in outbox.
+	IncreaseQueuedEmail()
+	// IncreaseSuccessfullySentEmail will increase counter of successfully sent emails.
+	IncreaseSuccessfullySentEmail()
+	// IncreaseFailedSentEmail will increase counter of failed email sending.
+	IncreaseFailedSentEmail()
+	// IncreasePermanentlyFailedEmail will increase counter of emails that won't be retried anymore.
+	IncreasePermanentlyFailedEmail()
+	// SetEmailOutboxMessagesCount will set the number of emails stored in outbox for a status.
+	SetEmailOutboxMessagesCount(status string, count int64)
 }
 
 type Priority string
@@ -77,6 +116,19 @@ type Email interface {
 	GetEmail() *spmail.Email
 }
 
-func NewService(cfgManager config.Manager, logger log.Logger) Service {
-	return &service{cfgManager: cfgManager, logger: logger}
+func NewService(
+	cfgManager config.Manager,
+	logger log.Logger,
+	db database.DB,
+	ldSvc lockdistributor.Service,
+	metricsSvc MetricsService,
+) Service {
+	return &service{
+		cfgManager: cfgManager,
+		logger:     logger,
+		db:         db,
+		ldSvc:      ldSvc,
+		metricsSvc: metricsSvc,
+		templates:  &templateRegistry{},
+	}
 }
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/email (interfaces: MetricsService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_MetricsService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/email MetricsService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMetricsService is a mock of MetricsService interface.
+type MockMetricsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMetricsServiceMockRecorder is the mock recorder for MockMetricsService.
+type MockMetricsServiceMockRecorder struct {
+	mock *MockMetricsService
+}
+
+// NewMockMetricsService creates a new mock instance.
+func NewMockMetricsService(ctrl *gomock.Controller) *MockMetricsService {
+	mock := &MockMetricsService{ctrl: ctrl}
+	mock.recorder = &MockMetricsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetricsService) EXPECT() *MockMetricsServiceMockRecorder {
+	return m.recorder
+}
+
+// IncreaseFailedSentEmail mocks base method.
+func (m *MockMetricsService) IncreaseFailedSentEmail() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncreaseFailedSentEmail")
+}
+
+// IncreaseFailedSentEmail indicates an expected call of IncreaseFailedSentEmail.
+func (mr *MockMetricsServiceMockRecorder) IncreaseFailedSentEmail() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseFailedSentEmail", reflect.TypeOf((*MockMetricsService)(nil).IncreaseFailedSentEmail))
+}
+
+// IncreasePermanentlyFailedEmail mocks base method.
+func (m *MockMetricsService) IncreasePermanentlyFailedEmail() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncreasePermanentlyFailedEmail")
+}
+
+// IncreasePermanentlyFailedEmail indicates an expected call of IncreasePermanentlyFailedEmail.
+func (mr *MockMetricsServiceMockRecorder) IncreasePermanentlyFailedEmail() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreasePermanentlyFailedEmail", reflect.TypeOf((*MockMetricsService)(nil).IncreasePermanentlyFailedEmail))
+}
+
+// IncreaseQueuedEmail mocks base method.
+func (m *MockMetricsService) IncreaseQueuedEmail() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncreaseQueuedEmail")
+}
+
+// IncreaseQueuedEmail indicates an expected call of IncreaseQueuedEmail.
+func (mr *MockMetricsServiceMockRecorder) IncreaseQueuedEmail() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseQueuedEmail", reflect.TypeOf((*MockMetricsService)(nil).IncreaseQueuedEmail))
+}
+
+// IncreaseSuccessfullySentEmail mocks base method.
+func (m *MockMetricsService) IncreaseSuccessfullySentEmail() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncreaseSuccessfullySentEmail")
+}
+
+// IncreaseSuccessfullySentEmail indicates an expected call of IncreaseSuccessfullySentEmail.
+func (mr *MockMetricsServiceMockRecorder) IncreaseSuccessfullySentEmail() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseSuccessfullySentEmail", reflect.TypeOf((*MockMetricsService)(nil).IncreaseSuccessfullySentEmail))
+}
+
+// SetEmailOutboxMessagesCount mocks base method.
+func (m *MockMetricsService) SetEmailOutboxMessagesCount(status string, count int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetEmailOutboxMessagesCount", status, count)
+}
+
+// SetEmailOutboxMessagesCount indicates an expected call of SetEmailOutboxMessagesCount.
+func (mr *MockMetricsServiceMockRecorder) SetEmailOutboxMessagesCount(status, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmailOutboxMessagesCount", reflect.TypeOf((*MockMetricsService)(nil).SetEmailOutboxMessagesCount), status, count)
+}
@@ -10,6 +10,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	email "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/email"
@@ -54,6 +55,18 @@ func (mr *MockServiceMockRecorder) Check() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockService)(nil).Check))
 }
 
+// ClearCapturedEmails mocks base method.
+func (m *MockService) ClearCapturedEmails() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ClearCapturedEmails")
+}
+
+// ClearCapturedEmails indicates an expected call of ClearCapturedEmails.
+func (mr *MockServiceMockRecorder) ClearCapturedEmails() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCapturedEmails", reflect.TypeOf((*MockService)(nil).ClearCapturedEmails))
+}
+
 // InitializeAndReload mocks base method.
 func (m *MockService) InitializeAndReload() error {
 	m.ctrl.T.Helper()
@@ -68,6 +81,34 @@ func (mr *MockServiceMockRecorder) InitializeAndReload() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeAndReload", reflect.TypeOf((*MockService)(nil).InitializeAndReload))
 }
 
+// ListCapturedEmails mocks base method.
+func (m *MockService) ListCapturedEmails() []*email.CapturedEmail {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCapturedEmails")
+	ret0, _ := ret[0].([]*email.CapturedEmail)
+	return ret0
+}
+
+// ListCapturedEmails indicates an expected call of ListCapturedEmails.
+func (mr *MockServiceMockRecorder) ListCapturedEmails() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapturedEmails", reflect.TypeOf((*MockService)(nil).ListCapturedEmails))
+}
+
+// ListTemplates mocks base method.
+func (m *MockService) ListTemplates() []*email.TemplateDefinition {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplates")
+	ret0, _ := ret[0].([]*email.TemplateDefinition)
+	return ret0
+}
+
+// ListTemplates indicates an expected call of ListTemplates.
+func (mr *MockServiceMockRecorder) ListTemplates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockService)(nil).ListTemplates))
+}
+
 // NewEmail mocks base method.
 func (m *MockService) NewEmail() email.Email {
 	m.ctrl.T.Helper()
@@ -82,6 +123,50 @@ func (mr *MockServiceMockRecorder) NewEmail() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewEmail", reflect.TypeOf((*MockService)(nil).NewEmail))
 }
 
+// NewTemplatedEmail mocks base method.
+func (m *MockService) NewTemplatedEmail(name, locale string, data any) (email.Email, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTemplatedEmail", name, locale, data)
+	ret0, _ := ret[0].(email.Email)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewTemplatedEmail indicates an expected call of NewTemplatedEmail.
+func (mr *MockServiceMockRecorder) NewTemplatedEmail(name, locale, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTemplatedEmail", reflect.TypeOf((*MockService)(nil).NewTemplatedEmail), name, locale, data)
+}
+
+// RenderTemplate mocks base method.
+func (m *MockService) RenderTemplate(name, locale string, data any) (*email.RenderedTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderTemplate", name, locale, data)
+	ret0, _ := ret[0].(*email.RenderedTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderTemplate indicates an expected call of RenderTemplate.
+func (mr *MockServiceMockRecorder) RenderTemplate(name, locale, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderTemplate", reflect.TypeOf((*MockService)(nil).RenderTemplate), name, locale, data)
+}
+
+// RunOutboxDelivery mocks base method.
+func (m *MockService) RunOutboxDelivery(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunOutboxDelivery", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunOutboxDelivery indicates an expected call of RunOutboxDelivery.
+func (mr *MockServiceMockRecorder) RunOutboxDelivery(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunOutboxDelivery", reflect.TypeOf((*MockService)(nil).RunOutboxDelivery), ctx)
+}
+
 // Send mocks base method.
 func (m *MockService) Send(em email.Email) error {
 	m.ctrl.T.Helper()
@@ -95,3 +180,17 @@ func (mr *MockServiceMockRecorder) Send(em any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockService)(nil).Send), em)
 }
+
+// SendTemplate mocks base method.
+func (m *MockService) SendTemplate(ctx context.Context, name, locale string, data any, recipients []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTemplate", ctx, name, locale, data, recipients)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendTemplate indicates an expected call of SendTemplate.
+func (mr *MockServiceMockRecorder) SendTemplate(ctx, name, locale, data, recipients any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTemplate", reflect.TypeOf((*MockService)(nil).SendTemplate), ctx, name, locale, data, recipients)
+}
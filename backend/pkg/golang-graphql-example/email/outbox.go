@@ -0,0 +1,311 @@
+package email
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	lockdistributor "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/lockdistributor/sql"
+)
+
+const (
+	OutboxStatusPending = "PENDING"
+	OutboxStatusFailed  = "FAILED"
+	outboxLockName      = "email-outbox-delivery"
+	outboxErrorMaxSize  = 2000
+	outboxDisabledWait  = 30 * time.Second
+)
+
+// OutboxMessage represents an email saved in database waiting to be delivered.
+type OutboxMessage struct {
+	database.Base
+	NextAttemptAt time.Time `gorm:"index"`
+	From          string
+	Message       string   `gorm:"type:text"`
+	Status        string   `gorm:"type:varchar(20);index"`
+	LastError     string   `gorm:"type:varchar(2000)"`
+	Recipients    []string `gorm:"type:text;serializer:json"`
+	Attempts      int
+}
+
+// TableName will force table name.
+func (*OutboxMessage) TableName() string {
+	return "email_outbox_messages"
+}
+
+type outboxSettings struct {
+	pollInterval   time.Duration
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	maxAttempts    int
+	batchSize      int
+}
+
+func parseOutboxSettings(cfg *config.EmailOutboxConfig) (*outboxSettings, error) {
+	// Check if configuration exists
+	if cfg == nil {
+		return nil, nil //nolint: nilnil // Outbox disabled
+	}
+
+	// Parse durations
+	pollInterval, err := time.ParseDuration(cfg.PollInterval)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	initialBackoff, err := time.ParseDuration(cfg.InitialBackoff)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	maxBackoff, err := time.ParseDuration(cfg.MaxBackoff)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &outboxSettings{
+		pollInterval:   pollInterval,
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		maxAttempts:    cfg.MaxAttempts,
+		batchSize:      cfg.BatchSize,
+	}, nil
+}
+
+// Compute exponential backoff duration for a number of attempts already done.
+func (os *outboxSettings) backoff(attempts int) time.Duration {
+	// Initialize
+	res := os.initialBackoff
+
+	// Loop over attempts
+	for i := 1; i < attempts; i++ {
+		res *= 2
+		// Check if max is reached
+		if res >= os.maxBackoff {
+			return os.maxBackoff
+		}
+	}
+
+	// Check max
+	if res > os.maxBackoff {
+		return os.maxBackoff
+	}
+
+	return res
+}
+
+func (s *service) enqueue(ctx context.Context, em Email) error {
+	// Get email object
+	e := em.GetEmail()
+	// Check email error
+	if e.Error != nil {
+		return errors.WithStack(e.Error)
+	}
+
+	// Create outbox message
+	msg := &OutboxMessage{
+		From:          e.GetFrom(),
+		Recipients:    e.GetRecipients(),
+		Message:       e.GetMessage(),
+		Status:        OutboxStatusPending,
+		NextAttemptAt: time.Now(),
+	}
+
+	// Save it
+	res := s.db.GetTransactionalOrDefaultGormDB(ctx).Create(msg)
+	// Check error
+	if res.Error != nil {
+		return errors.WithStack(res.Error)
+	}
+
+	// Metrics
+	s.metricsSvc.IncreaseQueuedEmail()
+
+	return nil
+}
+
+func (s *service) RunOutboxDelivery(ctx context.Context) error {
+	for {
+		// Get runtime objects
+		tr, settings := s.getRuntime()
+		// Initialize wait duration
+		wait := outboxDisabledWait
+		// Check if outbox is enabled
+		if settings != nil {
+			wait = settings.pollInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+
+		// Check if outbox is disabled or if transport isn't configured
+		if settings == nil || tr == nil {
+			continue
+		}
+
+		// Deliver
+		err := s.deliverOutboxBatch(ctx, tr, settings)
+		// Check error
+		if err != nil {
+			// Only log, this will be retried on next tick
+			s.logger.Error(err)
+		}
+	}
+}
+
+func (s *service) deliverOutboxBatch(ctx context.Context, tr transport, settings *outboxSettings) (err error) {
+	// Get lock
+	l := s.ldSvc.GetLock(outboxLockName)
+
+	// Check if lock is already taken by another instance
+	taken, err := l.IsAlreadyTaken()
+	// Check error
+	if err != nil {
+		return err
+	}
+	// Check if taken
+	if taken {
+		s.logger.Debug("Email outbox delivery lock already taken, skipping")
+
+		return nil
+	}
+
+	// Acquire
+	err = l.AcquireWithContext(ctx)
+	// Check error
+	if err != nil {
+		// Check if another instance took it in the meantime
+		if errors.Is(err, lockdistributor.ErrLockNotAcquired) {
+			return nil
+		}
+
+		return err
+	}
+	// Release lock at the end
+	defer func() {
+		err2 := l.Release()
+		// Check error
+		if err2 != nil && err == nil {
+			err = err2
+		}
+	}()
+
+	// Get gorm db
+	gdb := s.db.GetGormDB().WithContext(ctx)
+
+	// Find pending messages
+	var list []*OutboxMessage
+
+	res := gdb.
+		Where("status = ? AND next_attempt_at <= ?", OutboxStatusPending, time.Now()).
+		Order("next_attempt_at ASC").
+		Limit(settings.batchSize).
+		Find(&list)
+	// Check error
+	if res.Error != nil {
+		return errors.WithStack(res.Error)
+	}
+
+	// Loop over messages
+	for _, msg := range list {
+		// Deliver message
+		err = s.deliverOutboxMessage(ctx, tr, settings, msg)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Refresh metrics
+	return s.refreshOutboxMetrics(ctx)
+}
+
+func (s *service) deliverOutboxMessage(
+	ctx context.Context,
+	tr transport,
+	settings *outboxSettings,
+	msg *OutboxMessage,
+) error {
+	// Get gorm db
+	gdb := s.db.GetGormDB().WithContext(ctx)
+
+	// Send message
+	sendErr := tr.Send(msg.From, msg.Recipients, msg.Message)
+	// Check if it is a success
+	if sendErr == nil {
+		// Message delivered, remove it from outbox
+		res := gdb.Unscoped().Delete(msg)
+		// Check error
+		if res.Error != nil {
+			return errors.WithStack(res.Error)
+		}
+
+		// Metrics
+		s.metricsSvc.IncreaseSuccessfullySentEmail()
+
+		return nil
+	}
+
+	// Log
+	s.logger.WithError(sendErr).Warnf("Email outbox message %s delivery failed", msg.ID)
+
+	// Update message
+	msg.Attempts++
+	msg.LastError = sendErr.Error()
+	// Truncate error to column size
+	if len(msg.LastError) > outboxErrorMaxSize {
+		msg.LastError = msg.LastError[:outboxErrorMaxSize]
+	}
+
+	// Check if maximum attempts is reached
+	if msg.Attempts >= settings.maxAttempts {
+		// Mark as permanently failed and keep it for inspection
+		msg.Status = OutboxStatusFailed
+		// Metrics
+		s.metricsSvc.IncreasePermanentlyFailedEmail()
+	} else {
+		// Compute next attempt with exponential backoff
+		msg.NextAttemptAt = time.Now().Add(settings.backoff(msg.Attempts))
+		// Metrics
+		s.metricsSvc.IncreaseFailedSentEmail()
+	}
+
+	// Save
+	res := gdb.Save(msg)
+	// Check error
+	if res.Error != nil {
+		return errors.WithStack(res.Error)
+	}
+
+	return nil
+}
+
+func (s *service) refreshOutboxMetrics(ctx context.Context) error {
+	// Get gorm db
+	gdb := s.db.GetGormDB().WithContext(ctx)
+
+	// Loop over status
+	for _, status := range []string{OutboxStatusPending, OutboxStatusFailed} {
+		var count int64
+		// Count
+		res := gdb.Model(&OutboxMessage{}).Where("status = ?", status).Count(&count)
+		// Check error
+		if res.Error != nil {
+			return errors.WithStack(res.Error)
+		}
+
+		// Save
+		s.metricsSvc.SetEmailOutboxMessagesCount(status, count)
+	}
+
+	return nil
+}
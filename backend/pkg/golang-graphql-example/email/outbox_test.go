@@ -0,0 +1,83 @@
+//go:build unit
+
+package email
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+func Test_parseOutboxSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *config.EmailOutboxConfig
+		want    *outboxSettings
+		wantErr bool
+	}{
+		{
+			name: "nil configuration",
+		},
+		{
+			name: "valid configuration",
+			cfg: &config.EmailOutboxConfig{
+				PollInterval:   "5s",
+				InitialBackoff: "30s",
+				MaxBackoff:     "1h",
+				MaxAttempts:    10,
+				BatchSize:      50,
+			},
+			want: &outboxSettings{
+				pollInterval:   5 * time.Second,
+				initialBackoff: 30 * time.Second,
+				maxBackoff:     time.Hour,
+				maxAttempts:    10,
+				batchSize:      50,
+			},
+		},
+		{
+			name: "invalid duration",
+			cfg: &config.EmailOutboxConfig{
+				PollInterval:   "fake",
+				InitialBackoff: "30s",
+				MaxBackoff:     "1h",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOutboxSettings(tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_outboxSettings_backoff(t *testing.T) {
+	os := &outboxSettings{initialBackoff: 30 * time.Second, maxBackoff: 5 * time.Minute}
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: 30 * time.Second},
+		{attempts: 2, want: time.Minute},
+		{attempts: 3, want: 2 * time.Minute},
+		{attempts: 4, want: 4 * time.Minute},
+		{attempts: 5, want: 5 * time.Minute},
+		{attempts: 100, want: 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, os.backoff(tt.attempts), "attempts %d", tt.attempts)
+	}
+}
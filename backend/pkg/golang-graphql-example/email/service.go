@@ -1,7 +1,8 @@
 package email
 
 import (
-	"crypto/tls"
+	"context"
+	"sync"
 	"time"
 
 	"emperror.dev/errors"
@@ -9,6 +10,8 @@ import (
 	spmail "github.com/xhit/go-simple-mail/v2"
 
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	lockdistributor "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/lockdistributor/sql"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
 )
 
@@ -20,12 +23,23 @@ const (
 	CfgAuthenticationLogin   = "LOGIN"
 	CfgAuthenticationCRAMMD5 = "CRAM-MD5"
 	CfgDefaultTimeout        = 10 * time.Second
+	CfgTransportSMTP         = "SMTP"
+	CfgTransportFile         = "FILE"
+	CfgTransportCapture      = "CAPTURE"
+	CfgFileFormatEML         = "EML"
+	CfgFileFormatMBOX        = "MBOX"
 )
 
 type service struct {
 	logger     log.Logger
 	cfgManager config.Manager
-	server     *spmail.SMTPServer
+	db         database.DB
+	ldSvc      lockdistributor.Service
+	metricsSvc MetricsService
+	transport  transport
+	outbox     *outboxSettings
+	templates  *templateRegistry
+	mutex      sync.RWMutex
 }
 
 func (*service) NewEmail() Email {
@@ -36,160 +50,184 @@ func (*service) NewEmail() Email {
 }
 
 func (s *service) InitializeAndReload() error {
-	// Get configuration
-	cfg := s.cfgManager.GetConfig().SMTP
-
-	// Check if configuration exists
-	// If it is equal to nil, skip connect
-	if cfg == nil {
-		s.logger.Info("SMTP configuration not present, server creation skipped")
+	// Get email templates configuration
+	tplCfg := s.cfgManager.GetConfig().EmailTemplates
+	// Load templates
+	err := s.templates.Load(tplCfg)
+	// Check error
+	if err != nil {
+		return err
+	}
 
-		// Force flush created service
-		// In case of reload done with previously created service
-		s.server = nil
+	// Check if templates configuration exists to log
+	if tplCfg != nil {
+		s.logger.Infof("Email templates loaded from %s", tplCfg.FolderPath)
+	}
 
-		return nil
+	// Parse outbox configuration
+	outbox, err := parseOutboxSettings(s.cfgManager.GetConfig().EmailOutbox)
+	// Check error
+	if err != nil {
+		return err
+	}
+	// Create transport
+	tr, err := s.newTransport()
+	// Check error
+	if err != nil {
+		return err
 	}
 
-	s.logger.Debug("Trying to create SMTP server")
-
-	// Create SMTP client server
-	server := spmail.NewSMTPClient()
-
-	// SMTP Server configuration
-	server.Host = cfg.Host
-	server.Port = cfg.Port
-
-	// Configure username
-	if cfg.Username != nil {
-		server.Username = cfg.Username.Value
-	}
-	// Configure password
-	if cfg.Password != nil {
-		server.Password = cfg.Password.Value
-	}
-
-	// Encryption (default value is TLS)
-	switch cfg.Encryption {
-	case CfgEncryptionTLS:
-		server.Encryption = spmail.EncryptionTLS
-	case CfgEncryptionNone:
-		server.Encryption = spmail.EncryptionNone
-	case CfgEncryptionSSL:
-		server.Encryption = spmail.EncryptionSSL
-	default:
-		server.Encryption = spmail.EncryptionTLS
-	}
-
-	// Put authentication only if username and password exists
-	if cfg.Username != nil && cfg.Password != nil {
-		// Authentication type
-		switch cfg.AuthenticationType {
-		case CfgAuthenticationPlain:
-			server.Authentication = spmail.AuthPlain
-		case CfgAuthenticationLogin:
-			server.Authentication = spmail.AuthLogin
-		case CfgAuthenticationCRAMMD5:
-			server.Authentication = spmail.AuthCRAMMD5
-		default:
-			server.Authentication = spmail.AuthPlain
-		}
-	}
-
-	// Variable to keep alive connection
-	server.KeepAlive = cfg.KeepAlive
-
-	// Check if connect timeout isn't set
-	if cfg.ConnectTimeout == "" {
-		// Timeout for connect to SMTP Server
-		server.ConnectTimeout = CfgDefaultTimeout
-	} else {
-		// Parse connect timeout duration
-		connectTimeoutDur, err := time.ParseDuration(cfg.ConnectTimeout)
-		// Check error
-		if err != nil {
-			return errors.WithStack(err)
-		}
-		// Timeout for connect to SMTP Server
-		server.ConnectTimeout = connectTimeoutDur
-	}
-
-	// Check if send timeout isn't set
-	if cfg.SendTimeout == "" {
-		// Timeout for send the data and wait respond
-		server.SendTimeout = CfgDefaultTimeout
-	} else {
-		// Parse send timeout duration
-		sendTimeoutDur, err := time.ParseDuration(cfg.SendTimeout)
-		// Check error
-		if err != nil {
-			return errors.WithStack(err)
-		}
-		// Timeout for send the data and wait respond
-		server.SendTimeout = sendTimeoutDur
-	}
-
-	// Check if skip tls verify exists and is set
-	if cfg.TLSSkipVerify {
-		// Set TLSConfig to provide custom TLS configuration. For example,
-		// to skip TLS verification (useful for testing):
-		server.TLSConfig = &tls.Config{InsecureSkipVerify: true} //nolint: gosec // TLS Skip wanted
-	}
-
-	// Save SMTP server
-	s.server = server
-
-	s.logger.Info("SMTP server created")
+	// Save
+	// Outbox delivery daemon is reading them concurrently
+	s.mutex.Lock()
+	s.outbox = outbox
+	s.transport = tr
+	s.mutex.Unlock()
 
 	return nil
 }
 
+func (s *service) getRuntime() (transport, *outboxSettings) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.transport, s.outbox
+}
+
 func (s *service) Check() error {
-	// Check if server exists, if not, skip send
-	if s.server == nil {
+	// Get transport
+	tr, _ := s.getRuntime()
+	// Check if transport exists, if not, skip check
+	if tr == nil {
 		return nil
 	}
 
-	// Connect server
-	client, err := s.server.Connect()
-	// Check error
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	// Defer close client
-	defer client.Close()
-
-	return nil
+	return tr.Check()
 }
 
 func (s *service) Send(em Email) error {
-	// Check if server exists, if not, skip send
-	if s.server == nil {
+	return s.send(context.Background(), em)
+}
+
+func (s *service) send(ctx context.Context, em Email) error {
+	// Get runtime objects
+	tr, outbox := s.getRuntime()
+	// Check if transport exists, if not, skip send
+	if tr == nil {
 		s.logger.Debug(
-			"SMTP server not present (because configuration wasn't present probably), send skipped",
+			"Email transport not present (because configuration wasn't present probably), send skipped",
 		)
 
 		return nil
 	}
 
-	// Connect server
-	client, err := s.server.Connect()
-	// Check error
-	if err != nil {
-		return errors.WithStack(err)
+	// Check if outbox is enabled
+	if outbox != nil {
+		// Enqueue email, it will be delivered by the outbox daemon
+		return s.enqueue(ctx, em)
 	}
-	// Defer close client
-	defer client.Close()
 
 	// Get email object
 	e := em.GetEmail()
-	// Send email
-	err = e.Send(client)
+	// Check email error
+	if e.Error != nil {
+		return errors.WithStack(e.Error)
+	}
+
+	// Deliver
+	err := tr.Send(e.GetFrom(), e.GetRecipients(), e.GetMessage())
 	// Check error
 	if err != nil {
-		return errors.WithStack(err)
+		s.metricsSvc.IncreaseFailedSentEmail()
+
+		return err
 	}
 
+	// Metrics
+	s.metricsSvc.IncreaseSuccessfullySentEmail()
+
 	// Default
 	return nil
 }
+
+func (s *service) NewTemplatedEmail(name, locale string, data any) (Email, error) {
+	// Render template
+	rendered, err := s.templates.Render(name, locale, data)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Create email
+	em := s.NewEmail()
+
+	// Check if default from is set
+	if tplCfg := s.cfgManager.GetConfig().EmailTemplates; tplCfg != nil && tplCfg.From != "" {
+		em.SetFrom(tplCfg.From)
+	}
+
+	// Set subject
+	em.SetSubject(rendered.Subject)
+
+	// Set text body first to have it as first alternative
+	if rendered.TextBody != "" {
+		em.SetTextBody(rendered.TextBody)
+	}
+
+	// Set html body
+	if rendered.HTMLBody != "" {
+		em.SetHTMLBody(rendered.HTMLBody)
+	}
+
+	return em, nil
+}
+
+func (s *service) SendTemplate(
+	ctx context.Context,
+	name, locale string,
+	data any,
+	recipients []string,
+) error {
+	// Get logger
+	logger := log.GetLoggerFromContext(ctx)
+
+	// Create email
+	em, err := s.NewTemplatedEmail(name, locale, data)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Add recipients
+	em.AddTo(recipients...)
+
+	logger.Debugf("Sending email template %s to %d recipient(s)", name, len(recipients))
+
+	return s.send(ctx, em)
+}
+
+func (s *service) ListCapturedEmails() []*CapturedEmail {
+	// Check if transport is a capture one
+	ct := s.getCaptureTransport()
+	if ct == nil {
+		return nil
+	}
+
+	return ct.List()
+}
+
+func (s *service) ClearCapturedEmails() {
+	// Check if transport is a capture one
+	ct := s.getCaptureTransport()
+	if ct != nil {
+		ct.Clear()
+	}
+}
+
+func (s *service) RenderTemplate(name, locale string, data any) (*RenderedTemplate, error) {
+	return s.templates.Render(name, locale, data)
+}
+
+func (s *service) ListTemplates() []*TemplateDefinition {
+	return s.templates.List()
+}
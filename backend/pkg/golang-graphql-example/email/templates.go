@@ -0,0 +1,412 @@
+package email
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+
+	"emperror.dev/errors"
+	"github.com/aymerick/douceur/inliner"
+	"github.com/samber/lo"
+
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+const (
+	layoutsFolderName       = "layouts"
+	htmlTemplateFileSuffix  = ".html.tmpl"
+	textTemplateFileSuffix  = ".txt.tmpl"
+	subjectTemplateName     = "subject"
+	sampleDataFileName      = "sample.json"
+	localeSeparators        = "-_"
+	templateNotFoundMessage = "email template %s not found for locale %s"
+)
+
+// TemplateDefinition represents a registered email template.
+type TemplateDefinition struct {
+	SampleData any      `json:"sampleData,omitempty"`
+	Name       string   `json:"name"`
+	Locales    []string `json:"locales"`
+}
+
+// RenderedTemplate represents the result of an email template rendering.
+type RenderedTemplate struct {
+	Name     string `json:"name"`
+	Locale   string `json:"locale"`
+	Subject  string `json:"subject"`
+	HTMLBody string `json:"htmlBody"`
+	TextBody string `json:"textBody"`
+}
+
+type loadedTemplate struct {
+	sampleData any
+	locales    map[string]*localizedTemplate
+}
+
+type localizedTemplate struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+type templateRegistry struct {
+	cfg       *config.EmailTemplatesConfig
+	templates map[string]*loadedTemplate
+	mutex     sync.RWMutex
+}
+
+// Load will (re)load all templates from configuration.
+// If configuration isn't set, registry will be flushed.
+func (tr *templateRegistry) Load(cfg *config.EmailTemplatesConfig) error {
+	// Check if configuration exists
+	if cfg == nil {
+		// Flush
+		tr.mutex.Lock()
+		tr.cfg = nil
+		tr.templates = nil
+		tr.mutex.Unlock()
+
+		return nil
+	}
+
+	// Get layouts
+	htmlLayouts, textLayouts, err := listLayouts(filepath.Join(cfg.FolderPath, layoutsFolderName))
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Read main folder
+	entries, err := os.ReadDir(cfg.FolderPath)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Initialize result
+	res := map[string]*loadedTemplate{}
+
+	// Loop over entries
+	for _, entry := range entries {
+		// Ignore files, hidden folders and layouts folder
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || entry.Name() == layoutsFolderName {
+			continue
+		}
+
+		// Load localized templates
+		lts, err := loadLocalizedTemplates(
+			filepath.Join(cfg.FolderPath, entry.Name()),
+			htmlLayouts,
+			textLayouts,
+		)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Ignore empty folders
+		if len(lts) == 0 {
+			continue
+		}
+
+		// Load sample data
+		sampleData, err := loadSampleData(filepath.Join(cfg.FolderPath, entry.Name(), sampleDataFileName))
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Save
+		res[entry.Name()] = &loadedTemplate{sampleData: sampleData, locales: lts}
+	}
+
+	// Save
+	tr.mutex.Lock()
+	tr.cfg = cfg
+	tr.templates = res
+	tr.mutex.Unlock()
+
+	return nil
+}
+
+// List will list all registered templates sorted by name.
+func (tr *templateRegistry) List() []*TemplateDefinition {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	// Initialize result
+	res := make([]*TemplateDefinition, 0, len(tr.templates))
+
+	// Loop over templates
+	for name, lt := range tr.templates {
+		// Get locales
+		locales := lo.Keys(lt.locales)
+		// Sort them
+		slices.Sort(locales)
+		// Save
+		res = append(res, &TemplateDefinition{Name: name, Locales: locales, SampleData: lt.sampleData})
+	}
+
+	// Sort by name
+	slices.SortFunc(res, func(a, b *TemplateDefinition) int { return strings.Compare(a.Name, b.Name) })
+
+	return res
+}
+
+// Render will render a template by name for the closest available locale.
+func (tr *templateRegistry) Render(name, locale string, data any) (*RenderedTemplate, error) {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	// Check if registry is loaded
+	if tr.cfg == nil {
+		return nil, errors.New("email templates configuration not present, render not possible")
+	}
+
+	// Get templates for name
+	var lts map[string]*localizedTemplate
+	if lt := tr.templates[name]; lt != nil {
+		lts = lt.locales
+	}
+	// Resolve locale
+	resolvedLocale, found := resolveLocale(lts, locale, tr.cfg.DefaultLocale)
+	// Check if it hasn't been found
+	if !found {
+		return nil, cerrors.NewNotFoundError(fmt.Sprintf(templateNotFoundMessage, name, locale))
+	}
+
+	// Get localized template
+	lt := lts[resolvedLocale]
+
+	// Initialize result
+	res := &RenderedTemplate{Name: name, Locale: resolvedLocale}
+
+	// Check if html template exists
+	if lt.html != nil {
+		// Execute
+		buf := &bytes.Buffer{}
+
+		err := lt.html.Execute(buf, data)
+		// Check error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		res.HTMLBody = buf.String()
+
+		// Check if css must be inlined
+		if tr.cfg.InlineCSS {
+			res.HTMLBody, err = inliner.Inline(res.HTMLBody)
+			// Check error
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+	}
+
+	// Check if text template exists
+	if lt.text != nil {
+		// Execute
+		buf := &bytes.Buffer{}
+
+		err := lt.text.Execute(buf, data)
+		// Check error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		res.TextBody = buf.String()
+	}
+
+	// Render subject
+	subject, err := lt.renderSubject(data)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Save
+	res.Subject = subject
+
+	return res, nil
+}
+
+func (lt *localizedTemplate) renderSubject(data any) (string, error) {
+	buf := &bytes.Buffer{}
+
+	// Text template is preferred because it won't escape anything
+	if lt.text != nil && lt.text.Lookup(subjectTemplateName) != nil {
+		err := lt.text.ExecuteTemplate(buf, subjectTemplateName, data)
+		// Check error
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+
+		return strings.TrimSpace(buf.String()), nil
+	}
+
+	// Otherwise, try html template
+	if lt.html != nil && lt.html.Lookup(subjectTemplateName) != nil {
+		err := lt.html.ExecuteTemplate(buf, subjectTemplateName, data)
+		// Check error
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+
+		// Subject header isn't html, remove escaping
+		return strings.TrimSpace(html.UnescapeString(buf.String())), nil
+	}
+
+	// Default
+	return "", nil
+}
+
+// Resolve locale by trying the exact one, then the base language and finally the default locale.
+func resolveLocale(lts map[string]*localizedTemplate, locale, defaultLocale string) (string, bool) {
+	// Build candidates
+	candidates := []string{locale}
+	// Add base language
+	if idx := strings.IndexAny(locale, localeSeparators); idx > 0 {
+		candidates = append(candidates, locale[:idx])
+	}
+	// Add default locale
+	candidates = append(candidates, defaultLocale)
+
+	return lo.Find(candidates, func(it string) bool {
+		_, ok := lts[it]
+
+		return ok
+	})
+}
+
+// Load optional sample data used for previews.
+func loadSampleData(fp string) (any, error) {
+	// Read file
+	b, err := os.ReadFile(fp)
+	// Check error
+	if err != nil {
+		// Sample data are optional
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	// Parse
+	var res any
+
+	err = json.Unmarshal(b, &res)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return res, nil
+}
+
+func listLayouts(folderPath string) (htmlLayouts, textLayouts []string, err error) {
+	// Read layouts folder
+	entries, err := os.ReadDir(folderPath)
+	// Check error
+	if err != nil {
+		// Layouts are optional
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+
+		return nil, nil, errors.WithStack(err)
+	}
+
+	// Loop over entries
+	for _, entry := range entries {
+		// Ignore folders
+		if entry.IsDir() {
+			continue
+		}
+
+		fp := filepath.Join(folderPath, entry.Name())
+
+		switch {
+		case strings.HasSuffix(entry.Name(), htmlTemplateFileSuffix):
+			htmlLayouts = append(htmlLayouts, fp)
+		case strings.HasSuffix(entry.Name(), textTemplateFileSuffix):
+			textLayouts = append(textLayouts, fp)
+		}
+	}
+
+	return htmlLayouts, textLayouts, nil
+}
+
+func loadLocalizedTemplates(
+	folderPath string,
+	htmlLayouts, textLayouts []string,
+) (map[string]*localizedTemplate, error) {
+	// Read template folder
+	entries, err := os.ReadDir(folderPath)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Initialize result
+	res := map[string]*localizedTemplate{}
+
+	// Loop over entries
+	for _, entry := range entries {
+		// Ignore folders
+		if entry.IsDir() {
+			continue
+		}
+
+		filename := entry.Name()
+		fp := filepath.Join(folderPath, filename)
+
+		switch {
+		case strings.HasSuffix(filename, htmlTemplateFileSuffix):
+			// Parse layouts first to allow template to override layout blocks
+			t, err := htmltemplate.New(filename).ParseFiles(append(slices.Clone(htmlLayouts), fp)...)
+			// Check error
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+
+			// Get or create localized template
+			lt := getOrCreateLocalizedTemplate(res, strings.TrimSuffix(filename, htmlTemplateFileSuffix))
+			// Save
+			lt.html = t
+		case strings.HasSuffix(filename, textTemplateFileSuffix):
+			// Parse layouts first to allow template to override layout blocks
+			t, err := texttemplate.New(filename).ParseFiles(append(slices.Clone(textLayouts), fp)...)
+			// Check error
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+
+			// Get or create localized template
+			lt := getOrCreateLocalizedTemplate(res, strings.TrimSuffix(filename, textTemplateFileSuffix))
+			// Save
+			lt.text = t
+		}
+	}
+
+	return res, nil
+}
+
+func getOrCreateLocalizedTemplate(m map[string]*localizedTemplate, locale string) *localizedTemplate {
+	// Check if it exists
+	lt, ok := m[locale]
+	if !ok {
+		lt = &localizedTemplate{}
+		m[locale] = lt
+	}
+
+	return lt
+}
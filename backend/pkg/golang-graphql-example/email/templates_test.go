@@ -0,0 +1,156 @@
+//go:build unit
+
+package email
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+func writeTemplateFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for k, v := range files {
+		fp := filepath.Join(dir, k)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(fp), 0o755))
+		require.NoError(t, os.WriteFile(fp, []byte(v), 0o600))
+	}
+
+	return dir
+}
+
+func Test_templateRegistry_Render(t *testing.T) {
+	files := map[string]string{
+		"layouts/base.html.tmpl": `<html><head><style>p { color: red; }</style></head><body>{{ block "content" . }}{{ end }}</body></html>`,
+		"layouts/base.txt.tmpl":  `{{ block "content" . }}{{ end }} --`,
+		"welcome/en.html.tmpl":   `{{ template "base.html.tmpl" . }}{{ define "content" }}<p>Hello {{ .Name }}</p>{{ end }}`,
+		"welcome/en.txt.tmpl":    `{{ template "base.txt.tmpl" . }}{{ define "subject" }}Welcome {{ .Name }} & co{{ end }}{{ define "content" }}Hello {{ .Name }}{{ end }}`,
+		"welcome/fr.html.tmpl":   `{{ define "subject" }}Bienvenue {{ .Name }} & co{{ end }}<p>Bonjour {{ .Name }}</p>`,
+		"empty/.keep":            ``,
+	}
+
+	tests := []struct {
+		name      string
+		cfg       *config.EmailTemplatesConfig
+		tplName   string
+		locale    string
+		want      *RenderedTemplate
+		wantErr   bool
+		errString string
+	}{
+		{
+			name:    "exact locale with layouts",
+			cfg:     &config.EmailTemplatesConfig{DefaultLocale: "en"},
+			tplName: "welcome",
+			locale:  "en",
+			want: &RenderedTemplate{
+				Name:     "welcome",
+				Locale:   "en",
+				Subject:  "Welcome <b> & co",
+				HTMLBody: "<html><head><style>p { color: red; }</style></head><body><p>Hello &lt;b&gt;</p></body></html>",
+				TextBody: "Hello <b> --",
+			},
+		},
+		{
+			name:    "base language fallback with html subject",
+			cfg:     &config.EmailTemplatesConfig{DefaultLocale: "en"},
+			tplName: "welcome",
+			locale:  "fr-CA",
+			want: &RenderedTemplate{
+				Name:     "welcome",
+				Locale:   "fr",
+				Subject:  "Bienvenue <b> & co",
+				HTMLBody: "<p>Bonjour &lt;b&gt;</p>",
+			},
+		},
+		{
+			name:    "default locale fallback",
+			cfg:     &config.EmailTemplatesConfig{DefaultLocale: "en"},
+			tplName: "welcome",
+			locale:  "de_DE",
+			want: &RenderedTemplate{
+				Name:     "welcome",
+				Locale:   "en",
+				Subject:  "Welcome <b> & co",
+				HTMLBody: "<html><head><style>p { color: red; }</style></head><body><p>Hello &lt;b&gt;</p></body></html>",
+				TextBody: "Hello <b> --",
+			},
+		},
+		{
+			name:    "css inlined",
+			cfg:     &config.EmailTemplatesConfig{DefaultLocale: "en", InlineCSS: true},
+			tplName: "welcome",
+			locale:  "en",
+			want: &RenderedTemplate{
+				Name:     "welcome",
+				Locale:   "en",
+				Subject:  "Welcome <b> & co",
+				HTMLBody: "<html><head></head><body><p style=\"color: red;\">Hello &lt;b&gt;</p></body></html>",
+				TextBody: "Hello <b> --",
+			},
+		},
+		{
+			name:      "not found template",
+			cfg:       &config.EmailTemplatesConfig{DefaultLocale: "en"},
+			tplName:   "empty",
+			locale:    "en",
+			wantErr:   true,
+			errString: "email template empty not found for locale en",
+		},
+		{
+			name:      "registry without configuration",
+			tplName:   "welcome",
+			locale:    "en",
+			wantErr:   true,
+			errString: "email templates configuration not present, render not possible",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &templateRegistry{}
+
+			if tt.cfg != nil {
+				tt.cfg.FolderPath = writeTemplateFiles(t, files)
+			}
+
+			require.NoError(t, tr.Load(tt.cfg))
+
+			got, err := tr.Render(tt.tplName, tt.locale, map[string]string{"Name": "<b>"})
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errString)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_templateRegistry_List(t *testing.T) {
+	dir := writeTemplateFiles(t, map[string]string{
+		"welcome/fr.txt.tmpl":        `Bonjour`,
+		"welcome/en.txt.tmpl":        `Hello`,
+		"reset-password/en.txt.tmpl": `Reset`,
+		"layouts/base.txt.tmpl":      `{{ block "content" . }}{{ end }}`,
+	})
+
+	tr := &templateRegistry{}
+
+	require.NoError(t, tr.Load(&config.EmailTemplatesConfig{FolderPath: dir, DefaultLocale: "en"}))
+
+	assert.Equal(t, []*TemplateDefinition{
+		{Name: "reset-password", Locales: []string{"en"}},
+		{Name: "welcome", Locales: []string{"en", "fr"}},
+	}, tr.List())
+}
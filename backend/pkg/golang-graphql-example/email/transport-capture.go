@@ -0,0 +1,80 @@
+package email
+
+import (
+	"mime"
+	"net/mail"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// CapturedEmail represents an email kept in memory by the capture transport.
+type CapturedEmail struct {
+	Date       time.Time `json:"date"`
+	From       string    `json:"from"`
+	Subject    string    `json:"subject"`
+	Message    string    `json:"message"`
+	Recipients []string  `json:"recipients"`
+}
+
+type captureTransport struct {
+	emails []*CapturedEmail
+	mutex  sync.RWMutex
+}
+
+func (ct *captureTransport) Send(from string, recipients []string, msg string) error {
+	// Check recipients like a SMTP server would do
+	if len(recipients) == 0 {
+		return errors.New("no recipient specified")
+	}
+
+	// Create captured email
+	ce := &CapturedEmail{
+		Date:       time.Now(),
+		From:       from,
+		Message:    msg,
+		Recipients: slices.Clone(recipients),
+	}
+
+	// Parse message to extract subject
+	m, err := mail.ReadMessage(strings.NewReader(msg))
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// Decode subject
+	ce.Subject, err = (&mime.WordDecoder{}).DecodeHeader(m.Header.Get("Subject"))
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Save
+	ct.mutex.Lock()
+	ct.emails = append(ct.emails, ce)
+	ct.mutex.Unlock()
+
+	return nil
+}
+
+func (*captureTransport) Check() error {
+	return nil
+}
+
+// List will return a copy of captured emails.
+func (ct *captureTransport) List() []*CapturedEmail {
+	ct.mutex.RLock()
+	defer ct.mutex.RUnlock()
+
+	return slices.Clone(ct.emails)
+}
+
+// Clear will flush captured emails.
+func (ct *captureTransport) Clear() {
+	ct.mutex.Lock()
+	ct.emails = nil
+	ct.mutex.Unlock()
+}
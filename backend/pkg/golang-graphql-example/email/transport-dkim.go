@@ -0,0 +1,91 @@
+package email
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"slices"
+
+	"emperror.dev/errors"
+	"github.com/toorop/go-dkim"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+// dkimTransport will sign messages before delivering them with the wrapped transport.
+type dkimTransport struct {
+	next    transport
+	options dkim.SigOptions
+}
+
+func newDKIMTransport(next transport, cfg *config.SMTPDKIMConfig) (*dkimTransport, error) {
+	// Get private key
+	pk := []byte(cfg.PrivateKey.Value)
+	// Validate private key now to avoid failing on each send
+	err := validateDKIMPrivateKey(pk)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Create options
+	opts := dkim.NewSigOptions()
+	opts.PrivateKey = pk
+	opts.Domain = cfg.Domain
+	opts.Selector = cfg.Selector
+	opts.Canonicalization = cfg.Canonicalization
+	opts.Headers = cfg.Headers
+
+	return &dkimTransport{next: next, options: opts}, nil
+}
+
+func (dt *dkimTransport) Send(from string, recipients []string, msg string) error {
+	// Copy options as signature will modify headers list
+	opts := dt.options
+	opts.Headers = slices.Clone(dt.options.Headers)
+
+	// Sign message (headers and full body including attachments)
+	b := []byte(msg)
+
+	err := dkim.Sign(&b, opts)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return dt.next.Send(from, recipients, string(b))
+}
+
+func (dt *dkimTransport) Check() error {
+	return dt.next.Check()
+}
+
+func validateDKIMPrivateKey(pk []byte) error {
+	// Decode pem
+	d, _ := pem.Decode(pk)
+	// Check if it has been decoded
+	if d == nil {
+		return errors.New("dkim private key must be a pem encoded rsa private key")
+	}
+
+	// Try PKCS1
+	_, err := x509.ParsePKCS1PrivateKey(d.Bytes)
+	// Check if it is a PKCS1 key
+	if err == nil {
+		return nil
+	}
+
+	// Try PKCS8
+	key, err := x509.ParsePKCS8PrivateKey(d.Bytes)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Check key type
+	if _, ok := key.(*rsa.PrivateKey); !ok {
+		return errors.New("dkim private key must be a rsa private key")
+	}
+
+	return nil
+}
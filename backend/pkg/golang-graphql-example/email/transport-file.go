@@ -0,0 +1,165 @@
+package email
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gofrs/uuid"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+const (
+	emlFileExtension = ".eml"
+	mboxDateFormat   = "Mon Jan _2 15:04:05 2006"
+	filePermissions  = 0o600
+	dirPermissions   = 0o750
+)
+
+type fileTransport struct {
+	cfg   *config.EmailFileTransportConfig
+	mutex sync.Mutex
+}
+
+func newFileTransport(cfg *config.EmailFileTransportConfig) (*fileTransport, error) {
+	ft := &fileTransport{cfg: cfg}
+
+	// Create output directory to be healthy before first email
+	err := os.MkdirAll(ft.getDir(), dirPermissions)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ft, nil
+}
+
+func (ft *fileTransport) Send(from string, recipients []string, msg string) error {
+	// Check recipients like a SMTP server would do
+	if len(recipients) == 0 {
+		return errors.New("no recipient specified")
+	}
+
+	// Check format
+	if ft.cfg.Format == CfgFileFormatMBOX {
+		return ft.appendToMbox(from, msg)
+	}
+
+	return ft.writeEml(msg)
+}
+
+func (ft *fileTransport) writeEml(msg string) error {
+	// Ensure directory exists in case it has been removed
+	err := os.MkdirAll(ft.getDir(), dirPermissions)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Generate id to avoid any collision
+	id, err := uuid.NewV7()
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Build file path, time prefix allow to sort them easily
+	fp := filepath.Join(
+		ft.cfg.Path,
+		fmt.Sprintf("%s-%s%s", time.Now().UTC().Format("20060102T150405"), id.String(), emlFileExtension),
+	)
+
+	// Write file
+	err = os.WriteFile(fp, []byte(msg), filePermissions)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (ft *fileTransport) appendToMbox(from, msg string) error {
+	// Ensure parent directory exists in case it has been removed
+	err := os.MkdirAll(ft.getDir(), dirPermissions)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Build mbox entry
+	sb := strings.Builder{}
+	// Separator line
+	sb.WriteString(fmt.Sprintf("From %s %s\n", from, time.Now().UTC().Format(mboxDateFormat)))
+
+	// Mbox is using unix line endings
+	lines := strings.Split(strings.ReplaceAll(msg, "\r\n", "\n"), "\n")
+	// Loop over lines
+	for _, line := range lines {
+		// Escape lines that could be considered as separators (mboxrd format)
+		if strings.HasPrefix(strings.TrimLeft(line, ">"), "From ") {
+			sb.WriteString(">")
+		}
+
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	// Empty line at the end of message
+	sb.WriteString("\n")
+
+	// Lock to avoid concurrent writes
+	ft.mutex.Lock()
+	defer ft.mutex.Unlock()
+
+	// Open file
+	f, err := os.OpenFile(ft.cfg.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, filePermissions)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// Defer close
+	defer f.Close()
+
+	// Write entry
+	_, err = f.WriteString(sb.String())
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (ft *fileTransport) Check() error {
+	// Get directory that must exist
+	dir := ft.getDir()
+
+	// Stat
+	fi, err := os.Stat(dir)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Check if it is a directory
+	if !fi.IsDir() {
+		return errors.Errorf("%s isn't a directory", dir)
+	}
+
+	return nil
+}
+
+// getDir will return the directory where emails are written.
+func (ft *fileTransport) getDir() string {
+	// Check format
+	if ft.cfg.Format == CfgFileFormatMBOX {
+		return filepath.Dir(ft.cfg.Path)
+	}
+
+	return ft.cfg.Path
+}
@@ -0,0 +1,137 @@
+package email
+
+import (
+	"crypto/tls"
+	"time"
+
+	"emperror.dev/errors"
+
+	spmail "github.com/xhit/go-simple-mail/v2"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+type smtpTransport struct {
+	server *spmail.SMTPServer
+}
+
+func newSMTPTransport(cfg *config.SMTPConfig) (*smtpTransport, error) {
+	// Create SMTP client server
+	server := spmail.NewSMTPClient()
+
+	// SMTP Server configuration
+	server.Host = cfg.Host
+	server.Port = cfg.Port
+
+	// Configure username
+	if cfg.Username != nil {
+		server.Username = cfg.Username.Value
+	}
+	// Configure password
+	if cfg.Password != nil {
+		server.Password = cfg.Password.Value
+	}
+
+	// Encryption (default value is TLS)
+	switch cfg.Encryption {
+	case CfgEncryptionTLS:
+		server.Encryption = spmail.EncryptionTLS
+	case CfgEncryptionNone:
+		server.Encryption = spmail.EncryptionNone
+	case CfgEncryptionSSL:
+		server.Encryption = spmail.EncryptionSSL
+	default:
+		server.Encryption = spmail.EncryptionTLS
+	}
+
+	// Put authentication only if username and password exists
+	if cfg.Username != nil && cfg.Password != nil {
+		// Authentication type
+		switch cfg.AuthenticationType {
+		case CfgAuthenticationPlain:
+			server.Authentication = spmail.AuthPlain
+		case CfgAuthenticationLogin:
+			server.Authentication = spmail.AuthLogin
+		case CfgAuthenticationCRAMMD5:
+			server.Authentication = spmail.AuthCRAMMD5
+		default:
+			server.Authentication = spmail.AuthPlain
+		}
+	}
+
+	// Variable to keep alive connection
+	server.KeepAlive = cfg.KeepAlive
+
+	// Check if connect timeout isn't set
+	if cfg.ConnectTimeout == "" {
+		// Timeout for connect to SMTP Server
+		server.ConnectTimeout = CfgDefaultTimeout
+	} else {
+		// Parse connect timeout duration
+		connectTimeoutDur, err := time.ParseDuration(cfg.ConnectTimeout)
+		// Check error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		// Timeout for connect to SMTP Server
+		server.ConnectTimeout = connectTimeoutDur
+	}
+
+	// Check if send timeout isn't set
+	if cfg.SendTimeout == "" {
+		// Timeout for send the data and wait respond
+		server.SendTimeout = CfgDefaultTimeout
+	} else {
+		// Parse send timeout duration
+		sendTimeoutDur, err := time.ParseDuration(cfg.SendTimeout)
+		// Check error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		// Timeout for send the data and wait respond
+		server.SendTimeout = sendTimeoutDur
+	}
+
+	// Check if skip tls verify exists and is set
+	if cfg.TLSSkipVerify {
+		// Set TLSConfig to provide custom TLS configuration. For example,
+		// to skip TLS verification (useful for testing):
+		server.TLSConfig = &tls.Config{InsecureSkipVerify: true} //nolint: gosec // TLS Skip wanted
+	}
+
+	return &smtpTransport{server: server}, nil
+}
+
+func (st *smtpTransport) Send(from string, recipients []string, msg string) error {
+	// Connect server
+	client, err := st.server.Connect()
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// Defer close client
+	defer client.Close()
+
+	// Send message
+	err = spmail.SendMessage(from, recipients, msg, client)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Default
+	return nil
+}
+
+func (st *smtpTransport) Check() error {
+	// Connect server
+	client, err := st.server.Connect()
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// Defer close client
+	defer client.Close()
+
+	return nil
+}
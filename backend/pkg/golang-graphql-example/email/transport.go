@@ -0,0 +1,99 @@
+package email
+
+import (
+	"emperror.dev/errors"
+)
+
+// transport is the low level email delivery abstraction.
+type transport interface {
+	// Send will deliver a raw RFC822 message.
+	Send(from string, recipients []string, msg string) error
+	// Check transport health.
+	Check() error
+}
+
+func (s *service) newTransport() (transport, error) {
+	// Create base transport
+	tr, err := s.newBaseTransport()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get SMTP configuration
+	smtpCfg := s.cfgManager.GetConfig().SMTP
+	// Check if transport exists and DKIM signing is enabled
+	if tr == nil || smtpCfg == nil || smtpCfg.DKIM == nil {
+		return tr, nil
+	}
+
+	s.logger.Infof("Emails will be DKIM signed for domain %s", smtpCfg.DKIM.Domain)
+
+	return newDKIMTransport(tr, smtpCfg.DKIM)
+}
+
+func (s *service) newBaseTransport() (transport, error) {
+	// Get configuration
+	cfg := s.cfgManager.GetConfig()
+
+	// Default to SMTP transport for retro compatibility
+	trType := CfgTransportSMTP
+	// Check if transport configuration exists
+	if cfg.EmailTransport != nil {
+		trType = cfg.EmailTransport.Type
+	}
+
+	switch trType {
+	case CfgTransportSMTP:
+		// Check if configuration exists
+		// If it is equal to nil, skip connect
+		if cfg.SMTP == nil {
+			s.logger.Info("SMTP configuration not present, server creation skipped")
+
+			return nil, nil //nolint: nilnil // No transport
+		}
+
+		s.logger.Debug("Trying to create SMTP server")
+
+		// Create SMTP transport
+		tr, err := newSMTPTransport(cfg.SMTP)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger.Info("SMTP server created")
+
+		return tr, nil
+	case CfgTransportFile:
+		s.logger.Infof("Emails will be written to %s", cfg.EmailTransport.File.Path)
+
+		return newFileTransport(cfg.EmailTransport.File)
+	case CfgTransportCapture:
+		// Keep already captured emails in case of reload
+		if ct := s.getCaptureTransport(); ct != nil {
+			return ct, nil
+		}
+
+		s.logger.Info("Emails will be captured in memory")
+
+		return &captureTransport{}, nil
+	default:
+		return nil, errors.Errorf("unsupported email transport type %s", trType)
+	}
+}
+
+// Get capture transport even if it is wrapped by a signing transport.
+func (s *service) getCaptureTransport() *captureTransport {
+	// Get transport
+	tr, _ := s.getRuntime()
+	// Unwrap DKIM transport
+	if dt, ok := tr.(*dkimTransport); ok {
+		tr = dt.next
+	}
+
+	// Cast
+	ct, _ := tr.(*captureTransport)
+
+	return ct
+}
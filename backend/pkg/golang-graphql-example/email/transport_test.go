@@ -0,0 +1,204 @@
+//go:build unit
+
+package email
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+	mmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/metrics/mocks"
+)
+
+func Test_fileTransport_Send(t *testing.T) {
+	msg := "From: a@example.com\r\nSubject: Test\r\n\r\nFrom here\r\n>From there\r\n"
+
+	t.Run("eml", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "emails")
+		ft, err := newFileTransport(&config.EmailFileTransportConfig{Path: dir, Format: CfgFileFormatEML})
+		require.NoError(t, err)
+
+		// Directory is created with transport
+		require.NoError(t, ft.Check())
+		require.NoError(t, ft.Send("a@example.com", []string{"b@example.com"}, msg))
+		require.NoError(t, ft.Send("a@example.com", []string{"b@example.com"}, msg))
+		require.NoError(t, ft.Check())
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		require.NoError(t, err)
+		require.Len(t, files, 2)
+
+		content, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		assert.Equal(t, msg, string(content))
+	})
+
+	t.Run("mbox", func(t *testing.T) {
+		fp := filepath.Join(t.TempDir(), "mails", "emails.mbox")
+		ft, err := newFileTransport(&config.EmailFileTransportConfig{Path: fp, Format: CfgFileFormatMBOX})
+		require.NoError(t, err)
+
+		require.NoError(t, ft.Check())
+		require.NoError(t, ft.Send("a@example.com", []string{"b@example.com"}, msg))
+
+		content, err := os.ReadFile(fp)
+		require.NoError(t, err)
+		assert.Regexp(
+			t,
+			`^From a@example\.com .+\nFrom: a@example\.com\nSubject: Test\n\n>From here\n>>From there\n\n\n$`,
+			string(content),
+		)
+	})
+
+	t.Run("removed directory", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "emails")
+		ft, err := newFileTransport(&config.EmailFileTransportConfig{Path: dir, Format: CfgFileFormatEML})
+		require.NoError(t, err)
+
+		require.NoError(t, os.RemoveAll(dir))
+		require.Error(t, ft.Check())
+
+		// Directory is created again on send
+		require.NoError(t, ft.Send("a@example.com", []string{"b@example.com"}, msg))
+		require.NoError(t, ft.Check())
+	})
+
+	t.Run("no recipient", func(t *testing.T) {
+		ft := &fileTransport{cfg: &config.EmailFileTransportConfig{Path: t.TempDir(), Format: CfgFileFormatEML}}
+
+		assert.EqualError(t, ft.Send("a@example.com", nil, msg), "no recipient specified")
+	})
+}
+
+func Test_service_captureTransport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfgManagerMock := cmocks.NewMockManager(ctrl)
+	cfgManagerMock.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+		EmailTransport: &config.EmailTransportConfig{Type: CfgTransportCapture},
+	})
+
+	metricsSvcMock := mmocks.NewMockService(ctrl)
+	metricsSvcMock.EXPECT().IncreaseSuccessfullySentEmail().Times(1)
+
+	svc := NewService(cfgManagerMock, log.NewLogger(), nil, nil, metricsSvcMock)
+
+	require.NoError(t, svc.InitializeAndReload())
+	require.NoError(t, svc.Check())
+
+	em := svc.NewEmail()
+	em.SetFrom("a@example.com")
+	em.AddTo("b@example.com")
+	em.SetSubject("Hello é")
+	em.SetTextBody("Body")
+
+	require.NoError(t, svc.Send(em))
+
+	// Reload must keep captured emails
+	require.NoError(t, svc.InitializeAndReload())
+
+	list := svc.ListCapturedEmails()
+	require.Len(t, list, 1)
+	assert.Equal(t, "a@example.com", list[0].From)
+	assert.Equal(t, []string{"b@example.com"}, list[0].Recipients)
+	assert.Equal(t, "Hello é", list[0].Subject)
+	assert.Contains(t, list[0].Message, "Body")
+
+	svc.ClearCapturedEmails()
+	assert.Empty(t, svc.ListCapturedEmails())
+}
+
+func Test_service_dkimSigning(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	pk := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	cfgManagerMock := cmocks.NewMockManager(ctrl)
+	cfgManagerMock.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+		EmailTransport: &config.EmailTransportConfig{Type: CfgTransportCapture},
+		SMTP: &config.SMTPConfig{
+			DKIM: &config.SMTPDKIMConfig{
+				PrivateKey:       &config.CredentialConfig{Value: string(pk)},
+				Domain:           "example.com",
+				Selector:         "default",
+				Canonicalization: config.DefaultSMTPDKIMCanonicalization,
+				Headers:          config.DefaultSMTPDKIMHeaders,
+			},
+		},
+	})
+
+	metricsSvcMock := mmocks.NewMockService(ctrl)
+	metricsSvcMock.EXPECT().IncreaseSuccessfullySentEmail().Times(1)
+
+	svc := NewService(cfgManagerMock, log.NewLogger(), nil, nil, metricsSvcMock)
+
+	require.NoError(t, svc.InitializeAndReload())
+
+	em := svc.NewEmail()
+	em.SetFrom("a@example.com")
+	em.AddTo("b@example.com")
+	em.SetSubject("Signed")
+	em.SetTextBody("Body")
+	require.NoError(t, em.AddAttachment([]byte("content"), "file.txt", "text/plain"))
+
+	require.NoError(t, svc.Send(em))
+
+	list := svc.ListCapturedEmails()
+	require.Len(t, list, 1)
+	assert.Regexp(t, `^DKIM-Signature: v=1; a=rsa-sha256; q=dns/txt; c=relaxed/relaxed;`, list[0].Message)
+	assert.Contains(t, list[0].Message, "d=example.com")
+	assert.Contains(t, list[0].Message, "s=default")
+	assert.Contains(t, list[0].Message, "file.txt")
+	assert.Equal(t, "Signed", list[0].Subject)
+}
+
+func Test_validateDKIMPrivateKey(t *testing.T) {
+	assert.EqualError(t, validateDKIMPrivateKey([]byte("fake")), "dkim private key must be a pem encoded rsa private key")
+}
+
+func Test_service_concurrentReload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfgManagerMock := cmocks.NewMockManager(ctrl)
+	cfgManagerMock.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+		EmailTransport: &config.EmailTransportConfig{Type: CfgTransportCapture},
+	})
+
+	svc := NewService(cfgManagerMock, log.NewLogger(), nil, nil, mmocks.NewMockService(ctrl))
+
+	require.NoError(t, svc.InitializeAndReload())
+
+	// Reload while transport is used like the outbox delivery daemon would do
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for range 100 {
+			assert.NoError(t, svc.InitializeAndReload())
+		}
+	}()
+
+	for range 100 {
+		require.NoError(t, svc.Check())
+	}
+
+	<-done
+}
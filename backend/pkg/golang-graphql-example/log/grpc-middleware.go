@@ -0,0 +1,119 @@
+package log
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/utils"
+)
+
+func GRPCUnaryInterceptor(
+	logger Logger,
+	getCorrelationID func(ctx context.Context) string,
+	getTraceID func(ctx context.Context) string,
+) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		t1 := time.Now()
+
+		requestLogger := getGRPCRequestLogger(ctx, logger, info.FullMethod, getCorrelationID, getTraceID)
+
+		requestLogger.Debug("request started")
+
+		// Next with logger in context
+		res, err := handler(SetLoggerToContext(ctx, requestLogger), req)
+
+		logGRPCRequestComplete(requestLogger, t1, err)
+
+		return res, err
+	}
+}
+
+// GRPCStreamInterceptor is the stream equivalent of GRPCUnaryInterceptor.
+func GRPCStreamInterceptor(
+	logger Logger,
+	getCorrelationID func(ctx context.Context) string,
+	getTraceID func(ctx context.Context) string,
+) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		t1 := time.Now()
+
+		requestLogger := getGRPCRequestLogger(ss.Context(), logger, info.FullMethod, getCorrelationID, getTraceID)
+
+		requestLogger.Debug("request started")
+
+		// Next with logger in context
+		err := handler(srv, utils.WrapServerStreamWithContext(ss, SetLoggerToContext(ss.Context(), requestLogger)))
+
+		logGRPCRequestComplete(requestLogger, t1, err)
+
+		return err
+	}
+}
+
+func getGRPCRequestLogger(
+	ctx context.Context,
+	logger Logger,
+	fullMethod string,
+	getCorrelationID func(ctx context.Context) string,
+	getTraceID func(ctx context.Context) string,
+) Logger {
+	// Create logger fields
+	logFields := map[string]any{
+		"grpc_method": fullMethod,
+	}
+
+	// Get peer
+	if p, ok := peer.FromContext(ctx); ok {
+		logFields["remote_addr"] = p.Addr.String()
+	}
+
+	// Get user agent
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("user-agent"); len(v) != 0 {
+			logFields["user_agent"] = v[0]
+		}
+	}
+
+	// Log correlation id
+	logFields["correlation_id"] = getCorrelationID(ctx)
+
+	// Get trace id
+	traceID := getTraceID(ctx)
+	if traceID != "" {
+		logFields[LogTraceIDField] = traceID
+	}
+
+	return logger.WithFields(logFields)
+}
+
+func logGRPCRequestComplete(requestLogger Logger, t1 time.Time, err error) {
+	// Get code
+	code := status.Code(err)
+
+	// Create new fields
+	endFields := map[string]any{
+		"grpc_code":       code.String(),
+		"resp_elapsed_ms": float64(time.Since(t1).Nanoseconds()) / nsToMs,
+	}
+
+	endRequestLogger := requestLogger.WithFields(endFields)
+
+	logFunc := endRequestLogger.Info
+
+	switch code {
+	case codes.OK:
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal,
+		codes.Unavailable, codes.DataLoss:
+		logFunc = endRequestLogger.Error
+	default:
+		logFunc = endRequestLogger.Warn
+	}
+
+	logFunc("request complete")
+}
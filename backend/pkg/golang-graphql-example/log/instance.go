@@ -14,8 +14,11 @@ import (
 )
 
 const (
-	LogFileMode     = 0o666
-	LogTraceIDField = "trace_id"
+	LogFileMode              = 0o666
+	LogTraceIDField          = "trace_id"
+	LogImpersonatorField     = "impersonator"
+	LogImpersonatedUserField = "impersonated_user"
+	LogImpersonationIDField  = "impersonation_id"
 )
 
 // This is dirty pkg/errors.
@@ -101,6 +101,11 @@ func Middleware(
 			"resp_elapsed_ms":   float64(time.Since(t1).Nanoseconds()) / nsToMs,
 		}
 
+		// Get request logger from gin as it can have been enriched by next handlers (like authenticated identity)
+		if l := GetLoggerFromGin(c); l != nil {
+			requestLogger = l
+		}
+
 		endRequestLogger := requestLogger.WithFields(endFields)
 
 		logFunc := endRequestLogger.Info
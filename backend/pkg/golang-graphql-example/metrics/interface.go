@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 	"gorm.io/gorm"
 
 	gqlgraphql "github.com/99designs/gqlgen/graphql"
@@ -18,6 +19,8 @@ const defaultPrometheusGormRefreshMetricsSecond = 15
 type Service interface {
 	// Instrument web server.
 	Instrument(serverName string, routerPath bool) gin.HandlerFunc
+	// Instrument gRPC server.
+	InstrumentGRPC(serverName string) grpc.UnaryServerInterceptor
 	// Get prometheus handler for http expose.
 	PrometheusHTTPHandler() http.Handler
 	// Get database middleware.
@@ -36,6 +39,20 @@ type Service interface {
 	UpFailedConfigReload()
 	// DownFailedConfigReload will down the failed configuration reload gauge.
 	DownFailedConfigReload()
+	// IncreaseQueuedEmail will increase counter of emails saved in outbox.
+	IncreaseQueuedEmail()
+	// IncreaseSuccessfullySentEmail will increase counter of successfully sent emails.
+	IncreaseSuccessfullySentEmail()
+	// IncreaseFailedSentEmail will increase counter of failed email sending.
+	IncreaseFailedSentEmail()
+	// IncreasePermanentlyFailedEmail will increase counter of emails that won't be retried anymore.
+	IncreasePermanentlyFailedEmail()
+	// SetEmailOutboxMessagesCount will set the number of emails stored in outbox for a status.
+	SetEmailOutboxMessagesCount(status string, count int64)
+	// ObserveGraphQLOperationCost will observe the computed cost of a GraphQL operation.
+	ObserveGraphQLOperationCost(operationType string, cost int)
+	// IncreaseRejectedGraphQLOperation will increase counter of GraphQL operations rejected by limits.
+	IncreaseRejectedGraphQLOperation(reason string)
 }
 
 // NewService will generate a new Service.
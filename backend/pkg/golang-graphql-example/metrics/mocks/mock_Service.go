@@ -16,6 +16,7 @@ import (
 	graphql "github.com/99designs/gqlgen/graphql"
 	gin "github.com/gin-gonic/gin"
 	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
 	gorm "gorm.io/gorm"
 )
 
@@ -107,6 +108,54 @@ func (mr *MockServiceMockRecorder) IncreaseFailedAMQPPublishedMessage(exchange,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseFailedAMQPPublishedMessage", reflect.TypeOf((*MockService)(nil).IncreaseFailedAMQPPublishedMessage), exchange, routingKey)
 }
 
+// IncreaseFailedSentEmail mocks base method.
+func (m *MockService) IncreaseFailedSentEmail() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncreaseFailedSentEmail")
+}
+
+// IncreaseFailedSentEmail indicates an expected call of IncreaseFailedSentEmail.
+func (mr *MockServiceMockRecorder) IncreaseFailedSentEmail() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseFailedSentEmail", reflect.TypeOf((*MockService)(nil).IncreaseFailedSentEmail))
+}
+
+// IncreasePermanentlyFailedEmail mocks base method.
+func (m *MockService) IncreasePermanentlyFailedEmail() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncreasePermanentlyFailedEmail")
+}
+
+// IncreasePermanentlyFailedEmail indicates an expected call of IncreasePermanentlyFailedEmail.
+func (mr *MockServiceMockRecorder) IncreasePermanentlyFailedEmail() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreasePermanentlyFailedEmail", reflect.TypeOf((*MockService)(nil).IncreasePermanentlyFailedEmail))
+}
+
+// IncreaseQueuedEmail mocks base method.
+func (m *MockService) IncreaseQueuedEmail() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncreaseQueuedEmail")
+}
+
+// IncreaseQueuedEmail indicates an expected call of IncreaseQueuedEmail.
+func (mr *MockServiceMockRecorder) IncreaseQueuedEmail() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseQueuedEmail", reflect.TypeOf((*MockService)(nil).IncreaseQueuedEmail))
+}
+
+// IncreaseRejectedGraphQLOperation mocks base method.
+func (m *MockService) IncreaseRejectedGraphQLOperation(reason string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncreaseRejectedGraphQLOperation", reason)
+}
+
+// IncreaseRejectedGraphQLOperation indicates an expected call of IncreaseRejectedGraphQLOperation.
+func (mr *MockServiceMockRecorder) IncreaseRejectedGraphQLOperation(reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseRejectedGraphQLOperation", reflect.TypeOf((*MockService)(nil).IncreaseRejectedGraphQLOperation), reason)
+}
+
 // IncreaseSuccessfullyAMQPConsumedMessage mocks base method.
 func (m *MockService) IncreaseSuccessfullyAMQPConsumedMessage(queue, consumerTag, routingKey string) {
 	m.ctrl.T.Helper()
@@ -131,6 +180,18 @@ func (mr *MockServiceMockRecorder) IncreaseSuccessfullyAMQPPublishedMessage(exch
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseSuccessfullyAMQPPublishedMessage", reflect.TypeOf((*MockService)(nil).IncreaseSuccessfullyAMQPPublishedMessage), exchange, routingKey)
 }
 
+// IncreaseSuccessfullySentEmail mocks base method.
+func (m *MockService) IncreaseSuccessfullySentEmail() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncreaseSuccessfullySentEmail")
+}
+
+// IncreaseSuccessfullySentEmail indicates an expected call of IncreaseSuccessfullySentEmail.
+func (mr *MockServiceMockRecorder) IncreaseSuccessfullySentEmail() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseSuccessfullySentEmail", reflect.TypeOf((*MockService)(nil).IncreaseSuccessfullySentEmail))
+}
+
 // Instrument mocks base method.
 func (m *MockService) Instrument(serverName string, routerPath bool) gin.HandlerFunc {
 	m.ctrl.T.Helper()
@@ -145,6 +206,32 @@ func (mr *MockServiceMockRecorder) Instrument(serverName, routerPath any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Instrument", reflect.TypeOf((*MockService)(nil).Instrument), serverName, routerPath)
 }
 
+// InstrumentGRPC mocks base method.
+func (m *MockService) InstrumentGRPC(serverName string) grpc.UnaryServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstrumentGRPC", serverName)
+	ret0, _ := ret[0].(grpc.UnaryServerInterceptor)
+	return ret0
+}
+
+// InstrumentGRPC indicates an expected call of InstrumentGRPC.
+func (mr *MockServiceMockRecorder) InstrumentGRPC(serverName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstrumentGRPC", reflect.TypeOf((*MockService)(nil).InstrumentGRPC), serverName)
+}
+
+// ObserveGraphQLOperationCost mocks base method.
+func (m *MockService) ObserveGraphQLOperationCost(operationType string, cost int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveGraphQLOperationCost", operationType, cost)
+}
+
+// ObserveGraphQLOperationCost indicates an expected call of ObserveGraphQLOperationCost.
+func (mr *MockServiceMockRecorder) ObserveGraphQLOperationCost(operationType, cost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveGraphQLOperationCost", reflect.TypeOf((*MockService)(nil).ObserveGraphQLOperationCost), operationType, cost)
+}
+
 // PrometheusHTTPHandler mocks base method.
 func (m *MockService) PrometheusHTTPHandler() http.Handler {
 	m.ctrl.T.Helper()
@@ -159,6 +246,18 @@ func (mr *MockServiceMockRecorder) PrometheusHTTPHandler() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrometheusHTTPHandler", reflect.TypeOf((*MockService)(nil).PrometheusHTTPHandler))
 }
 
+// SetEmailOutboxMessagesCount mocks base method.
+func (m *MockService) SetEmailOutboxMessagesCount(status string, count int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetEmailOutboxMessagesCount", status, count)
+}
+
+// SetEmailOutboxMessagesCount indicates an expected call of SetEmailOutboxMessagesCount.
+func (mr *MockServiceMockRecorder) SetEmailOutboxMessagesCount(status, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmailOutboxMessagesCount", reflect.TypeOf((*MockService)(nil).SetEmailOutboxMessagesCount), status, count)
+}
+
 // UpFailedConfigReload mocks base method.
 func (m *MockService) UpFailedConfigReload() {
 	m.ctrl.T.Helper()
@@ -1,13 +1,17 @@
 package metrics
 
 import (
+	"context"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 
 	gqlprometheus "github.com/99designs/gqlgen-contrib/prometheus"
@@ -25,6 +29,12 @@ type prometheusMetrics struct {
 	gormPrometheus        map[string]gorm.Plugin
 	amqpConsumedMessages  *prometheus.CounterVec
 	amqpPublishedMessages *prometheus.CounterVec
+	emailMessages         *prometheus.CounterVec
+	emailOutboxMessages   *prometheus.GaugeVec
+	graphqlOperationCost  *prometheus.HistogramVec
+	graphqlRejectedOps    *prometheus.CounterVec
+	grpcReqCnt            *prometheus.CounterVec
+	grpcReqDur            *prometheus.SummaryVec
 }
 
 func (*prometheusMetrics) GraphqlMiddleware() gqlgraphql.HandlerExtension {
@@ -65,6 +75,34 @@ func (impl *prometheusMetrics) IncreaseFailedAMQPPublishedMessage(exchange, rout
 	impl.amqpPublishedMessages.WithLabelValues(exchange, routingKey, "error").Inc()
 }
 
+func (impl *prometheusMetrics) IncreaseQueuedEmail() {
+	impl.emailMessages.WithLabelValues("queued").Inc()
+}
+
+func (impl *prometheusMetrics) IncreaseSuccessfullySentEmail() {
+	impl.emailMessages.WithLabelValues("success").Inc()
+}
+
+func (impl *prometheusMetrics) IncreaseFailedSentEmail() {
+	impl.emailMessages.WithLabelValues("error").Inc()
+}
+
+func (impl *prometheusMetrics) IncreasePermanentlyFailedEmail() {
+	impl.emailMessages.WithLabelValues("failed").Inc()
+}
+
+func (impl *prometheusMetrics) SetEmailOutboxMessagesCount(status string, count int64) {
+	impl.emailOutboxMessages.WithLabelValues(status).Set(float64(count))
+}
+
+func (impl *prometheusMetrics) ObserveGraphQLOperationCost(operationType string, cost int) {
+	impl.graphqlOperationCost.WithLabelValues(operationType).Observe(float64(cost))
+}
+
+func (impl *prometheusMetrics) IncreaseRejectedGraphQLOperation(reason string) {
+	impl.graphqlRejectedOps.WithLabelValues(reason).Inc()
+}
+
 // The gorm prometheus plugin cannot be instantiated twice because there is a loop inside that cannot be modified or stopped.
 // This loop get all data from database and the loop cannot be modified in terms of the duration.
 // Labels and all other options cannot be modified.
@@ -112,6 +150,24 @@ func (impl *prometheusMetrics) Instrument(serverName string, routerPath bool) gi
 	}
 }
 
+// InstrumentGRPC will instrument gRPC unary calls.
+func (impl *prometheusMetrics) InstrumentGRPC(serverName string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		res, err := handler(ctx, req)
+
+		elapsed := float64(time.Since(start)) / float64(time.Second)
+		// Full method format is "/package.service/method"
+		service, method, _ := strings.Cut(strings.TrimPrefix(info.FullMethod, "/"), "/")
+
+		impl.grpcReqDur.WithLabelValues(serverName).Observe(elapsed)
+		impl.grpcReqCnt.WithLabelValues(serverName, service, method, status.Code(err).String()).Inc()
+
+		return res, err
+	}
+}
+
 // From https://github.com/DanielHeckrath/gin-prometheus/blob/master/gin_prometheus.go
 func computeApproximateRequestSize(r *http.Request) int {
 	s := 0
@@ -212,6 +268,61 @@ func (impl *prometheusMetrics) register() {
 	)
 	prometheus.MustRegister(impl.amqpPublishedMessages)
 
+	impl.emailMessages = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "email_messages_total",
+			Help: "How many emails have been queued, sent, failed to be sent or permanently failed by status",
+		},
+		[]string{"status"},
+	)
+	prometheus.MustRegister(impl.emailMessages)
+
+	impl.emailOutboxMessages = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "email_outbox_messages",
+			Help: "How many emails are stored in outbox by status",
+		},
+		[]string{"status"},
+	)
+	prometheus.MustRegister(impl.emailOutboxMessages)
+
+	impl.graphqlOperationCost = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "graphql_operation_cost",
+			Help:    "The computed cost of GraphQL operations by operation type",
+			Buckets: prometheus.ExponentialBuckets(1, 4, 8), //nolint:mnd
+		},
+		[]string{"operation_type"},
+	)
+	prometheus.MustRegister(impl.graphqlOperationCost)
+
+	impl.graphqlRejectedOps = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "graphql_rejected_operations_total",
+			Help: "How many GraphQL operations have been rejected by limits by reason (complexity, depth or aliases)",
+		},
+		[]string{"reason"},
+	)
+	prometheus.MustRegister(impl.graphqlRejectedOps)
+
+	impl.grpcReqCnt = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "How many gRPC calls processed, partitioned by service, method and gRPC code.",
+		},
+		[]string{"server_name", "grpc_service", "grpc_method", "grpc_code"},
+	)
+	prometheus.MustRegister(impl.grpcReqCnt)
+
+	impl.grpcReqDur = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Name: "grpc_server_handling_seconds",
+			Help: "The gRPC call latencies in seconds.",
+		},
+		[]string{"server_name"},
+	)
+	prometheus.MustRegister(impl.grpcReqDur)
+
 	// Register gqlgen graphql prometheus metrics
 	gqlprometheus.Register()
 }
@@ -0,0 +1,58 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+// bucket is the token bucket state.
+type bucket struct {
+	RefilledAt time.Time
+	// ExpiresAt is the date where bucket will be full again, bucket can be forgotten after it.
+	ExpiresAt time.Time `gorm:"index"`
+	Key       string    `gorm:"type:varchar(500);primaryKey"`
+	Tokens    float64
+}
+
+func (*bucket) TableName() string {
+	return "rate_limit_buckets"
+}
+
+// take will refill bucket and consume a token if available.
+func (b *bucket) take(limit *Limit, now time.Time) *Result {
+	// Compute refill rate in tokens per second
+	rate := float64(limit.Count) / limit.Period.Seconds()
+	burst := float64(limit.Burst)
+
+	// Refill
+	elapsed := now.Sub(b.RefilledAt).Seconds()
+	if elapsed > 0 {
+		b.Tokens = math.Min(burst, b.Tokens+elapsed*rate)
+		b.RefilledAt = now
+	}
+	// Limit can have changed since last time
+	b.Tokens = math.Min(burst, b.Tokens)
+
+	res := &Result{}
+	// Check if a token is available
+	if b.Tokens >= 1 {
+		b.Tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = time.Duration((1 - b.Tokens) / rate * float64(time.Second))
+	}
+
+	// Compute when bucket will be full again
+	b.ExpiresAt = now.Add(time.Duration((burst - b.Tokens) / rate * float64(time.Second)))
+
+	return res
+}
+
+func newBucket(key string, limit *Limit, now time.Time) *bucket {
+	return &bucket{
+		Key:        key,
+		Tokens:     float64(limit.Burst),
+		RefilledAt: now,
+		ExpiresAt:  now,
+	}
+}
@@ -0,0 +1,67 @@
+//go:build unit
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_bucket_take(t *testing.T) {
+	now := time.Now()
+	// 1 token per second with a burst of 2
+	limit := &Limit{Count: 60, Period: time.Minute, Burst: 2}
+	b := newBucket("key", limit, now)
+
+	// Burst
+	assert.True(t, b.take(limit, now).Allowed)
+	assert.True(t, b.take(limit, now).Allowed)
+
+	// Empty
+	res := b.take(limit, now)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, time.Second, res.RetryAfter)
+	assert.Equal(t, now.Add(2*time.Second), b.ExpiresAt)
+
+	// Half refilled
+	res = b.take(limit, now.Add(500*time.Millisecond))
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 500*time.Millisecond, res.RetryAfter)
+
+	// Refilled
+	assert.True(t, b.take(limit, now.Add(time.Second)).Allowed)
+
+	// Refill cannot exceed burst
+	now = now.Add(time.Hour)
+	assert.True(t, b.take(limit, now).Allowed)
+	assert.True(t, b.take(limit, now).Allowed)
+	assert.False(t, b.take(limit, now).Allowed)
+
+	// Lower burst is applied on existing bucket
+	b = newBucket("key", limit, now)
+	assert.True(t, b.take(&Limit{Count: 60, Period: time.Minute, Burst: 1}, now).Allowed)
+	assert.False(t, b.take(&Limit{Count: 60, Period: time.Minute, Burst: 1}, now).Allowed)
+}
+
+func Test_memoryStore_prune(t *testing.T) {
+	now := time.Now()
+	limit := &Limit{Count: 60, Period: time.Minute, Burst: 1}
+	s := newMemoryStore()
+
+	_, _ = s.take(context.TODO(), "key1", limit, now)
+	assert.Len(t, s.buckets, 1)
+
+	// Bucket is full again after a second and prune is done after an interval
+	_, _ = s.take(context.TODO(), "key2", limit, now.Add(pruneInterval))
+	assert.Len(t, s.buckets, 1)
+	assert.Contains(t, s.buckets, "key2")
+}
+
+func Test_retryAfterSeconds(t *testing.T) {
+	assert.Equal(t, 1, retryAfterSeconds(0))
+	assert.Equal(t, 1, retryAfterSeconds(100*time.Millisecond))
+	assert.Equal(t, 2, retryAfterSeconds(1100*time.Millisecond))
+}
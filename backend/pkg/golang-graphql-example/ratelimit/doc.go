@@ -0,0 +1,3 @@
+package ratelimit
+
+// This package will manage request rate limiting with token buckets stored in memory or in database.
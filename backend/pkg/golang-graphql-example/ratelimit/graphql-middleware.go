@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+type graphqlExtension struct {
+	s         *service
+	limits    map[string]*Limit
+	storeType string
+}
+
+var _ interface {
+	gqlgraphql.HandlerExtension
+	gqlgraphql.OperationContextMutator
+} = &graphqlExtension{}
+
+func (s *service) GraphqlMiddleware(cfg *config.ServerRateLimitConfig) (gqlgraphql.HandlerExtension, error) {
+	// Build limits
+	limits := make(map[string]*Limit, len(cfg.GraphQLOperations))
+	for _, it := range cfg.GraphQLOperations {
+		// Parse limit
+		limit, err := parseLimit(it.Period, it.Limit, it.Burst)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		limits[it.OperationName] = limit
+	}
+
+	return &graphqlExtension{s: s, limits: limits, storeType: cfg.Store}, nil
+}
+
+func (*graphqlExtension) ExtensionName() string {
+	return "RateLimit"
+}
+
+func (*graphqlExtension) Validate(_ gqlgraphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e *graphqlExtension) MutateOperationContext(
+	ctx context.Context,
+	opCtx *gqlgraphql.OperationContext,
+) *gqlerror.Error {
+	// Get operation name
+	name := opCtx.OperationName
+	if name == "" && opCtx.Operation != nil {
+		name = opCtx.Operation.Name
+	}
+
+	// Get limit
+	limit, ok := e.limits[name]
+	// Check if operation is limited
+	if !ok {
+		return nil
+	}
+
+	// Consume token
+	res, err := e.s.Take(ctx, e.storeType, "graphql:"+name+":"+getSubjectFromContext(ctx), limit)
+	// Check error
+	if err != nil {
+		return gqlerror.Wrap(err)
+	}
+
+	// Check if operation is allowed
+	if !res.Allowed {
+		err = newRateLimitedError(res.RetryAfter)
+
+		// Get logger
+		logger := log.GetLoggerFromContext(ctx)
+		if logger != nil {
+			logger.Warn(err)
+		}
+
+		return gqlerror.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+//go:build unit
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+func Test_graphqlExtension_MutateOperationContext(t *testing.T) {
+	ext, err := NewService(nil).GraphqlMiddleware(&config.ServerRateLimitConfig{
+		Store: config.ServerRateLimitStoreMemory,
+		GraphQLOperations: []*config.ServerRateLimitGraphQLOperationConfig{
+			{OperationName: "createTodo", Limit: 1, Burst: 1, Period: "1m"},
+		},
+	})
+	require.NoError(t, err)
+
+	mutator, ok := ext.(gqlgraphql.OperationContextMutator)
+	require.True(t, ok)
+
+	ctx1 := setSubjectInContext(context.TODO(), "user:user1")
+	ctx2 := setSubjectInContext(context.TODO(), "user:user2")
+
+	// Not limited operation
+	opCtx := &gqlgraphql.OperationContext{Operation: &ast.OperationDefinition{Name: "todos"}}
+	assert.Nil(t, mutator.MutateOperationContext(ctx1, opCtx))
+	assert.Nil(t, mutator.MutateOperationContext(ctx1, opCtx))
+
+	// Operation name taken from document
+	opCtx = &gqlgraphql.OperationContext{Operation: &ast.OperationDefinition{Name: "createTodo"}}
+	assert.Nil(t, mutator.MutateOperationContext(ctx1, opCtx))
+
+	// Operation name taken from request
+	opCtx = &gqlgraphql.OperationContext{OperationName: "createTodo"}
+	gErr := mutator.MutateOperationContext(ctx1, opCtx)
+	require.NotNil(t, gErr)
+
+	var err2 *cerrors.GenericError
+	require.ErrorAs(t, gErr, &err2)
+	assert.Equal(t, RateLimitedErrorCode, err2.Extensions()["code"])
+	assert.Equal(t, 60, err2.Extensions()["retryAfter"])
+
+	// Other subject has its own bucket
+	assert.Nil(t, mutator.MutateOperationContext(ctx2, opCtx))
+}
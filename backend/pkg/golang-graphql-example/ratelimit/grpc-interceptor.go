@@ -0,0 +1,126 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+// gRPC metadata key containing the duration in seconds to wait before retrying.
+const grpcRetryAfterMetadataKey = "retry-after"
+
+func (s *service) GRPCIPUnaryInterceptor(cfg *config.ServerRateLimitConfig) (grpc.UnaryServerInterceptor, error) {
+	// Parse limit
+	limit, err := parseLimit(cfg.IP.Period, cfg.IP.Limit, cfg.IP.Burst)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		// Consume token
+		// Bucket is shared with HTTP requests coming from the same ip
+		res, err := s.Take(ctx, cfg.Store, "ip:"+getGRPCPeerIP(ctx), limit)
+		// Check error
+		if err != nil {
+			return nil, answerGRPCError(ctx, err)
+		}
+
+		// Check if request is allowed
+		if !res.Allowed {
+			return nil, answerGRPCRateLimited(ctx, res)
+		}
+
+		return handler(ctx, req)
+	}, nil
+}
+
+func (s *service) GRPCUnaryInterceptor(cfg *config.ServerRateLimitConfig) (grpc.UnaryServerInterceptor, error) {
+	// Build rules
+	rules, err := buildRouteRules(cfg)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		// Compute subject
+		subject := computeSubject(ctx, getGRPCPeerIP(ctx))
+
+		for _, r := range rules {
+			// Check if rule is matching
+			// gRPC calls are HTTP/2 POST requests on full method path
+			if !r.match(info.FullMethod, http.MethodPost) {
+				continue
+			}
+
+			// Consume token
+			res, err := s.Take(ctx, cfg.Store, "route:"+r.id+":"+subject, r.limit)
+			// Check error
+			if err != nil {
+				return nil, answerGRPCError(ctx, err)
+			}
+
+			// Check if request is allowed
+			if !res.Allowed {
+				return nil, answerGRPCRateLimited(ctx, res)
+			}
+		}
+
+		return handler(ctx, req)
+	}, nil
+}
+
+// getGRPCPeerIP will return peer ip (or address if it cannot be parsed).
+// Forwarded metadata aren't used as they can be set by any client.
+func getGRPCPeerIP(ctx context.Context) string {
+	// Get peer
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	// Remove port
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	// Check error
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+func answerGRPCError(ctx context.Context, err error) error {
+	// Get logger
+	logger := log.GetLoggerFromContext(ctx)
+	if logger != nil {
+		logger.Error(err)
+	}
+
+	return utils.ToGRPCError(err)
+}
+
+func answerGRPCRateLimited(ctx context.Context, res *Result) error {
+	err := newRateLimitedError(res.RetryAfter)
+
+	// Get logger
+	logger := log.GetLoggerFromContext(ctx)
+	if logger != nil {
+		logger.Warn(err)
+	}
+
+	// Send retry after in header metadata
+	// Error is ignored as it only fails when headers are already sent
+	_ = grpc.SetHeader(ctx, metadata.Pairs(grpcRetryAfterMetadataKey, strconv.Itoa(retryAfterSeconds(res.RetryAfter))))
+
+	return utils.ToGRPCError(err)
+}
@@ -0,0 +1,88 @@
+//go:build unit
+
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+func newGRPCTestContext(ip, user string) context.Context {
+	ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+
+	if user != "" {
+		ctx = authentication.SetAuthenticatedUserToContext(ctx, &models.OIDCUser{PreferredUsername: user})
+	}
+
+	return ctx
+}
+
+func callGRPCInterceptor(interceptor grpc.UnaryServerInterceptor, ctx context.Context, method string) codes.Code { //nolint:revive // Test helper
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(_ context.Context, _ any) (any, error) {
+		return nil, nil
+	})
+
+	return status.Code(err)
+}
+
+func Test_service_GRPCUnaryInterceptor(t *testing.T) {
+	const (
+		createMethod = "/todos.v1.TodoService/CreateTodo"
+		listMethod   = "/todos.v1.TodoService/ListTodos"
+	)
+
+	interceptor, err := NewService(nil).GRPCUnaryInterceptor(&config.ServerRateLimitConfig{
+		Store: config.ServerRateLimitStoreMemory,
+		Routes: []*config.ServerRateLimitRouteConfig{
+			{Path: "^/todos\\.v1\\.TodoService/CreateTodo$", Methods: []string{"post"}, Limit: 1, Burst: 1, Period: "1h"},
+		},
+	})
+	require.NoError(t, err)
+
+	// Anonymous is limited per peer ip
+	assert.Equal(t, codes.OK, callGRPCInterceptor(interceptor, newGRPCTestContext("10.0.0.1", ""), createMethod))
+	assert.Equal(t, codes.ResourceExhausted, callGRPCInterceptor(interceptor, newGRPCTestContext("10.0.0.1", ""), createMethod))
+	assert.Equal(t, codes.OK, callGRPCInterceptor(interceptor, newGRPCTestContext("10.0.0.2", ""), createMethod))
+
+	// Authenticated users are limited per user whatever the ip
+	assert.Equal(t, codes.OK, callGRPCInterceptor(interceptor, newGRPCTestContext("10.0.0.1", "user"), createMethod))
+	assert.Equal(t, codes.ResourceExhausted, callGRPCInterceptor(interceptor, newGRPCTestContext("10.0.0.3", "user"), createMethod))
+
+	// Not matching methods aren't limited
+	assert.Equal(t, codes.OK, callGRPCInterceptor(interceptor, newGRPCTestContext("10.0.0.1", "user"), listMethod))
+}
+
+func Test_service_GRPCIPUnaryInterceptor(t *testing.T) {
+	s := NewService(nil)
+	cfg := &config.ServerRateLimitConfig{
+		Store: config.ServerRateLimitStoreMemory,
+		IP:    &config.ServerRateLimitIPConfig{Limit: 1, Burst: 1, Period: "1h"},
+	}
+
+	interceptor, err := s.GRPCIPUnaryInterceptor(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, codes.OK, callGRPCInterceptor(interceptor, newGRPCTestContext("10.0.0.1", ""), "/any"))
+	assert.Equal(t, codes.ResourceExhausted, callGRPCInterceptor(interceptor, newGRPCTestContext("10.0.0.1", ""), "/other"))
+	assert.Equal(t, codes.OK, callGRPCInterceptor(interceptor, newGRPCTestContext("10.0.0.2", ""), "/any"))
+
+	// Bucket is the one used by HTTP requests
+	res, err := s.Take(context.TODO(), cfg.Store, "ip:10.0.0.2", &Limit{Period: time.Hour, Count: 1, Burst: 1})
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+}
@@ -0,0 +1,143 @@
+package ratelimit
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+type routeRule struct {
+	limit   *Limit
+	path    *regexp.Regexp
+	id      string
+	methods []string
+}
+
+func (r *routeRule) match(path, method string) bool {
+	return r.path.MatchString(path) &&
+		(len(r.methods) == 0 || lo.Contains(r.methods, method))
+}
+
+func buildRouteRules(cfg *config.ServerRateLimitConfig) ([]*routeRule, error) {
+	// Build rules
+	rules := make([]*routeRule, 0, len(cfg.Routes))
+	for _, it := range cfg.Routes {
+		// Compile path
+		reg, err := regexp.Compile(it.Path)
+		// Check error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		// Parse limit
+		limit, err := parseLimit(it.Period, it.Limit, it.Burst)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		methods := lo.Map(it.Methods, func(m string, _ int) string { return strings.ToUpper(m) })
+
+		rules = append(rules, &routeRule{
+			limit:   limit,
+			path:    reg,
+			id:      strings.Join(methods, ",") + " " + it.Path,
+			methods: methods,
+		})
+	}
+
+	return rules, nil
+}
+
+func (s *service) IPMiddleware(cfg *config.ServerRateLimitConfig) (gin.HandlerFunc, error) {
+	// Parse limit
+	limit, err := parseLimit(cfg.IP.Period, cfg.IP.Limit, cfg.IP.Burst)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return func(c *gin.Context) {
+		// Consume token
+		res, err := s.Take(c.Request.Context(), cfg.Store, "ip:"+c.ClientIP(), limit)
+		// Check error
+		if err != nil {
+			log.GetLoggerFromGin(c).Error(err)
+			utils.AnswerWithError(c, err)
+
+			return
+		}
+
+		// Check if request is allowed
+		if !res.Allowed {
+			answerRateLimited(c, res)
+
+			return
+		}
+
+		c.Next()
+	}, nil
+}
+
+func (s *service) HTTPMiddleware(cfg *config.ServerRateLimitConfig) (gin.HandlerFunc, error) {
+	// Build rules
+	rules, err := buildRouteRules(cfg)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return func(c *gin.Context) {
+		// Get logger
+		logger := log.GetLoggerFromGin(c)
+
+		// Compute subject
+		subject := computeSubject(c.Request.Context(), c.ClientIP())
+
+		// Save subject for graphql operations limits
+		ctx := setSubjectInContext(c.Request.Context(), subject)
+		c.Request = c.Request.WithContext(ctx)
+
+		for _, r := range rules {
+			// Check if rule is matching
+			if !r.match(c.Request.URL.Path, c.Request.Method) {
+				continue
+			}
+
+			// Consume token
+			res, err := s.Take(ctx, cfg.Store, "route:"+r.id+":"+subject, r.limit)
+			// Check error
+			if err != nil {
+				logger.Error(err)
+				utils.AnswerWithError(c, err)
+
+				return
+			}
+
+			// Check if request is allowed
+			if !res.Allowed {
+				answerRateLimited(c, res)
+
+				return
+			}
+		}
+
+		c.Next()
+	}, nil
+}
+
+func answerRateLimited(c *gin.Context, res *Result) {
+	err := newRateLimitedError(res.RetryAfter)
+
+	log.GetLoggerFromGin(c).Warn(err)
+	c.Header("Retry-After", strconv.Itoa(retryAfterSeconds(res.RetryAfter)))
+	utils.AnswerWithError(c, err)
+}
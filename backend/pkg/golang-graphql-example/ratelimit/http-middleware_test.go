@@ -0,0 +1,162 @@
+//go:build unit
+
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+func newTestRouter(t *testing.T, cfg *config.ServerRateLimitConfig) *gin.Engine {
+	t.Helper()
+
+	mw, err := NewService(nil).HTTPMiddleware(cfg)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		log.SetLoggerToGin(c, log.NewLogger())
+
+		// Fake authentication
+		if user := c.GetHeader("X-User"); user != "" {
+			c.Request = c.Request.WithContext(authentication.SetAuthenticatedUserToContext(
+				c.Request.Context(),
+				&models.OIDCUser{PreferredUsername: user},
+			))
+		}
+	})
+	router.Use(mw)
+	router.Any("/api/*any", func(c *gin.Context) {
+		c.String(http.StatusOK, getSubjectFromContext(c.Request.Context()))
+	})
+
+	return router
+}
+
+func Test_service_HTTPMiddleware(t *testing.T) {
+	router := newTestRouter(t, &config.ServerRateLimitConfig{
+		Store: config.ServerRateLimitStoreMemory,
+		Routes: []*config.ServerRateLimitRouteConfig{
+			{Path: "^/api/graphql$", Methods: []string{"post"}, Limit: 1, Burst: 1, Period: "1h"},
+		},
+	})
+
+	do := func(method, path, user, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = ip + ":1234"
+
+		if user != "" {
+			req.Header.Set("X-User", user)
+		}
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		return w
+	}
+
+	// Anonymous is limited per ip
+	w := do(http.MethodPost, "/api/graphql", "", "10.0.0.1")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "ip:10.0.0.1", w.Body.String())
+
+	w = do(http.MethodPost, "/api/graphql", "", "10.0.0.1")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "3600", w.Header().Get("Retry-After"))
+	assert.JSONEq(
+		t,
+		`{"error":"rate limit exceeded","extensions":{"code":"RATE_LIMITED","retryAfter":3600}}`,
+		w.Body.String(),
+	)
+
+	w = do(http.MethodPost, "/api/graphql", "", "10.0.0.2")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// Authenticated users are limited per user whatever the ip
+	w = do(http.MethodPost, "/api/graphql", "user", "10.0.0.1")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "user:user", w.Body.String())
+
+	w = do(http.MethodPost, "/api/graphql", "user", "10.0.0.3")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+
+	// Not matching routes and methods aren't limited
+	w = do(http.MethodGet, "/api/graphql", "user", "10.0.0.1")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = do(http.MethodPost, "/api/other", "user", "10.0.0.1")
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func Test_service_HTTPMiddleware_invalidConfig(t *testing.T) {
+	_, err := NewService(nil).HTTPMiddleware(&config.ServerRateLimitConfig{
+		Routes: []*config.ServerRateLimitRouteConfig{{Path: "(", Limit: 1, Burst: 1, Period: "1m"}},
+	})
+	require.Error(t, err)
+
+	_, err = NewService(nil).HTTPMiddleware(&config.ServerRateLimitConfig{
+		Routes: []*config.ServerRateLimitRouteConfig{{Path: "^/", Limit: 1, Burst: 1, Period: "0s"}},
+	})
+	require.Error(t, err)
+}
+
+func Test_service_IPMiddleware(t *testing.T) {
+	mw, err := NewService(nil).IPMiddleware(&config.ServerRateLimitConfig{
+		Store: config.ServerRateLimitStoreMemory,
+		IP:    &config.ServerRateLimitIPConfig{Limit: 1, Burst: 1, Period: "1h"},
+	})
+	require.NoError(t, err)
+
+	router := gin.New()
+	// No proxy is trusted
+	require.NoError(t, router.SetTrustedProxies(nil))
+	router.Use(func(c *gin.Context) {
+		log.SetLoggerToGin(c, log.NewLogger())
+	})
+	router.Use(mw)
+	router.Any("/*any", func(c *gin.Context) {
+		// Authentication rejecting all requests
+		c.AbortWithStatus(http.StatusUnauthorized)
+	})
+
+	do := func(path, ip, forwardedFor string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = ip + ":1234"
+
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		return w
+	}
+
+	// Rejected requests are counted
+	w := do("/api/graphql", "10.0.0.1", "")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// All paths are limited
+	w = do("/other", "10.0.0.1", "")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "3600", w.Header().Get("Retry-After"))
+
+	// Forwarded header from untrusted proxy is ignored
+	w = do("/api/graphql", "10.0.0.1", "192.168.0.1")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+
+	// Other ips aren't limited
+	w = do("/api/graphql", "10.0.0.2", "")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
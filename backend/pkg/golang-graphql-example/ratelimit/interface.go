@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+)
+
+// RateLimitedErrorCode is the error code returned when a request is rate limited.
+const RateLimitedErrorCode = "RATE_LIMITED"
+
+//go:generate mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/ratelimit Service
+type Service interface {
+	// Take will consume a token in the bucket identified by key.
+	Take(ctx context.Context, storeType, key string, limit *Limit) (*Result, error)
+	// IPMiddleware will return a middleware limiting all requests per client ip.
+	// This middleware must be added before authentication one to limit requests rejected by authentication.
+	// Configuration must contain an ip limit.
+	IPMiddleware(cfg *config.ServerRateLimitConfig) (gin.HandlerFunc, error)
+	// HTTPMiddleware will return a middleware limiting requests of routes matching configuration.
+	// This middleware must be added after authentication one to limit per user.
+	HTTPMiddleware(cfg *config.ServerRateLimitConfig) (gin.HandlerFunc, error)
+	// GRPCIPUnaryInterceptor is the gRPC equivalent of IPMiddleware and is limiting calls per peer ip.
+	// Configuration must contain an ip limit.
+	GRPCIPUnaryInterceptor(cfg *config.ServerRateLimitConfig) (grpc.UnaryServerInterceptor, error)
+	// GRPCUnaryInterceptor is the gRPC equivalent of HTTPMiddleware.
+	// Route rules are matched against full method path (e.g. "/todos.v1.TodoService/CreateTodo") with POST method.
+	// This interceptor must be added after authentication one to limit per user.
+	GRPCUnaryInterceptor(cfg *config.ServerRateLimitConfig) (grpc.UnaryServerInterceptor, error)
+	// GraphqlMiddleware will return a graphql extension limiting operations matching configuration.
+	// This extension must be used with HTTP middleware that is computing request subject.
+	GraphqlMiddleware(cfg *config.ServerRateLimitConfig) (gqlgraphql.HandlerExtension, error)
+}
+
+// Limit is a token bucket definition.
+// Tokens are refilled at Count/Period rate and the bucket contains at most Burst tokens.
+type Limit struct {
+	Period time.Duration
+	Count  int
+	Burst  int
+}
+
+// Result is the result of a token consumption.
+type Result struct {
+	// RetryAfter is the duration to wait before a token is available when not allowed.
+	RetryAfter time.Duration
+	Allowed    bool
+}
+
+func NewService(db database.DB) Service {
+	return &service{
+		dbStore:  &dbStore{db: db},
+		memStore: newMemoryStore(),
+	}
+}
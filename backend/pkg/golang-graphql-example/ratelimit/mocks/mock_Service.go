@@ -0,0 +1,136 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/ratelimit (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/ratelimit Service
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	graphql "github.com/99designs/gqlgen/graphql"
+	gin "github.com/gin-gonic/gin"
+	config "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	ratelimit "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/ratelimit"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// GRPCIPUnaryInterceptor mocks base method.
+func (m *MockService) GRPCIPUnaryInterceptor(cfg *config.ServerRateLimitConfig) (grpc.UnaryServerInterceptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCIPUnaryInterceptor", cfg)
+	ret0, _ := ret[0].(grpc.UnaryServerInterceptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GRPCIPUnaryInterceptor indicates an expected call of GRPCIPUnaryInterceptor.
+func (mr *MockServiceMockRecorder) GRPCIPUnaryInterceptor(cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCIPUnaryInterceptor", reflect.TypeOf((*MockService)(nil).GRPCIPUnaryInterceptor), cfg)
+}
+
+// GRPCUnaryInterceptor mocks base method.
+func (m *MockService) GRPCUnaryInterceptor(cfg *config.ServerRateLimitConfig) (grpc.UnaryServerInterceptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCUnaryInterceptor", cfg)
+	ret0, _ := ret[0].(grpc.UnaryServerInterceptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GRPCUnaryInterceptor indicates an expected call of GRPCUnaryInterceptor.
+func (mr *MockServiceMockRecorder) GRPCUnaryInterceptor(cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCUnaryInterceptor", reflect.TypeOf((*MockService)(nil).GRPCUnaryInterceptor), cfg)
+}
+
+// GraphqlMiddleware mocks base method.
+func (m *MockService) GraphqlMiddleware(cfg *config.ServerRateLimitConfig) (graphql.HandlerExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GraphqlMiddleware", cfg)
+	ret0, _ := ret[0].(graphql.HandlerExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GraphqlMiddleware indicates an expected call of GraphqlMiddleware.
+func (mr *MockServiceMockRecorder) GraphqlMiddleware(cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GraphqlMiddleware", reflect.TypeOf((*MockService)(nil).GraphqlMiddleware), cfg)
+}
+
+// HTTPMiddleware mocks base method.
+func (m *MockService) HTTPMiddleware(cfg *config.ServerRateLimitConfig) (gin.HandlerFunc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HTTPMiddleware", cfg)
+	ret0, _ := ret[0].(gin.HandlerFunc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HTTPMiddleware indicates an expected call of HTTPMiddleware.
+func (mr *MockServiceMockRecorder) HTTPMiddleware(cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HTTPMiddleware", reflect.TypeOf((*MockService)(nil).HTTPMiddleware), cfg)
+}
+
+// IPMiddleware mocks base method.
+func (m *MockService) IPMiddleware(cfg *config.ServerRateLimitConfig) (gin.HandlerFunc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IPMiddleware", cfg)
+	ret0, _ := ret[0].(gin.HandlerFunc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IPMiddleware indicates an expected call of IPMiddleware.
+func (mr *MockServiceMockRecorder) IPMiddleware(cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IPMiddleware", reflect.TypeOf((*MockService)(nil).IPMiddleware), cfg)
+}
+
+// Take mocks base method.
+func (m *MockService) Take(ctx context.Context, storeType, key string, limit *ratelimit.Limit) (*ratelimit.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, storeType, key, limit)
+	ret0, _ := ret[0].(*ratelimit.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockServiceMockRecorder) Take(ctx, storeType, key, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockService)(nil).Take), ctx, storeType, key, limit)
+}
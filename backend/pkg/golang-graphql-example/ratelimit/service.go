@@ -0,0 +1,99 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+type contextKey struct {
+	name string
+}
+
+var subjectContextKey = &contextKey{name: "rate-limit-subject"}
+
+type service struct {
+	dbStore  store
+	memStore store
+}
+
+func (s *service) Take(ctx context.Context, storeType, key string, limit *Limit) (*Result, error) {
+	return s.getStore(storeType).take(ctx, key, limit, time.Now())
+}
+
+func (s *service) getStore(storeType string) store {
+	// Check if database store must be used
+	if storeType == config.ServerRateLimitStoreDatabase {
+		return s.dbStore
+	}
+
+	return s.memStore
+}
+
+// getSubjectFromContext will return the subject computed by HTTP middleware.
+func getSubjectFromContext(ctx context.Context) string {
+	// Get subject
+	subject, _ := ctx.Value(subjectContextKey).(string)
+	// Check if it exists
+	if subject != "" {
+		return subject
+	}
+
+	// Fallback on user
+	user := authentication.GetAuthenticatedUserFromContext(ctx)
+	if user != nil {
+		return "user:" + user.GetActor().GetIdentifier()
+	}
+
+	return "anonymous"
+}
+
+// computeSubject will return authenticated user subject or client ip subject when not authenticated.
+// Impersonation is counted on real user.
+func computeSubject(ctx context.Context, clientIP string) string {
+	// Get authenticated user
+	user := authentication.GetAuthenticatedUserFromContext(ctx)
+	if user != nil {
+		return "user:" + user.GetActor().GetIdentifier()
+	}
+
+	return "ip:" + clientIP
+}
+
+func setSubjectInContext(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, subjectContextKey, subject)
+}
+
+func parseLimit(period string, count, burst int) (*Limit, error) {
+	// Parse period
+	d, err := time.ParseDuration(period)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	// Check period
+	if d <= 0 {
+		return nil, errors.Errorf("rate limit period must be positive: %s", period)
+	}
+
+	return &Limit{Period: d, Count: count, Burst: burst}, nil
+}
+
+func retryAfterSeconds(d time.Duration) int {
+	return int(math.Max(1, math.Ceil(d.Seconds())))
+}
+
+func newRateLimitedError(retryAfter time.Duration) cerrors.Error {
+	return cerrors.NewTooManyRequestsError(
+		"rate limit exceeded",
+		cerrors.WithCode(RateLimitedErrorCode),
+		cerrors.WithPublicErrorMessage("rate limit exceeded"),
+		cerrors.AddExtension("retryAfter", retryAfterSeconds(retryAfter)),
+	)
+}
@@ -0,0 +1,142 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+)
+
+const (
+	// Full buckets are forgotten at this interval.
+	pruneInterval = time.Minute
+	// Maximum key length stored in database, longer keys are hashed.
+	maxDBKeyLength = 500
+)
+
+// store is the persistence layer of token buckets.
+type store interface {
+	take(ctx context.Context, key string, limit *Limit, now time.Time) (*Result, error)
+}
+
+type memoryStore struct {
+	lastPrune time.Time
+	buckets   map[string]*bucket
+	mutex     sync.Mutex
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{buckets: map[string]*bucket{}}
+}
+
+func (s *memoryStore) take(_ context.Context, key string, limit *Limit, now time.Time) (*Result, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Forget full buckets
+	if now.Sub(s.lastPrune) >= pruneInterval {
+		for k, b := range s.buckets {
+			if b.ExpiresAt.Before(now) {
+				delete(s.buckets, k)
+			}
+		}
+
+		s.lastPrune = now
+	}
+
+	// Get bucket
+	b, ok := s.buckets[key]
+	// Check if it exists
+	if !ok {
+		b = newBucket(key, limit, now)
+		s.buckets[key] = b
+	}
+
+	return b.take(limit, now), nil
+}
+
+// dbStore is sharing buckets between all replicas.
+type dbStore struct {
+	db        database.DB
+	lastPrune time.Time
+	mutex     sync.Mutex
+}
+
+func (s *dbStore) take(ctx context.Context, key string, limit *Limit, now time.Time) (*Result, error) {
+	// Forget full buckets
+	err := s.prune(ctx, now)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Hash too long keys
+	if len(key) > maxDBKeyLength {
+		h := sha256.Sum256([]byte(key))
+		key = hex.EncodeToString(h[:])
+	}
+
+	var res *Result
+
+	err = s.db.GetGormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Create bucket if it doesn't exist
+		err2 := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(newBucket(key, limit, now)).Error
+		// Check error
+		if err2 != nil {
+			return errors.WithStack(err2)
+		}
+
+		// Lock row to serialize token consumption between replicas
+		q := tx
+		if tx.Dialector.Name() == "postgres" {
+			q = q.Clauses(clause.Locking{Strength: "UPDATE"})
+		}
+
+		// Get bucket
+		b := &bucket{}
+
+		err2 = q.Where(clause.Eq{Column: clause.Column{Name: "key"}, Value: key}).First(b).Error
+		// Check error
+		if err2 != nil {
+			return errors.WithStack(err2)
+		}
+
+		// Consume token
+		res = b.take(limit, now)
+
+		return errors.WithStack(tx.Save(b).Error)
+	})
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *dbStore) prune(ctx context.Context, now time.Time) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Check if prune is needed
+	if now.Sub(s.lastPrune) < pruneInterval {
+		return nil
+	}
+
+	err := s.db.GetGormDB().WithContext(ctx).Where("expires_at < ?", now).Delete(&bucket{}).Error
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	s.lastPrune = now
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+const csrfTokenRandomBytesLength = 32
+
+func manageCSRF(router gin.IRoutes, cfg *config.ServerConfig) {
+	// If csrf configuration exists, apply csrf middleware
+	if cfg.CSRF != nil {
+		router.Use(csrfMiddleware(cfg.CSRF))
+	}
+}
+
+// CSRF protection is based on a required custom header (browsers cannot send it cross origin
+// without a CORS preflight) or on a double submit cookie that must be copied in this header.
+// Safe methods aren't checked: GraphQL GET transport only allows queries.
+func csrfMiddleware(cfg *config.ServerCSRFConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Get logger
+		logger := log.GetLoggerFromGin(c)
+
+		cookieToken := ""
+		// Check if double submit cookie must be managed
+		if cfg.Mode == config.ServerCSRFModeDoubleSubmit {
+			var err error
+			// Get or issue cookie
+			cookieToken, err = getOrSetCSRFCookie(c, cfg)
+			// Check error
+			if err != nil {
+				logger.Error(err)
+				utils.AnswerWithError(c, err)
+
+				return
+			}
+		}
+
+		// Check if request must be checked
+		if isCSRFSafeMethod(c.Request.Method) || isCSRFExemptRequest(c.Request) {
+			c.Next()
+
+			return
+		}
+
+		// Get header token
+		headerToken := c.GetHeader(cfg.HeaderName)
+
+		// Check token
+		valid := headerToken != ""
+		if cfg.Mode == config.ServerCSRFModeDoubleSubmit {
+			valid = valid && subtle.ConstantTimeCompare([]byte(headerToken), []byte(cookieToken)) == 1
+		}
+
+		if !valid {
+			err := cerrors.NewForbiddenError("csrf validation failed")
+
+			logger.Error(err)
+			utils.AnswerWithError(c, err)
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func getOrSetCSRFCookie(c *gin.Context, cfg *config.ServerCSRFConfig) (string, error) {
+	// Get cookie
+	cookie, err := c.Request.Cookie(cfg.CookieName)
+	// Check if cookie exists
+	if err == nil && cookie.Value != "" {
+		return cookie.Value, nil
+	}
+
+	// Generate token
+	b := make([]byte, csrfTokenRandomBytesLength)
+
+	_, err = rand.Read(b)
+	// Check error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	// Cookie must be readable by javascript to be copied in header
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     cfg.CookieName,
+		Value:    token,
+		Path:     "/",
+		Secure:   cfg.CookieSecure,
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	return token, nil
+}
+
+func isCSRFSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// Bearer tokens and API keys are never sent automatically by browsers.
+func isCSRFExemptRequest(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") ||
+		r.Header.Get(authentication.APITokenHeaderName) != ""
+}
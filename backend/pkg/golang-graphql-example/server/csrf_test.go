@@ -0,0 +1,217 @@
+//go:build unit
+
+package server
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/generated"
+)
+
+func newCSRFTestRouter(cfg *config.ServerCSRFConfig) *gin.Engine {
+	h := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: &graphql.Resolver{}}))
+	h.AddTransport(transport.GET{})
+	h.AddTransport(transport.POST{})
+	h.AddTransport(transport.MultipartForm{})
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		log.SetLoggerToGin(c, log.NewLogger())
+	})
+	manageCSRF(router, &config.ServerConfig{CSRF: cfg})
+
+	gqlHandler := gin.WrapH(h)
+	router.POST("/api/graphql", gqlHandler)
+	router.GET("/api/graphql", graphqlGETHandler(gqlHandler, func(c *gin.Context) {
+		c.String(http.StatusOK, "playground")
+	}))
+
+	return router
+}
+
+func newCSRFMultipartRequest(t *testing.T) *http.Request {
+	t.Helper()
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	require.NoError(t, w.WriteField("operations", `{"query":"mutation { __typename }"}`))
+	require.NoError(t, w.WriteField("map", `{}`))
+	require.NoError(t, w.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/graphql", body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	return req
+}
+
+func newCSRFPostRequest() *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/graphql", strings.NewReader(`{"query":"mutation { __typename }"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	return req
+}
+
+func Test_csrfMiddleware_header(t *testing.T) {
+	tests := []struct {
+		name         string
+		cfg          *config.ServerCSRFConfig
+		req          func(t *testing.T) *http.Request
+		headers      map[string]string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "disabled",
+			req:          func(t *testing.T) *http.Request { return newCSRFMultipartRequest(t) },
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "post without header",
+			cfg:          &config.ServerCSRFConfig{Mode: config.ServerCSRFModeHeader, HeaderName: "X-CSRF-Token"},
+			req:          func(_ *testing.T) *http.Request { return newCSRFPostRequest() },
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "multipart without header",
+			cfg:          &config.ServerCSRFConfig{Mode: config.ServerCSRFModeHeader, HeaderName: "X-CSRF-Token"},
+			req:          func(t *testing.T) *http.Request { return newCSRFMultipartRequest(t) },
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "multipart with header",
+			cfg:          &config.ServerCSRFConfig{Mode: config.ServerCSRFModeHeader, HeaderName: "X-CSRF-Token"},
+			req:          func(t *testing.T) *http.Request { return newCSRFMultipartRequest(t) },
+			headers:      map[string]string{"X-CSRF-Token": "1"},
+			expectedCode: http.StatusOK,
+			expectedBody: `"__typename":"Mutation"`,
+		},
+		{
+			name:         "post with bearer",
+			cfg:          &config.ServerCSRFConfig{Mode: config.ServerCSRFModeHeader, HeaderName: "X-CSRF-Token"},
+			req:          func(_ *testing.T) *http.Request { return newCSRFPostRequest() },
+			headers:      map[string]string{"Authorization": "Bearer TOKEN"},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "post with api key",
+			cfg:          &config.ServerCSRFConfig{Mode: config.ServerCSRFModeHeader, HeaderName: "X-CSRF-Token"},
+			req:          func(_ *testing.T) *http.Request { return newCSRFPostRequest() },
+			headers:      map[string]string{"X-API-Key": "ggepat_TOKEN"},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "get query",
+			cfg:  &config.ServerCSRFConfig{Mode: config.ServerCSRFModeHeader, HeaderName: "X-CSRF-Token"},
+			req: func(_ *testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/api/graphql?query="+url.QueryEscape("{ __typename }"), nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"__typename":"Query"`,
+		},
+		{
+			name: "get mutation",
+			cfg:  &config.ServerCSRFConfig{Mode: config.ServerCSRFModeHeader, HeaderName: "X-CSRF-Token"},
+			req: func(_ *testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/api/graphql?query="+url.QueryEscape("mutation { __typename }"), nil)
+			},
+			expectedCode: http.StatusNotAcceptable,
+		},
+		{
+			name: "get playground",
+			cfg:  &config.ServerCSRFConfig{Mode: config.ServerCSRFModeHeader, HeaderName: "X-CSRF-Token"},
+			req: func(_ *testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/api/graphql", nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "playground",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newCSRFTestRouter(tt.cfg)
+
+			req := tt.req(t)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func Test_csrfMiddleware_doubleSubmit(t *testing.T) {
+	router := newCSRFTestRouter(&config.ServerCSRFConfig{
+		Mode:       config.ServerCSRFModeDoubleSubmit,
+		HeaderName: "X-CSRF-Token",
+		CookieName: "csrf_token",
+	})
+
+	// Cookie is issued on safe request
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/graphql", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 1)
+
+	cookie := cookies[0]
+	assert.Equal(t, "csrf_token", cookie.Name)
+	assert.NotEmpty(t, cookie.Value)
+	assert.False(t, cookie.HttpOnly)
+	assert.Equal(t, http.SameSiteStrictMode, cookie.SameSite)
+
+	// Cookie isn't issued again
+	req := httptest.NewRequest(http.MethodGet, "/api/graphql", nil)
+	req.AddCookie(cookie)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Empty(t, w.Result().Cookies())
+
+	// Header without cookie
+	req = newCSRFMultipartRequest(t)
+	req.Header.Set("X-CSRF-Token", cookie.Value)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	// Wrong header
+	req = newCSRFMultipartRequest(t)
+	req.AddCookie(cookie)
+	req.Header.Set("X-CSRF-Token", "wrong")
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	// Valid
+	req = newCSRFMultipartRequest(t)
+	req.AddCookie(cookie)
+	req.Header.Set("X-CSRF-Token", cookie.Value)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"__typename":"Mutation"`)
+}
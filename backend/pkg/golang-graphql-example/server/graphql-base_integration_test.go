@@ -26,8 +26,10 @@ import (
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
 	lockdistributor "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/lockdistributor/sql"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/ratelimit"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/signalhandler"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/tracing"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/trusteddocuments"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
@@ -79,12 +81,15 @@ func (suite *GraphQLTestSuite) SetupSuite() {
 	// Create authorization service
 	authoCl := authorization.NewService(cfgManagerMock)
 	// Create services
-	bSvc := business.NewServices(logger, db, authoCl)
+	bSvc := business.NewServices(logger, cfgManagerMock, db, authoCl)
 	// Migrate
 	err = bSvc.MigrateDB(context.TODO())
 	suite.NoError(err)
 	// Create server
-	s := NewServer(logger, cfgManagerMock, metricsCtx, tracingSvc, bSvc, authCl, authoCl, signalHandlerSvc)
+	s := NewServer(
+		logger, cfgManagerMock, metricsCtx, tracingSvc, bSvc, authCl, authoCl,
+		signalHandlerSvc, ratelimit.NewService(db), trusteddocuments.NewService(db),
+	)
 	// Create handler
 	got, err := s.generateRouter()
 	suite.NoError(err)
@@ -98,53 +103,7 @@ func (suite *GraphQLTestSuite) SetupSuite() {
 	// Create graphql client
 	gcl := graphql.NewClient(svr.URL+"/api/graphql", svr.Client())
 	// Add request modifier to add authentication
-	gcl = gcl.WithRequestModifier(func(req *http.Request) {
-		data := url.Values{}
-		data.Set("username", "user")
-		data.Set("password", "password")
-		data.Set("client_id", integrationTestsCfg.OIDCAuthentication.ClientID)
-		data.Set("grant_type", "password")
-		data.Set("scope", "openid profile")
-
-		authentUrlStr := integrationTestsCfg.OIDCAuthentication.IssuerURL + "/protocol/openid-connect/token"
-
-		clientAuth := &http.Client{}
-		r, err := http.NewRequest("POST", authentUrlStr, strings.NewReader(data.Encode())) // URL-encoded payload
-		// Check err
-		suite.NoError(err)
-
-		r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-		r.Header.Add("Content-Length", strconv.Itoa(len(data.Encode())))
-
-		resp, err := clientAuth.Do(r)
-		// Check err
-		suite.NoError(err)
-
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			suite.NoError(err)
-			return
-		}
-		body := string(bodyBytes)
-
-		// Check response
-		if resp.StatusCode != 200 {
-			suite.Fail(fmt.Sprintf("%d - %s", resp.StatusCode, body))
-			return
-		}
-
-		type tokensResponseBody struct {
-			IDToken string `json:"id_token"`
-		}
-
-		var to tokensResponseBody
-		// Parse token
-		err = json.Unmarshal(bodyBytes, &to)
-		suite.NoError(err)
-
-		// Add header to request
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", to.IDToken))
-	})
+	gcl = gcl.WithRequestModifier(suite.authenticateRequest)
 
 	// Save data
 	suite.testServer = svr
@@ -153,6 +112,55 @@ func (suite *GraphQLTestSuite) SetupSuite() {
 	suite.busiServices = bSvc
 }
 
+// authenticateRequest will get an ID token for test user and add it in request authorization header.
+func (suite *GraphQLTestSuite) authenticateRequest(req *http.Request) {
+	data := url.Values{}
+	data.Set("username", "user")
+	data.Set("password", "password")
+	data.Set("client_id", integrationTestsCfg.OIDCAuthentication.ClientID)
+	data.Set("grant_type", "password")
+	data.Set("scope", "openid profile")
+
+	authentUrlStr := integrationTestsCfg.OIDCAuthentication.IssuerURL + "/protocol/openid-connect/token"
+
+	clientAuth := &http.Client{}
+	r, err := http.NewRequest("POST", authentUrlStr, strings.NewReader(data.Encode())) // URL-encoded payload
+	// Check err
+	suite.NoError(err)
+
+	r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Add("Content-Length", strconv.Itoa(len(data.Encode())))
+
+	resp, err := clientAuth.Do(r)
+	// Check err
+	suite.NoError(err)
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		suite.NoError(err)
+		return
+	}
+	body := string(bodyBytes)
+
+	// Check response
+	if resp.StatusCode != 200 {
+		suite.Fail(fmt.Sprintf("%d - %s", resp.StatusCode, body))
+		return
+	}
+
+	type tokensResponseBody struct {
+		IDToken string `json:"id_token"`
+	}
+
+	var to tokensResponseBody
+	// Parse token
+	err = json.Unmarshal(bodyBytes, &to)
+	suite.NoError(err)
+
+	// Add header to request
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", to.IDToken))
+}
+
 // this function executes after all tests executed
 func (suite *GraphQLTestSuite) TearDownSuite() {
 	fmt.Println("TearDownSuite phase")
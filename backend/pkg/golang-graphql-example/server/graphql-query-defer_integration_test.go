@@ -0,0 +1,247 @@
+//go:build integration
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/mappers"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+)
+
+const (
+	sseAcceptHeader       = "text/event-stream"
+	multipartAcceptHeader = "multipart/mixed; deferSpec=20220824"
+)
+
+// incrementalResult is an initial or a deferred result of an incremental delivery response.
+type incrementalResult struct {
+	Data    json.RawMessage `json:"data"`
+	Label   string          `json:"label,omitempty"`
+	Path    []any           `json:"path,omitempty"`
+	HasNext *bool           `json:"hasNext,omitempty"`
+	Errors  json.RawMessage `json:"errors,omitempty"`
+	// Incremental contains deferred results batched by multipart transport
+	Incremental []*incrementalResult `json:"incremental,omitempty"`
+}
+
+// execIncremental will send a GraphQL query with an Accept header selecting an incremental delivery transport
+// and will return the initial result followed by all deferred results.
+func (suite *GraphQLTestSuite) execIncremental(accept, query string) []*incrementalResult {
+	body, err := json.Marshal(map[string]any{"query": query})
+	suite.Require().NoError(err)
+
+	req, err := http.NewRequestWithContext(
+		context.TODO(),
+		http.MethodPost,
+		suite.testServer.URL+"/api/graphql",
+		bytes.NewReader(body),
+	)
+	suite.Require().NoError(err)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", accept)
+	suite.authenticateRequest(req)
+
+	resp, err := suite.testServer.Client().Do(req)
+	suite.Require().NoError(err)
+
+	defer resp.Body.Close()
+
+	suite.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	// Payloads are JSON lines for both transports:
+	// "data: {...}" in server sent events and "{...}" between multipart boundaries
+	payloads := []*incrementalResult{}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := strings.TrimPrefix(strings.TrimSpace(scanner.Text()), "data: ")
+		if !strings.HasPrefix(line, "{") {
+			continue
+		}
+
+		var p incrementalResult
+
+		suite.Require().NoError(json.Unmarshal([]byte(line), &p))
+
+		payloads = append(payloads, &p)
+	}
+
+	suite.Require().NoError(scanner.Err())
+	suite.Require().NotEmpty(payloads)
+
+	// Flatten batched results
+	res := []*incrementalResult{payloads[0]}
+	for _, p := range payloads[1:] {
+		if p.Incremental != nil {
+			res = append(res, p.Incremental...)
+		} else {
+			res = append(res, p)
+		}
+	}
+
+	return res
+}
+
+// resultPath will return JSON representation of deferred result path.
+func (suite *GraphQLTestSuite) resultPath(r *incrementalResult) string {
+	b, err := json.Marshal(r.Path)
+	suite.Require().NoError(err)
+
+	return string(b)
+}
+
+// recordTodoSelects will record SQL of todo select queries (count queries are ignored).
+func (suite *GraphQLTestSuite) recordTodoSelects() (func() []string, func()) {
+	var (
+		mu  sync.Mutex
+		res []string
+	)
+
+	gdb := suite.db.GetGormDB()
+	suite.NoError(gdb.Callback().Query().After("gorm:query").Register("test:record_todos", func(db *gorm.DB) {
+		sql := db.Statement.SQL.String()
+		if db.Statement.Table == "todos" && !strings.Contains(strings.ToLower(sql), "count(") {
+			mu.Lock()
+			res = append(res, sql)
+			mu.Unlock()
+		}
+	}))
+
+	get := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return res
+	}
+
+	cleanup := func() {
+		suite.NoError(gdb.Callback().Query().Remove("test:record_todos"))
+	}
+
+	return get, cleanup
+}
+
+// deferredCreatedAt will return created at date contained in deferred result data.
+func (suite *GraphQLTestSuite) deferredCreatedAt(data string) time.Time {
+	var res struct {
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	suite.Require().NoError(json.Unmarshal([]byte(data), &res))
+
+	return res.CreatedAt
+}
+
+// Only fields with a resolver (like relay id or dates) are deferred by gqlgen,
+// others are resolved from parent object in initial result.
+func (suite *GraphQLTestSuite) TestQueryTodosDefer() {
+	id1 := "00000000-0000-0000-0000-000000000001"
+	id2 := "00000000-0000-0000-0000-000000000002"
+	date1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	date2 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	suite.setupGenericDataset([]interface{}{
+		&models.Todo{Base: database.Base{ID: id1, CreatedAt: date1}, Text: "todo 1"},
+		&models.Todo{Base: database.Base{ID: id2, CreatedAt: date2}, Text: "todo 2", Done: true},
+	})
+
+	query := `{ todos(sorts: [{ text: ASC }]) { edges { node { id text ... @defer(label: "details") { createdAt } } } } }`
+
+	tests := []struct {
+		name   string
+		accept string
+	}{
+		{name: "server sent events", accept: sseAcceptHeader},
+		{name: "multipart", accept: multipartAcceptHeader},
+	}
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			selects, cleanup := suite.recordTodoSelects()
+			defer cleanup()
+
+			res := suite.execIncremental(tt.accept, query)
+			suite.Require().Len(res, 3)
+
+			// Deferred fields are null in initial result
+			suite.Empty(res[0].Errors)
+			suite.Require().NotNil(res[0].HasNext)
+			suite.True(*res[0].HasNext)
+			suite.JSONEq(fmt.Sprintf(
+				`{"todos":{"edges":[{"node":{"id":%q,"text":"todo 1","createdAt":null}},{"node":{"id":%q,"text":"todo 2","createdAt":null}}]}}`,
+				utils.ToIDRelay(mappers.TodoIDPrefix, id1),
+				utils.ToIDRelay(mappers.TodoIDPrefix, id2),
+			), string(res[0].Data))
+
+			// Deferred results are sent in any order
+			deferred := map[string]string{}
+			for _, it := range res[1:] {
+				suite.Empty(it.Errors)
+				suite.Equal("details", it.Label)
+				deferred[suite.resultPath(it)] = string(it.Data)
+			}
+
+			suite.Len(deferred, 2)
+			suite.True(date1.Equal(suite.deferredCreatedAt(deferred[`["todos","edges",0,"node"]`])))
+			suite.True(date2.Equal(suite.deferredCreatedAt(deferred[`["todos","edges",1,"node"]`])))
+
+			// Deferred fields are part of the projection so they are loaded with the list
+			suite.Require().Len(selects(), 1)
+			suite.Contains(selects()[0], "created_at")
+		})
+	}
+}
+
+func (suite *GraphQLTestSuite) TestQueryTodoDeferWithDataloader() {
+	id1 := "00000000-0000-0000-0000-000000000001"
+	id2 := "00000000-0000-0000-0000-000000000002"
+	date1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	date2 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	suite.setupGenericDataset([]interface{}{
+		&models.Todo{Base: database.Base{ID: id1, CreatedAt: date1}, Text: "todo 1"},
+		&models.Todo{Base: database.Base{ID: id2, CreatedAt: date2}, Text: "todo 2", Done: true},
+	})
+
+	selects, cleanup := suite.recordTodoSelects()
+	defer cleanup()
+
+	// Both todos are loaded in one batch by dataloader and deferred fields are resolved from loaded objects
+	res := suite.execIncremental(multipartAcceptHeader, fmt.Sprintf(
+		`{ t1: todo(id: %q) { text ... @defer { createdAt } } t2: todo(id: %q) { text ...F @defer(label: "f") } } fragment F on Todo { createdAt }`,
+		utils.ToIDRelay(mappers.TodoIDPrefix, id1),
+		utils.ToIDRelay(mappers.TodoIDPrefix, id2),
+	))
+	suite.Require().Len(res, 3)
+
+	suite.Empty(res[0].Errors)
+	suite.JSONEq(
+		`{"t1":{"text":"todo 1","createdAt":null},"t2":{"text":"todo 2","createdAt":null}}`,
+		string(res[0].Data),
+	)
+
+	deferred := map[string]string{}
+	for _, it := range res[1:] {
+		suite.Empty(it.Errors)
+		deferred[it.Label+suite.resultPath(it)] = string(it.Data)
+	}
+
+	suite.True(date1.Equal(suite.deferredCreatedAt(deferred[`["t1"]`])))
+	suite.True(date2.Equal(suite.deferredCreatedAt(deferred[`f["t2"]`])))
+
+	suite.Require().Len(selects(), 1)
+	suite.Contains(selects()[0], "created_at")
+}
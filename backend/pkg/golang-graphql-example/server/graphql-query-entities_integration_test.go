@@ -0,0 +1,92 @@
+//go:build integration
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync/atomic"
+
+	"gorm.io/gorm"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/mappers"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+)
+
+func (suite *GraphQLTestSuite) TestQueryEntities() {
+	suite.setupGenericDataset([]interface{}{
+		&models.Todo{Base: database.Base{ID: "00000000-0000-0000-0000-000000000001"}, Text: "todo 1"},
+		&models.Todo{Base: database.Base{ID: "00000000-0000-0000-0000-000000000002"}, Text: "todo 2", Done: true},
+		&models.Todo{Base: database.Base{ID: "00000000-0000-0000-0000-000000000003"}, Text: "todo 3"},
+	})
+
+	// Count todo queries to ensure that representations are resolved in batch
+	var queryCount int64
+
+	gdb := suite.db.GetGormDB()
+	suite.NoError(gdb.Callback().Query().After("gorm:query").Register("test:count_todos", func(db *gorm.DB) {
+		if db.Statement.Table == "todos" {
+			atomic.AddInt64(&queryCount, 1)
+		}
+	}))
+
+	defer func() {
+		suite.NoError(gdb.Callback().Query().Remove("test:count_todos"))
+	}()
+
+	representations := []map[string]any{}
+	for _, id := range []string{
+		"00000000-0000-0000-0000-000000000003",
+		"00000000-0000-0000-0000-000000000099",
+		"00000000-0000-0000-0000-000000000001",
+		"00000000-0000-0000-0000-000000000002",
+	} {
+		representations = append(representations, map[string]any{
+			"__typename": "Todo",
+			"id":         utils.ToIDRelay(mappers.TodoIDPrefix, id),
+		})
+	}
+
+	raw, err := suite.graphqlClient.ExecRaw(
+		context.TODO(),
+		`query ($representations: [_Any!]!) { _entities(representations: $representations) { ... on Todo { id text done } } }`,
+		map[string]any{"representations": representations},
+	)
+	suite.NoError(err)
+
+	type todo struct {
+		ID   string `json:"id"`
+		Text string `json:"text"`
+		Done bool   `json:"done"`
+	}
+
+	var res struct {
+		Entities []*todo `json:"_entities"`
+	}
+
+	suite.NoError(json.Unmarshal(raw, &res))
+	suite.Equal([]*todo{
+		{ID: representations[0]["id"].(string), Text: "todo 3"},
+		nil,
+		{ID: representations[2]["id"].(string), Text: "todo 1"},
+		{ID: representations[3]["id"].(string), Text: "todo 2", Done: true},
+	}, res.Entities)
+	suite.Equal(int64(1), atomic.LoadInt64(&queryCount))
+}
+
+func (suite *GraphQLTestSuite) TestQueryService() {
+	raw, err := suite.graphqlClient.ExecRaw(context.TODO(), `{ _service { sdl } }`, nil)
+	suite.NoError(err)
+
+	var res struct {
+		Service struct {
+			SDL string `json:"sdl"`
+		} `json:"_service"`
+	}
+
+	suite.NoError(json.Unmarshal(raw, &res))
+	suite.True(strings.Contains(res.Service.SDL, `type Todo @key(fields: "id")`))
+}
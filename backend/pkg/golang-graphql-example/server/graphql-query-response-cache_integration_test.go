@@ -0,0 +1,125 @@
+//go:build integration
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/hasura/go-graphql-client"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authorization"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/ratelimit"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/mappers"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/signalhandler"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/tracing"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/trusteddocuments"
+)
+
+func (suite *GraphQLTestSuite) TestQueryResponseCacheInvalidatedByRESTMutations() {
+	// Create configuration with response cache enabled
+	cfg := *integrationTestsCfg
+	serverCfg := *cfg.Server
+	serverCfg.ResponseCache = &config.ServerResponseCacheConfig{MaxEntries: 10}
+	cfg.Server = &serverCfg
+
+	ctrl := gomock.NewController(suite.T())
+	cfgManagerMock := cmocks.NewMockManager(ctrl)
+	cfgManagerMock.EXPECT().GetConfig().AnyTimes().Return(&cfg)
+
+	// Create server using same business services
+	logger := log.NewLogger()
+	tracingSvc := tracing.New(cfgManagerMock, logger)
+	suite.NoError(tracingSvc.InitializeAndReload())
+
+	s := NewServer(
+		logger, cfgManagerMock, metricsCtx, tracingSvc, suite.busiServices,
+		authentication.NewService(cfgManagerMock), authorization.NewService(cfgManagerMock),
+		signalhandler.NewService(logger, false, []os.Signal{syscall.SIGTERM, syscall.SIGINT}),
+		ratelimit.NewService(suite.db), trusteddocuments.NewService(suite.db),
+	)
+	h, err := s.generateRouter()
+	suite.NoError(err)
+
+	svr := httptest.NewServer(h)
+	defer svr.Close()
+
+	gcl := graphql.NewClient(svr.URL+"/api/graphql", svr.Client()).WithRequestModifier(suite.authenticateRequest)
+
+	// Create todo with REST API
+	var created struct {
+		ID string `json:"id"`
+	}
+
+	suite.callREST(svr, http.MethodPost, "/api/v1/todos", `{"text":"before"}`, http.StatusCreated, &created)
+
+	// Read todos with GraphQL API and fill response cache
+	query := func() string {
+		raw, err := gcl.ExecRaw(context.TODO(), `{ todos { edges { node { id text } } } }`, nil)
+		suite.NoError(err)
+
+		var res struct {
+			Todos struct {
+				Edges []struct {
+					Node struct {
+						ID   string `json:"id"`
+						Text string `json:"text"`
+					} `json:"node"`
+				} `json:"edges"`
+			} `json:"todos"`
+		}
+
+		suite.NoError(json.Unmarshal(raw, &res))
+		suite.Len(res.Todos.Edges, 1)
+		suite.Equal(created.ID, res.Todos.Edges[0].Node.ID)
+
+		return res.Todos.Edges[0].Node.Text
+	}
+
+	suite.Equal("before", query())
+
+	// Change database without any API call to ensure that response is coming from cache
+	uuid, err := utils.FromIDRelay(created.ID, mappers.TodoIDPrefix)
+	suite.NoError(err)
+	suite.NoError(suite.db.GetGormDB().Model(&models.Todo{}).Where("id = ?", uuid).Update("text", "database").Error)
+
+	suite.Equal("before", query())
+
+	// Update todo with REST API
+	suite.callREST(svr, http.MethodPut, "/api/v1/todos/"+created.ID, `{"text":"after"}`, http.StatusOK, nil)
+
+	// Cached response must have been invalidated
+	suite.Equal("after", query())
+}
+
+// callREST will call REST API as authenticated test user and decode response body.
+func (suite *GraphQLTestSuite) callREST(svr *httptest.Server, method, path, body string, expectedStatus int, out any) {
+	req, err := http.NewRequest(method, svr.URL+path, strings.NewReader(body))
+	suite.NoError(err)
+
+	req.Header.Set("Content-Type", "application/json")
+	suite.authenticateRequest(req)
+
+	resp, err := svr.Client().Do(req)
+	suite.NoError(err)
+
+	defer resp.Body.Close()
+
+	suite.Equal(expectedStatus, resp.StatusCode)
+
+	if out != nil {
+		suite.NoError(json.NewDecoder(resp.Body).Decode(out))
+	}
+}
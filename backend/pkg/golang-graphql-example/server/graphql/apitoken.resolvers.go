@@ -0,0 +1,110 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.84
+
+import (
+	"context"
+	"time"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/models"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/generated"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/mappers"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/model"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+)
+
+// ID is the resolver for the id field.
+func (r *aPITokenResolver) ID(ctx context.Context, obj *models.APIToken) (string, error) {
+	return utils.ToIDRelay(mappers.APITokenIDPrefix, obj.ID), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *aPITokenResolver) CreatedAt(ctx context.Context, obj *models.APIToken, format *utils.DateFormat) (string, error) {
+	return utils.FormatTime(format, obj.CreatedAt), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *aPITokenResolver) UpdatedAt(ctx context.Context, obj *models.APIToken, format *utils.DateFormat) (string, error) {
+	return utils.FormatTime(format, obj.UpdatedAt), nil
+}
+
+// ExpiresAt is the resolver for the expiresAt field.
+func (r *aPITokenResolver) ExpiresAt(ctx context.Context, obj *models.APIToken, format *utils.DateFormat) (*string, error) {
+	return utils.FormatOptionalTime(format, obj.ExpiresAt), nil
+}
+
+// LastUsedAt is the resolver for the lastUsedAt field.
+func (r *aPITokenResolver) LastUsedAt(ctx context.Context, obj *models.APIToken, format *utils.DateFormat) (*string, error) {
+	return utils.FormatOptionalTime(format, obj.LastUsedAt), nil
+}
+
+// RevokedAt is the resolver for the revokedAt field.
+func (r *aPITokenResolver) RevokedAt(ctx context.Context, obj *models.APIToken, format *utils.DateFormat) (*string, error) {
+	return utils.FormatOptionalTime(format, obj.RevokedAt), nil
+}
+
+// CreateAPIToken is the resolver for the createAPIToken field.
+func (r *mutationResolver) CreateAPIToken(ctx context.Context, input model.NewAPIToken) (*model.CreatedAPIToken, error) {
+	inp := &apitokens.InputCreateAPIToken{Name: input.Name, Scopes: input.Scopes}
+
+	// Parse expiration date
+	if input.ExpiresAt != nil {
+		ti, err := time.Parse(time.RFC3339, *input.ExpiresAt)
+		// Check error
+		if err != nil {
+			return nil, cerrors.NewInvalidInputErrorWithError(err)
+		}
+
+		inp.ExpiresAt = &ti
+	}
+
+	tt, token, err := r.BusiServices.APITokenSvc.Create(ctx, inp)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CreatedAPIToken{Token: token, APIToken: tt}, nil
+}
+
+// RevokeAPIToken is the resolver for the revokeAPIToken field.
+func (r *mutationResolver) RevokeAPIToken(ctx context.Context, id string) (*models.APIToken, error) {
+	// Manage relay id
+	bid, err := utils.FromIDRelay(id, mappers.APITokenIDPrefix)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get projection
+	proj := &models.Projection{}
+	err = utils.ManageSimpleProjection(ctx, proj)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.BusiServices.APITokenSvc.Revoke(ctx, bid, proj)
+}
+
+// APITokens is the resolver for the apiTokens field.
+func (r *queryResolver) APITokens(ctx context.Context) ([]*models.APIToken, error) {
+	// Get projection
+	proj := &models.Projection{}
+	err := utils.ManageSimpleProjection(ctx, proj)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.BusiServices.APITokenSvc.FindForCurrentUser(ctx, proj)
+}
+
+// APIToken returns generated.APITokenResolver implementation.
+func (r *Resolver) APIToken() generated.APITokenResolver { return &aPITokenResolver{r} }
+
+type aPITokenResolver struct{ *Resolver }
@@ -0,0 +1,93 @@
+package cachecontrol
+
+import (
+	"time"
+
+	"emperror.dev/errors"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/samber/lo"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+)
+
+type cacheEntry struct {
+	response  *gqlgraphql.Response
+	expiresAt time.Time
+	tags      []string
+}
+
+// Cache is an in-memory response cache with tag based invalidation.
+type Cache struct {
+	entries *lru.Cache[string, *cacheEntry]
+	now     func() time.Time
+}
+
+// NewCache will create a response cache keeping at most maxEntries responses.
+func NewCache(maxEntries int) (*Cache, error) {
+	entries, err := lru.New[string, *cacheEntry](maxEntries)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &Cache{entries: entries, now: time.Now}, nil
+}
+
+// Get will return a copy of a non expired cached response.
+func (c *Cache) Get(key string) *gqlgraphql.Response {
+	// Get entry
+	e, ok := c.entries.Get(key)
+	// Check if it exists
+	if !ok {
+		return nil
+	}
+
+	// Check if entry is expired
+	if !c.now().Before(e.expiresAt) {
+		c.entries.Remove(key)
+
+		return nil
+	}
+
+	return copyResponse(e.response)
+}
+
+// Set will save a copy of response for maxAge.
+func (c *Cache) Set(key string, resp *gqlgraphql.Response, maxAge time.Duration, tags []string) {
+	c.entries.Add(key, &cacheEntry{
+		response:  copyResponse(resp),
+		expiresAt: c.now().Add(maxAge),
+		tags:      tags,
+	})
+}
+
+// Invalidate will remove all entries having at least one of the tags.
+func (c *Cache) Invalidate(tags []string) {
+	for _, k := range c.entries.Keys() {
+		// Get entry without updating recentness
+		e, ok := c.entries.Peek(k)
+		// Check if entry must be removed
+		if ok && lo.Some(e.tags, tags) {
+			c.entries.Remove(k)
+		}
+	}
+}
+
+// Len will return the number of cached entries.
+func (c *Cache) Len() int {
+	return c.entries.Len()
+}
+
+// copyResponse will copy response to avoid extensions added on responses to be shared.
+func copyResponse(resp *gqlgraphql.Response) *gqlgraphql.Response {
+	res := *resp
+	// Check if extensions exist
+	if resp.Extensions != nil {
+		res.Extensions = make(map[string]any, len(resp.Extensions))
+		for k, v := range resp.Extensions {
+			res.Extensions[k] = v
+		}
+	}
+
+	return &res
+}
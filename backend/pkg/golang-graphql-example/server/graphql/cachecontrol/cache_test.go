@@ -0,0 +1,50 @@
+//go:build unit
+
+package cachecontrol
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+)
+
+func TestCache(t *testing.T) {
+	c, err := NewCache(10)
+	require.NoError(t, err)
+
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	resp := &gqlgraphql.Response{Data: json.RawMessage(`{"todos":null}`), Extensions: map[string]any{"a": 1}}
+
+	c.Set("todos", resp, 30*time.Second, []string{"Todo", "TodoConnection"})
+	c.Set("tokens", resp, 30*time.Second, []string{"APIToken"})
+
+	// Cached responses are copies
+	got := c.Get("todos")
+	require.NotNil(t, got)
+	assert.Equal(t, resp, got)
+
+	got.Extensions["b"] = 2
+	assert.Equal(t, map[string]any{"a": 1}, c.Get("todos").Extensions)
+
+	// Missing
+	assert.Nil(t, c.Get("missing"))
+
+	// Invalidation by tags
+	c.Invalidate([]string{"Todo"})
+	assert.Nil(t, c.Get("todos"))
+	assert.NotNil(t, c.Get("tokens"))
+	assert.Equal(t, 1, c.Len())
+
+	// Expiration
+	c.now = func() time.Time { return now.Add(30 * time.Second) }
+
+	assert.Nil(t, c.Get("tokens"))
+	assert.Equal(t, 0, c.Len())
+}
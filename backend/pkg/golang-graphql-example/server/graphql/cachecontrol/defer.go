@@ -0,0 +1,63 @@
+package cachecontrol
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	deferDirectiveName  = "defer"
+	deferIfArgumentName = "if"
+)
+
+// hasDeferredFragments will return true if selection set contains an active @defer fragment.
+// Those operations are answered in multiple payloads with incremental delivery.
+func hasDeferredFragments(set ast.SelectionSet, variables map[string]any) bool {
+	for _, it := range set {
+		switch s := it.(type) {
+		case *ast.Field:
+			if hasDeferredFragments(s.SelectionSet, variables) {
+				return true
+			}
+		case *ast.InlineFragment:
+			if isDeferred(s.Directives, variables) || hasDeferredFragments(s.SelectionSet, variables) {
+				return true
+			}
+		case *ast.FragmentSpread:
+			if isDeferred(s.Directives, variables) {
+				return true
+			}
+
+			if s.Definition != nil && hasDeferredFragments(s.Definition.SelectionSet, variables) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isDeferred(directives ast.DirectiveList, variables map[string]any) bool {
+	// Get directive
+	d := directives.ForName(deferDirectiveName)
+	// Check if directive exists
+	if d == nil {
+		return false
+	}
+
+	// Get if argument
+	arg := d.Arguments.ForName(deferIfArgumentName)
+	// Check if argument exists, default value is true
+	if arg == nil || arg.Value == nil {
+		return true
+	}
+
+	v, err := arg.Value.Value(variables)
+	// Check error
+	if err != nil {
+		return true
+	}
+
+	b, ok := v.(bool)
+
+	return !ok || b
+}
@@ -0,0 +1,62 @@
+//go:build unit
+
+package cachecontrol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_hasDeferredFragments(t *testing.T) {
+	schema := newTestExecutableSchema().Schema()
+
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]any
+		want      bool
+	}{
+		{
+			name:  "no defer",
+			query: "{ todos { edges { node { id ... on Todo { text } } } } }",
+		},
+		{
+			name:  "deferred inline fragment in nested field",
+			query: "{ todos { edges { node { id ... @defer { text } } } } }",
+			want:  true,
+		},
+		{
+			name:  "deferred fragment spread",
+			query: "{ todo(id: \"1\") { id ...F @defer(label: \"f\") } } fragment F on Todo { text }",
+			want:  true,
+		},
+		{
+			name:  "deferred fragment inside fragment",
+			query: "{ todo(id: \"1\") { ...F } } fragment F on Todo { id ... @defer { text } }",
+			want:  true,
+		},
+		{
+			name:  "disabled defer",
+			query: "{ todo(id: \"1\") { id ... @defer(if: false) { text } } }",
+		},
+		{
+			name:      "disabled defer with variable",
+			query:     "query ($d: Boolean!) { todo(id: \"1\") { id ... @defer(if: $d) { text } } }",
+			variables: map[string]any{"d": false},
+		},
+		{
+			name:      "enabled defer with variable",
+			query:     "query ($d: Boolean!) { todo(id: \"1\") { id ... @defer(if: $d) { text } } }",
+			variables: map[string]any{"d": true},
+			want:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := parseOperation(t, schema, tt.query)
+
+			assert.Equal(t, tt.want, hasDeferredFragments(op.SelectionSet, tt.variables))
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package cachecontrol
+
+// This package will manage GraphQL cache control hints computed from @cacheControl directives and the in-memory response cache.
@@ -0,0 +1,219 @@
+package cachecontrol
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/model"
+)
+
+const (
+	extensionName = "CacheControl"
+	// Cache-Control header name.
+	headerName = "Cache-Control"
+)
+
+type contextKey struct {
+	name string
+}
+
+var responseHeaderCtxKey = &contextKey{name: "cache-control-response-header"}
+
+// SetResponseHeaderToContext will save response headers in context to allow the Cache-Control header to be set.
+func SetResponseHeaderToContext(ctx context.Context, h http.Header) context.Context {
+	return context.WithValue(ctx, responseHeaderCtxKey, h)
+}
+
+func getResponseHeaderFromContext(ctx context.Context) http.Header {
+	res, _ := ctx.Value(responseHeaderCtxKey).(http.Header)
+
+	return res
+}
+
+// Extension will compute operation cache policy, set the Cache-Control header
+// and manage the response cache if one is given.
+type Extension struct {
+	cache *Cache
+	es    gqlgraphql.ExecutableSchema
+}
+
+var _ interface {
+	gqlgraphql.HandlerExtension
+	gqlgraphql.OperationContextMutator
+	gqlgraphql.ResponseInterceptor
+} = &Extension{}
+
+// NewExtension will create the cache control extension. Cache is optional.
+func NewExtension(cache *Cache) *Extension {
+	return &Extension{cache: cache}
+}
+
+// GetPolicy will return operation cache policy from context.
+func GetPolicy(ctx context.Context) *Policy {
+	// Get operation context
+	opCtx := gqlgraphql.GetOperationContext(ctx)
+	if opCtx == nil {
+		return nil
+	}
+
+	p, _ := opCtx.Stats.GetExtension(extensionName).(*Policy)
+
+	return p
+}
+
+func (*Extension) ExtensionName() string {
+	return extensionName
+}
+
+func (e *Extension) Validate(schema gqlgraphql.ExecutableSchema) error {
+	e.es = schema
+
+	return nil
+}
+
+func (e *Extension) MutateOperationContext(
+	_ context.Context,
+	opCtx *gqlgraphql.OperationContext,
+) *gqlerror.Error {
+	// Compute policy and save it
+	opCtx.Stats.SetExtension(extensionName, ComputePolicy(e.es.Schema(), opCtx.Operation))
+
+	return nil
+}
+
+func (e *Extension) InterceptResponse(ctx context.Context, next gqlgraphql.ResponseHandler) *gqlgraphql.Response {
+	// Get operation context and policy
+	opCtx := gqlgraphql.GetOperationContext(ctx)
+	policy := GetPolicy(ctx)
+	// Check if policy exists
+	if opCtx == nil || policy == nil {
+		return next(ctx)
+	}
+
+	switch opCtx.Operation.Operation {
+	case ast.Mutation:
+		// Get response
+		resp := next(ctx)
+		// Invalidate cached responses containing mutated types
+		if e.cache != nil {
+			e.cache.Invalidate(policy.Tags)
+		}
+
+		return resp
+	case ast.Query:
+		return e.interceptQuery(ctx, opCtx, policy, next)
+	default:
+		return next(ctx)
+	}
+}
+
+func (e *Extension) interceptQuery(
+	ctx context.Context,
+	opCtx *gqlgraphql.OperationContext,
+	policy *Policy,
+	next gqlgraphql.ResponseHandler,
+) *gqlgraphql.Response {
+	// Check if operation is answered with incremental delivery
+	// Response handler is called for each payload so they can't be cached and header is managed by transport
+	if hasDeferredFragments(opCtx.Operation.SelectionSet, opCtx.Variables) {
+		return next(ctx)
+	}
+
+	// Get cache key
+	key, cacheable := e.cacheKey(ctx, opCtx, policy)
+	// Check if response is cached
+	if cacheable {
+		if resp := e.cache.Get(key); resp != nil {
+			setHeader(ctx, policy)
+
+			return resp
+		}
+	}
+
+	// Get response
+	resp := next(ctx)
+	// Check if response can be cached
+	if resp == nil || len(resp.Errors) != 0 {
+		return resp
+	}
+
+	setHeader(ctx, policy)
+
+	if cacheable {
+		e.cache.Set(key, resp, time.Duration(policy.MaxAge)*time.Second, policy.Tags)
+	}
+
+	return resp
+}
+
+// cacheKey will return the response cache key and if response can be cached.
+func (e *Extension) cacheKey(ctx context.Context, opCtx *gqlgraphql.OperationContext, policy *Policy) (string, bool) {
+	// Check if cache is enabled and response is cacheable
+	if e.cache == nil || !policy.IsCacheable() {
+		return "", false
+	}
+
+	// Get variables
+	vars, err := json.Marshal(opCtx.Variables)
+	// Check error
+	if err != nil {
+		return "", false
+	}
+
+	h := sha256.New()
+	h.Write([]byte(opCtx.RawQuery))
+	h.Write([]byte{0})
+	h.Write([]byte(opCtx.OperationName))
+	h.Write([]byte{0})
+	h.Write(vars)
+
+	// Private responses are cached per user
+	if policy.Scope == model.CacheControlScopePrivate {
+		// Get user
+		user := authentication.GetAuthenticatedUserFromContext(ctx)
+		// Check if user exists
+		if user == nil {
+			return "", false
+		}
+
+		h.Write([]byte{0})
+		h.Write([]byte(userSubject(user)))
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+// userSubject will return a value identifying user and how it is authenticated.
+func userSubject(user *models.OIDCUser) string {
+	res := user.Issuer + "|" + user.GetIdentifier() + "|" + user.Tenant
+	// Check if user is authenticated with an api token (scopes are restricted)
+	if user.APIToken != nil {
+		res += "|token:" + user.APIToken.ID
+	}
+	// Check if user is impersonated
+	if user.Impersonator != nil {
+		res += "|impersonator:" + user.Impersonator.GetIdentifier()
+	}
+
+	return res
+}
+
+func setHeader(ctx context.Context, policy *Policy) {
+	// Get response headers
+	h := getResponseHeaderFromContext(ctx)
+	// Check if headers can be set
+	if h != nil {
+		h.Set(headerName, policy.HeaderValue())
+	}
+}
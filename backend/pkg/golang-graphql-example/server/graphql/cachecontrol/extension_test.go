@@ -0,0 +1,102 @@
+//go:build unit
+
+package cachecontrol
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+)
+
+func TestExtension_InterceptResponse(t *testing.T) {
+	cache, err := NewCache(10)
+	require.NoError(t, err)
+
+	ext := NewExtension(cache)
+	require.NoError(t, ext.Validate(newTestExecutableSchema()))
+
+	calls := 0
+	next := func(_ context.Context) *gqlgraphql.Response {
+		calls++
+
+		return &gqlgraphql.Response{Data: json.RawMessage(`{}`)}
+	}
+
+	execute := func(user *models.OIDCUser, query string, header http.Header) *gqlgraphql.Response {
+		ctx := context.TODO()
+		if user != nil {
+			ctx = authentication.SetAuthenticatedUserToContext(ctx, user)
+		}
+
+		if header != nil {
+			ctx = SetResponseHeaderToContext(ctx, header)
+		}
+
+		opCtx := &gqlgraphql.OperationContext{
+			RawQuery:  query,
+			Operation: parseOperation(t, ext.es.Schema(), query),
+			Variables: map[string]any{},
+		}
+		require.Nil(t, ext.MutateOperationContext(ctx, opCtx))
+
+		return ext.InterceptResponse(gqlgraphql.WithOperationContext(ctx, opCtx), next)
+	}
+
+	user1 := &models.OIDCUser{PreferredUsername: "user1"}
+	user2 := &models.OIDCUser{PreferredUsername: "user2"}
+	query := "{ todos { edges { node { id } } } }"
+
+	// First call is executed and header is set
+	header := http.Header{}
+	execute(user1, query, header)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, "max-age=30, private", header.Get("Cache-Control"))
+
+	// Second call is answered from cache with header
+	header = http.Header{}
+	execute(user1, query, header)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, "max-age=30, private", header.Get("Cache-Control"))
+
+	// Private responses aren't shared between users
+	execute(user2, query, nil)
+	assert.Equal(t, 2, calls)
+
+	// Private responses aren't cached without user
+	execute(nil, query, nil)
+	execute(nil, query, nil)
+	assert.Equal(t, 4, calls)
+
+	// Not cacheable operations
+	header = http.Header{}
+	execute(user1, "{ __schema { queryType { name } } }", header)
+	assert.Equal(t, 5, calls)
+	assert.Equal(t, "no-store", header.Get("Cache-Control"))
+	assert.Equal(t, 2, cache.Len())
+
+	// Mutations invalidate responses containing mutated types
+	execute(user1, "mutation { createTodo(input: { text: \"fake\" }) { id } }", nil)
+	assert.Equal(t, 6, calls)
+	assert.Equal(t, 0, cache.Len())
+
+	execute(user1, query, nil)
+	assert.Equal(t, 7, calls)
+
+	// Deferred operations are answered in multiple payloads and aren't cached
+	deferredQuery := "{ todos { edges { node { id ... @defer { text } } } } }"
+	header = http.Header{}
+	execute(user1, deferredQuery, header)
+	execute(user1, deferredQuery, nil)
+	assert.Equal(t, 9, calls)
+	assert.Empty(t, header.Get("Cache-Control"))
+	assert.Equal(t, 1, cache.Len())
+}
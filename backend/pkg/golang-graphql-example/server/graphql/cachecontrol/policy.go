@@ -0,0 +1,145 @@
+package cachecontrol
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/model"
+)
+
+const (
+	directiveName      = "cacheControl"
+	maxAgeArgumentName = "maxAge"
+	scopeArgumentName  = "scope"
+)
+
+// Policy is the cache policy of an operation aggregated from all selected fields.
+type Policy struct {
+	// MaxAge is the minimum max age of all selected fields in seconds.
+	MaxAge int
+	// Scope is PRIVATE if at least one selected field is private.
+	Scope model.CacheControlScope
+	// Tags are the object type names selected in operation.
+	Tags []string
+}
+
+// IsCacheable will return true if policy allows to cache operation response.
+func (p *Policy) IsCacheable() bool {
+	return p.MaxAge > 0
+}
+
+// HeaderValue will return the Cache-Control header value.
+func (p *Policy) HeaderValue() string {
+	// Check if response can be cached
+	if !p.IsCacheable() {
+		return "no-store"
+	}
+
+	return fmt.Sprintf("max-age=%d, %s", p.MaxAge, strings.ToLower(p.Scope.String()))
+}
+
+type policyBuilder struct {
+	schema *ast.Schema
+	maxAge *int
+	scope  model.CacheControlScope
+	tags   map[string]bool
+}
+
+// ComputePolicy will compute operation cache policy from @cacheControl hints.
+// Root fields without hint aren't cacheable and nested fields without hint inherit their parent max age.
+func ComputePolicy(schema *ast.Schema, op *ast.OperationDefinition) *Policy {
+	b := &policyBuilder{
+		schema: schema,
+		scope:  model.CacheControlScopePublic,
+		tags:   map[string]bool{},
+	}
+
+	b.walk(op.SelectionSet, 0, true)
+
+	res := &Policy{
+		Scope: b.scope,
+		Tags:  make([]string, 0, len(b.tags)),
+	}
+	// Check if at least one field have been found
+	if b.maxAge != nil {
+		res.MaxAge = *b.maxAge
+	}
+
+	for k := range b.tags {
+		res.Tags = append(res.Tags, k)
+	}
+	// Sort to have a stable result
+	sort.Strings(res.Tags)
+
+	return res
+}
+
+func (b *policyBuilder) walk(set ast.SelectionSet, parentMaxAge int, root bool) {
+	for _, it := range set {
+		switch s := it.(type) {
+		case *ast.Field:
+			b.walkField(s, parentMaxAge, root)
+		case *ast.InlineFragment:
+			b.walk(s.SelectionSet, parentMaxAge, root)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				b.walk(s.Definition.SelectionSet, parentMaxAge, root)
+			}
+		}
+	}
+}
+
+func (b *policyBuilder) walkField(f *ast.Field, parentMaxAge int, root bool) {
+	// Check if field definition is available (__typename is ignored)
+	if f.Definition == nil || f.Name == "__typename" {
+		return
+	}
+
+	// Get returned type definition
+	typeDef := b.schema.Types[f.Definition.Type.Name()]
+	// Check if it is an object to save it as tag
+	if typeDef != nil && typeDef.Kind == ast.Object && !strings.HasPrefix(typeDef.Name, "__") {
+		b.tags[typeDef.Name] = true
+	}
+
+	// Get hint from field first and from returned type otherwise
+	hint := f.Definition.Directives.ForName(directiveName)
+	if hint == nil && typeDef != nil {
+		hint = typeDef.Directives.ForName(directiveName)
+	}
+
+	// Initialize max age with inherited value
+	maxAge := parentMaxAge
+	// Root fields without hint aren't cacheable
+	if root {
+		maxAge = 0
+	}
+
+	if hint != nil {
+		// Get max age from hint
+		if arg := hint.Arguments.ForName(maxAgeArgumentName); arg != nil && arg.Value != nil {
+			v, err := arg.Value.Value(nil)
+			if err == nil {
+				if i, ok := v.(int64); ok {
+					maxAge = int(i)
+				}
+			}
+		}
+
+		// Get scope from hint
+		if arg := hint.Arguments.ForName(scopeArgumentName); arg != nil && arg.Value != nil &&
+			arg.Value.Raw == model.CacheControlScopePrivate.String() {
+			b.scope = model.CacheControlScopePrivate
+		}
+	}
+
+	// Keep minimum max age
+	if b.maxAge == nil || maxAge < *b.maxAge {
+		b.maxAge = &maxAge
+	}
+
+	b.walk(f.SelectionSet, maxAge, false)
+}
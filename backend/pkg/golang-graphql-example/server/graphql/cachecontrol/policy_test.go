@@ -0,0 +1,92 @@
+//go:build unit
+
+package cachecontrol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/generated"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/model"
+)
+
+func newTestExecutableSchema() gqlgraphql.ExecutableSchema {
+	return generated.NewExecutableSchema(generated.Config{Resolvers: &graphql.Resolver{}})
+}
+
+func parseOperation(t *testing.T, schema *ast.Schema, query string) *ast.OperationDefinition {
+	t.Helper()
+
+	doc, gErr := gqlparser.LoadQuery(schema, query)
+	require.Nil(t, gErr)
+	require.Len(t, doc.Operations, 1)
+
+	return doc.Operations[0]
+}
+
+func TestComputePolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedPolicy *Policy
+		expectedHeader string
+	}{
+		{
+			name:  "hinted root field",
+			query: "{ todos { edges { node { id text } } } }",
+			expectedPolicy: &Policy{
+				MaxAge: 30,
+				Scope:  model.CacheControlScopePrivate,
+				Tags:   []string{"Todo", "TodoConnection", "TodoEdge"},
+			},
+			expectedHeader: "max-age=30, private",
+		},
+		{
+			name:  "fragments and typename",
+			query: "{ todo(id: \"1\") { ...F } } fragment F on Todo { __typename ... on Todo { id } }",
+			expectedPolicy: &Policy{
+				MaxAge: 30,
+				Scope:  model.CacheControlScopePrivate,
+				Tags:   []string{"Todo"},
+			},
+			expectedHeader: "max-age=30, private",
+		},
+		{
+			name:  "root field without hint",
+			query: "{ todo(id: \"1\") { id } __schema { queryType { name } } }",
+			expectedPolicy: &Policy{
+				MaxAge: 0,
+				Scope:  model.CacheControlScopePrivate,
+				Tags:   []string{"Todo"},
+			},
+			expectedHeader: "no-store",
+		},
+		{
+			name:  "mutation",
+			query: "mutation { createTodo(input: { text: \"fake\" }) { id } }",
+			expectedPolicy: &Policy{
+				MaxAge: 0,
+				Scope:  model.CacheControlScopePublic,
+				Tags:   []string{"Todo"},
+			},
+			expectedHeader: "no-store",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := newTestExecutableSchema().Schema()
+
+			got := ComputePolicy(schema, parseOperation(t, schema, tt.query))
+
+			assert.Equal(t, tt.expectedPolicy, got)
+			assert.Equal(t, tt.expectedHeader, got.HeaderValue())
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package cost
+
+// This package will manage GraphQL operation cost computed from @cost directives and operation limits.
@@ -0,0 +1,271 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	gqlcomplexity "github.com/99designs/gqlgen/complexity"
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+const (
+	extensionName = "Cost"
+	// Response extension key containing cost.
+	responseExtensionKey = "cost"
+)
+
+// Limits error codes.
+const (
+	ComplexityLimitErrorCode = "COMPLEXITY_LIMIT_EXCEEDED"
+	DepthLimitErrorCode      = "DEPTH_LIMIT_EXCEEDED"
+	AliasesLimitErrorCode    = "ALIASES_LIMIT_EXCEEDED"
+)
+
+// Rejection reasons used in metrics.
+const (
+	complexityReason = "complexity"
+	depthReason      = "depth"
+	aliasesReason    = "aliases"
+)
+
+//go:generate mockgen -destination=./mocks/mock_MetricsService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/cost MetricsService
+type MetricsService interface {
+	ObserveGraphQLOperationCost(operationType string, cost int)
+	IncreaseRejectedGraphQLOperation(reason string)
+}
+
+// Stats are the computed operation cost and the limits applied.
+type Stats struct {
+	Complexity    int `json:"complexity"`
+	MaxComplexity int `json:"maxComplexity"`
+	Depth         int `json:"depth"`
+	MaxDepth      int `json:"maxDepth"`
+	Aliases       int `json:"aliases"`
+	MaxAliases    int `json:"maxAliases"`
+}
+
+// Extension will limit operations complexity, depth and aliases and report operation cost.
+type Extension struct {
+	cfg        *config.ServerGraphQLLimitsConfig
+	metricsSvc MetricsService
+	es         gqlgraphql.ExecutableSchema
+}
+
+var _ interface {
+	gqlgraphql.HandlerExtension
+	gqlgraphql.OperationContextMutator
+	gqlgraphql.ResponseInterceptor
+} = &Extension{}
+
+// NewExtension will create the limits extension.
+func NewExtension(cfg *config.ServerGraphQLLimitsConfig, metricsSvc MetricsService) *Extension {
+	return &Extension{cfg: cfg, metricsSvc: metricsSvc}
+}
+
+// GetStats will return operation cost stats from context.
+func GetStats(ctx context.Context) *Stats {
+	// Get operation context
+	opCtx := gqlgraphql.GetOperationContext(ctx)
+	if opCtx == nil {
+		return nil
+	}
+
+	s, _ := opCtx.Stats.GetExtension(extensionName).(*Stats)
+
+	return s
+}
+
+func (*Extension) ExtensionName() string {
+	return extensionName
+}
+
+func (e *Extension) Validate(schema gqlgraphql.ExecutableSchema) error {
+	e.es = schema
+
+	return nil
+}
+
+func (e *Extension) MutateOperationContext(
+	ctx context.Context,
+	opCtx *gqlgraphql.OperationContext,
+) *gqlerror.Error {
+	op := opCtx.Operation
+	// Get limits
+	stats := e.getLimits(ctx)
+	// Compute operation cost
+	stats.Complexity = gqlcomplexity.Calculate(ctx, e.es, op, opCtx.Variables)
+	stats.Depth = selectionSetDepth(op.SelectionSet)
+	stats.Aliases = selectionSetAliases(op.SelectionSet)
+
+	// Save stats
+	opCtx.Stats.SetExtension(extensionName, stats)
+	// Report cost
+	e.metricsSvc.ObserveGraphQLOperationCost(string(op.Operation), stats.Complexity)
+
+	// Check limits
+	if stats.Depth > stats.MaxDepth {
+		return e.reject(ctx, depthReason, DepthLimitErrorCode, stats.Depth, stats.MaxDepth)
+	}
+
+	if stats.Aliases > stats.MaxAliases {
+		return e.reject(ctx, aliasesReason, AliasesLimitErrorCode, stats.Aliases, stats.MaxAliases)
+	}
+
+	if stats.Complexity > stats.MaxComplexity {
+		return e.reject(ctx, complexityReason, ComplexityLimitErrorCode, stats.Complexity, stats.MaxComplexity)
+	}
+
+	return nil
+}
+
+func (*Extension) InterceptResponse(ctx context.Context, next gqlgraphql.ResponseHandler) *gqlgraphql.Response {
+	// Get response
+	resp := next(ctx)
+	// Get stats
+	stats := GetStats(ctx)
+	// Check if cost can be reported
+	if resp == nil || stats == nil {
+		return resp
+	}
+
+	// Check if extensions exist
+	if resp.Extensions == nil {
+		resp.Extensions = map[string]any{}
+	}
+
+	resp.Extensions[responseExtensionKey] = stats
+
+	return resp
+}
+
+// getLimits will return limits applied to authenticated user.
+func (e *Extension) getLimits(ctx context.Context) *Stats {
+	res := &Stats{
+		MaxComplexity: orDefault(e.cfg.MaxComplexity, config.DefaultServerGraphQLMaxComplexity),
+		MaxDepth:      orDefault(e.cfg.MaxDepth, config.DefaultServerGraphQLMaxDepth),
+		MaxAliases:    orDefault(e.cfg.MaxAliases, config.DefaultServerGraphQLMaxAliases),
+	}
+	// Save global limits inherited by roles
+	globalComplexity, globalDepth, globalAliases := res.MaxComplexity, res.MaxDepth, res.MaxAliases
+
+	// Get user
+	user := authentication.GetAuthenticatedUserFromContext(ctx)
+	// Check if user exists
+	if user == nil {
+		return res
+	}
+
+	// Initialize
+	matched := false
+
+	for _, it := range e.cfg.Roles {
+		// Check if user has role
+		if !user.HasAnyRole([]string{it.Role}) {
+			continue
+		}
+
+		// Compute role limits
+		maxComplexity, maxDepth, maxAliases := orDefault(it.MaxComplexity, globalComplexity),
+			orDefault(it.MaxDepth, globalDepth),
+			orDefault(it.MaxAliases, globalAliases)
+
+		// Check if it is the first matching role
+		if !matched {
+			res.MaxComplexity, res.MaxDepth, res.MaxAliases = maxComplexity, maxDepth, maxAliases
+			matched = true
+
+			continue
+		}
+
+		// Keep greatest limits
+		res.MaxComplexity = max(res.MaxComplexity, maxComplexity)
+		res.MaxDepth = max(res.MaxDepth, maxDepth)
+		res.MaxAliases = max(res.MaxAliases, maxAliases)
+	}
+
+	return res
+}
+
+func (e *Extension) reject(ctx context.Context, reason, code string, value, limit int) *gqlerror.Error {
+	// Report rejection
+	e.metricsSvc.IncreaseRejectedGraphQLOperation(reason)
+
+	msg := fmt.Sprintf("operation has %s %d, which exceeds the limit of %d", reason, value, limit)
+	err := cerrors.NewInvalidInputError(
+		msg,
+		cerrors.WithCode(code),
+		cerrors.WithPublicErrorMessage(msg),
+	)
+
+	// Get logger
+	logger := log.GetLoggerFromContext(ctx)
+	if logger != nil {
+		logger.Warn(err)
+	}
+
+	return gqlerror.Wrap(err)
+}
+
+func orDefault(v *int, def int) int {
+	if v == nil {
+		return def
+	}
+
+	return *v
+}
+
+// selectionSetDepth will return the maximum number of nested fields.
+func selectionSetDepth(set ast.SelectionSet) int {
+	res := 0
+
+	for _, it := range set {
+		var d int
+
+		switch s := it.(type) {
+		case *ast.Field:
+			d = 1 + selectionSetDepth(s.SelectionSet)
+		case *ast.InlineFragment:
+			d = selectionSetDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				d = selectionSetDepth(s.Definition.SelectionSet)
+			}
+		}
+
+		res = max(res, d)
+	}
+
+	return res
+}
+
+// selectionSetAliases will return the number of aliased fields.
+func selectionSetAliases(set ast.SelectionSet) int {
+	res := 0
+
+	for _, it := range set {
+		switch s := it.(type) {
+		case *ast.Field:
+			if s.Alias != "" && s.Alias != s.Name {
+				res++
+			}
+
+			res += selectionSetAliases(s.SelectionSet)
+		case *ast.InlineFragment:
+			res += selectionSetAliases(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				res += selectionSetAliases(s.Definition.SelectionSet)
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,166 @@
+//go:build unit
+
+package cost
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/cost/mocks"
+)
+
+func TestExtension_MutateOperationContext(t *testing.T) {
+	cfg := &config.ServerGraphQLLimitsConfig{
+		MaxComplexity: intPtr(20),
+		MaxDepth:      intPtr(4),
+		MaxAliases:    intPtr(1),
+		Roles: []*config.ServerGraphQLRoleLimitsConfig{
+			{Role: "power", MaxComplexity: intPtr(100)},
+			{Role: "deep", MaxDepth: intPtr(10)},
+			{Role: "noalias", MaxAliases: intPtr(0)},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		roles         []string
+		expectedCode  string
+		expectedStats *Stats
+	}{
+		{
+			name:  "allowed",
+			query: "{ todos(first: 5) { edges { node { id } } } }",
+			expectedStats: &Stats{
+				Complexity: 17, MaxComplexity: 20, Depth: 4, MaxDepth: 4, Aliases: 0, MaxAliases: 1,
+			},
+		},
+		{
+			name:         "complexity exceeded",
+			query:        "{ todos { edges { node { id } } } }",
+			expectedCode: ComplexityLimitErrorCode,
+		},
+		{
+			name:  "complexity allowed by role",
+			query: "{ todos { edges { node { id } } } }",
+			roles: []string{"power"},
+			expectedStats: &Stats{
+				Complexity: 32, MaxComplexity: 100, Depth: 4, MaxDepth: 4, Aliases: 0, MaxAliases: 1,
+			},
+		},
+		{
+			name:  "depth computed through fragments",
+			query: "{ todos(first: 1) { ...F } } fragment F on TodoConnection { edges { node { ... on Todo { id } } cursor } pageInfo { hasNextPage } }",
+			roles: []string{"power"},
+			expectedStats: &Stats{
+				Complexity: 8, MaxComplexity: 100, Depth: 4, MaxDepth: 4, Aliases: 0, MaxAliases: 1,
+			},
+		},
+		{
+			name:         "depth exceeded",
+			query:        "{ __schema { types { fields { type { ofType { name } } } } } }",
+			expectedCode: DepthLimitErrorCode,
+		},
+		{
+			name:         "aliases exceeded",
+			query:        "{ a: todo(id: \"1\") { id } b: todo(id: \"2\") { id } }",
+			expectedCode: AliasesLimitErrorCode,
+		},
+		{
+			name:  "aliases allowed",
+			query: "{ a: todo(id: \"1\") { id } }",
+			expectedStats: &Stats{
+				Complexity: 2, MaxComplexity: 20, Depth: 2, MaxDepth: 4, Aliases: 1, MaxAliases: 1,
+			},
+		},
+		{
+			name:         "aliases forbidden by role limit set to 0",
+			query:        "{ a: todo(id: \"1\") { id } }",
+			roles:        []string{"noalias"},
+			expectedCode: AliasesLimitErrorCode,
+		},
+		{
+			name:  "greatest limits of user roles",
+			query: "{ todos(first: 1) { edges { node { id } } } }",
+			roles: []string{"power", "deep"},
+			expectedStats: &Stats{
+				Complexity: 5, MaxComplexity: 100, Depth: 4, MaxDepth: 10, Aliases: 0, MaxAliases: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			metricsMock := mocks.NewMockMetricsService(ctrl)
+			metricsMock.EXPECT().ObserveGraphQLOperationCost("query", gomock.Any())
+
+			es := newTestExecutableSchema(t)
+			ext := NewExtension(cfg, metricsMock)
+			require.NoError(t, ext.Validate(es))
+
+			ctx := context.TODO()
+			if tt.roles != nil {
+				ctx = authentication.SetAuthenticatedUserToContext(ctx, &models.OIDCUser{PreferredUsername: "user", Roles: tt.roles})
+			}
+
+			opCtx := &gqlgraphql.OperationContext{Operation: parseOperation(t, es, tt.query)}
+
+			if tt.expectedCode != "" {
+				metricsMock.EXPECT().IncreaseRejectedGraphQLOperation(gomock.Any())
+
+				gErr := ext.MutateOperationContext(ctx, opCtx)
+				require.NotNil(t, gErr)
+
+				var err2 *cerrors.GenericError
+				require.ErrorAs(t, gErr, &err2)
+				assert.Equal(t, tt.expectedCode, err2.Extensions()["code"])
+
+				return
+			}
+
+			require.Nil(t, ext.MutateOperationContext(ctx, opCtx))
+			assert.Equal(t, tt.expectedStats, GetStats(gqlgraphql.WithOperationContext(ctx, opCtx)))
+		})
+	}
+}
+
+func TestExtension_defaultLimits(t *testing.T) {
+	ext := NewExtension(&config.ServerGraphQLLimitsConfig{}, nil)
+
+	assert.Equal(t, &Stats{
+		MaxComplexity: config.DefaultServerGraphQLMaxComplexity,
+		MaxDepth:      config.DefaultServerGraphQLMaxDepth,
+		MaxAliases:    config.DefaultServerGraphQLMaxAliases,
+	}, ext.getLimits(context.TODO()))
+}
+
+func TestExtension_InterceptResponse(t *testing.T) {
+	ext := NewExtension(&config.ServerGraphQLLimitsConfig{}, nil)
+	stats := &Stats{Complexity: 2}
+
+	opCtx := &gqlgraphql.OperationContext{}
+	opCtx.Stats.SetExtension(extensionName, stats)
+	ctx := gqlgraphql.WithOperationContext(context.TODO(), opCtx)
+
+	resp := ext.InterceptResponse(ctx, func(_ context.Context) *gqlgraphql.Response {
+		return &gqlgraphql.Response{}
+	})
+
+	assert.Equal(t, map[string]any{"cost": stats}, resp.Extensions)
+}
+
+func intPtr(v int) *int {
+	return &v
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/cost (interfaces: MetricsService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_MetricsService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/cost MetricsService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMetricsService is a mock of MetricsService interface.
+type MockMetricsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMetricsServiceMockRecorder is the mock recorder for MockMetricsService.
+type MockMetricsServiceMockRecorder struct {
+	mock *MockMetricsService
+}
+
+// NewMockMetricsService creates a new mock instance.
+func NewMockMetricsService(ctrl *gomock.Controller) *MockMetricsService {
+	mock := &MockMetricsService{ctrl: ctrl}
+	mock.recorder = &MockMetricsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetricsService) EXPECT() *MockMetricsServiceMockRecorder {
+	return m.recorder
+}
+
+// IncreaseRejectedGraphQLOperation mocks base method.
+func (m *MockMetricsService) IncreaseRejectedGraphQLOperation(reason string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncreaseRejectedGraphQLOperation", reason)
+}
+
+// IncreaseRejectedGraphQLOperation indicates an expected call of IncreaseRejectedGraphQLOperation.
+func (mr *MockMetricsServiceMockRecorder) IncreaseRejectedGraphQLOperation(reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseRejectedGraphQLOperation", reflect.TypeOf((*MockMetricsService)(nil).IncreaseRejectedGraphQLOperation), reason)
+}
+
+// ObserveGraphQLOperationCost mocks base method.
+func (m *MockMetricsService) ObserveGraphQLOperationCost(operationType string, cost int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveGraphQLOperationCost", operationType, cost)
+}
+
+// ObserveGraphQLOperationCost indicates an expected call of ObserveGraphQLOperationCost.
+func (mr *MockMetricsServiceMockRecorder) ObserveGraphQLOperationCost(operationType, cost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveGraphQLOperationCost", reflect.TypeOf((*MockMetricsService)(nil).ObserveGraphQLOperationCost), operationType, cost)
+}
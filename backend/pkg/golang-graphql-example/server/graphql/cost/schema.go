@@ -0,0 +1,135 @@
+package cost
+
+import (
+	"context"
+	"encoding/json"
+
+	"emperror.dev/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+)
+
+const costDirectiveName = "cost"
+
+// definition is a parsed cost directive.
+type definition struct {
+	multipliers []string
+	weight      int
+}
+
+// executableSchema is overriding generated complexity with cost directives.
+type executableSchema struct {
+	gqlgraphql.ExecutableSchema
+	// costs are indexed by "Type.field"
+	costs map[string]*definition
+}
+
+// NewExecutableSchema will wrap executable schema to compute complexity from @cost directives.
+// Fields without directive keep generated complexity.
+func NewExecutableSchema(es gqlgraphql.ExecutableSchema) (gqlgraphql.ExecutableSchema, error) {
+	costs := map[string]*definition{}
+
+	for _, def := range es.Schema().Types {
+		for _, f := range def.Fields {
+			// Get directive
+			d := f.Directives.ForName(costDirectiveName)
+			// Check if it exists
+			if d == nil {
+				continue
+			}
+
+			// Parse
+			c, err := parseDirective(d)
+			// Check error
+			if err != nil {
+				return nil, errors.WithMessagef(err, "invalid cost directive on %s.%s", def.Name, f.Name)
+			}
+
+			costs[def.Name+"."+f.Name] = c
+		}
+	}
+
+	return &executableSchema{ExecutableSchema: es, costs: costs}, nil
+}
+
+func (es *executableSchema) Complexity(
+	ctx context.Context,
+	typeName, fieldName string,
+	childComplexity int,
+	args map[string]any,
+) (int, bool) {
+	// Get cost definition
+	c, ok := es.costs[typeName+"."+fieldName]
+	// Check if it exists
+	if !ok {
+		return es.ExecutableSchema.Complexity(ctx, typeName, fieldName, childComplexity, args)
+	}
+
+	// Get multipliers values
+	multipliers := make([]*int, len(c.multipliers))
+	for i, name := range c.multipliers {
+		multipliers[i] = toInt(args[name])
+	}
+
+	return utils.CalculateCostComplexity(childComplexity, c.weight, multipliers), true
+}
+
+func parseDirective(d *ast.Directive) (*definition, error) {
+	// Get arguments
+	args := d.ArgumentMap(nil)
+
+	// Get weight
+	weight := toInt(args["weight"])
+	// Check weight
+	if weight == nil || *weight < 0 {
+		return nil, errors.New("weight must be a positive integer")
+	}
+
+	res := &definition{weight: *weight}
+
+	// Get multipliers
+	list, _ := args["multipliers"].([]any)
+	for _, it := range list {
+		s, ok := it.(string)
+		// Check if it is a string
+		if !ok {
+			return nil, errors.New("multipliers must be argument names")
+		}
+
+		res.multipliers = append(res.multipliers, s)
+	}
+
+	return res, nil
+}
+
+// toInt will convert argument value to int, nil is returned when value isn't set or isn't a number.
+func toInt(v any) *int {
+	var res int
+
+	switch val := v.(type) {
+	case int:
+		res = val
+	case int32:
+		res = int(val)
+	case int64:
+		res = int(val)
+	case float64:
+		res = int(val)
+	case json.Number:
+		i, err := val.Int64()
+		if err != nil {
+			return nil
+		}
+
+		res = int(i)
+	case *int:
+		return val
+	default:
+		return nil
+	}
+
+	return &res
+}
@@ -0,0 +1,95 @@
+//go:build unit
+
+package cost
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	gqlcomplexity "github.com/99designs/gqlgen/complexity"
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/generated"
+)
+
+func newTestExecutableSchema(t *testing.T) gqlgraphql.ExecutableSchema {
+	t.Helper()
+
+	es, err := NewExecutableSchema(generated.NewExecutableSchema(generated.Config{Resolvers: &graphql.Resolver{}}))
+	require.NoError(t, err)
+
+	return es
+}
+
+func parseOperation(t *testing.T, es gqlgraphql.ExecutableSchema, query string) *ast.OperationDefinition {
+	t.Helper()
+
+	doc, gErr := gqlparser.LoadQuery(es.Schema(), query)
+	require.Nil(t, gErr)
+	require.Len(t, doc.Operations, 1)
+
+	return doc.Operations[0]
+}
+
+func Test_executableSchema_Complexity(t *testing.T) {
+	es := newTestExecutableSchema(t)
+
+	tests := []struct {
+		name  string
+		query string
+		vars  map[string]any
+		want  int
+	}{
+		{
+			name:  "field without cost",
+			query: "{ todo(id: \"id\") { id text } }",
+			want:  3,
+		},
+		{
+			name:  "connection with first argument",
+			query: "{ todos(first: 5) { edges { node { id } } } }",
+			want:  17,
+		},
+		{
+			name:  "connection with variable",
+			query: "query q($last: Int) { todos(before: \"c\", last: $last) { edges { node { id } } } }",
+			vars:  map[string]any{"last": int64(20)},
+			want:  62,
+		},
+		{
+			name:  "connection without pagination",
+			query: "{ todos { edges { node { id } } } }",
+			want:  32,
+		},
+		{
+			name:  "mutation",
+			query: "mutation { createTodo(input: {text: \"text\"}) { id } }",
+			want:  11,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := parseOperation(t, es, tt.query)
+
+			assert.Equal(t, tt.want, gqlcomplexity.Calculate(context.TODO(), es, op, tt.vars))
+		})
+	}
+}
+
+func Test_parseDirective(t *testing.T) {
+	d := &ast.Directive{
+		Name: costDirectiveName,
+		Arguments: ast.ArgumentList{
+			{Name: "weight", Value: &ast.Value{Kind: ast.IntValue, Raw: "-1"}},
+		},
+	}
+
+	_, err := parseDirective(d)
+	assert.ErrorContains(t, err, "weight must be a positive integer")
+}
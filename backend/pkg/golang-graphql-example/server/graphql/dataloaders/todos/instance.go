@@ -64,10 +64,12 @@ func New(busiServices *business.Services) *TodosDataloaders {
 					return &projection, nil
 				},
 				func(ctx context.Context, ids []string, projection any) ([]*models.Todo, error) {
-					return busiServices.TodoSvc.Find(
+					// Entities are requested by other subgraphs so authorization is checked here
+					// Each reference is checked with the "todo:Get" action on its own resource,
+					// references that aren't authorized are resolved as null
+					return busiServices.TodoSvc.FindByIDs(
 						ctx,
-						nil,
-						&models.Filter{ID: &common.GenericFilter{In: ids}},
+						ids,
 						projection.(*models.Projection), //nolint:forcetypeassert // We know that it is that
 					)
 				},
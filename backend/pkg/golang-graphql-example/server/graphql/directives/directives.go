@@ -0,0 +1,147 @@
+package directives
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+)
+
+// Relay modifier in resource placeholders.
+const relayModifier = "relay"
+
+// Resource placeholder regexp: "{argName}" or "{argName:relay}".
+var resourcePlaceholderRegexp = regexp.MustCompile(`\{(\w+)(?::(\w+))?\}`)
+
+//go:generate mockgen -destination=./mocks/mock_AuthorizationService.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/directives AuthorizationService
+type AuthorizationService interface {
+	CheckAuthorized(ctx context.Context, action, resource string) error
+}
+
+// Authenticated will ensure that an authenticated user exists before running resolver.
+func Authenticated(ctx context.Context, _ any, next graphql.Resolver) (any, error) {
+	// Get user from context
+	user := authentication.GetAuthenticatedUserFromContext(ctx)
+	// Check if user exists
+	if user == nil {
+		return nil, errors.NewUnauthorizedError("authenticated user is required")
+	}
+
+	return next(ctx)
+}
+
+// NewAuthorize will create the authorize directive wired to authorization service.
+func NewAuthorize(
+	authSvc AuthorizationService,
+) func(ctx context.Context, obj any, next graphql.Resolver, action string, resource *string) (any, error) {
+	return func(ctx context.Context, _ any, next graphql.Resolver, action string, resource *string) (any, error) {
+		// Initialize resource
+		res := ""
+		// Check if resource is set
+		if resource != nil {
+			var err error
+			// Build resource from field arguments
+			res, err = buildResource(*resource, graphql.GetFieldContext(ctx))
+			// Check error
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Check authorization
+		err := authSvc.CheckAuthorized(ctx, action, res)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		return next(ctx)
+	}
+}
+
+// buildResource will replace placeholders in resource with field arguments.
+func buildResource(resource string, fc *graphql.FieldContext) (string, error) {
+	// Initialize
+	var resErr error
+
+	res := resourcePlaceholderRegexp.ReplaceAllStringFunc(resource, func(placeholder string) string {
+		// Ignore when an error is already present
+		if resErr != nil {
+			return ""
+		}
+
+		// Get matches
+		m := resourcePlaceholderRegexp.FindStringSubmatch(placeholder)
+		argName, modifier := m[1], m[2]
+
+		// Get argument value
+		var (
+			val any
+			ok  bool
+		)
+		// Check field context
+		if fc != nil {
+			val, ok = fc.Args[argName]
+		}
+		// Check if argument exists
+		if !ok {
+			resErr = errors.NewInternalServerError(fmt.Sprintf("argument %s not found for authorize directive", argName))
+
+			return ""
+		}
+
+		// Stringify value
+		str := stringifyArgument(val)
+
+		// Manage modifiers
+		switch modifier {
+		case "":
+			return str
+		case relayModifier:
+			// Decode relay ID
+			_, id, err := utils.DecodeIDRelay(str)
+			// Check error
+			if err != nil {
+				resErr = err
+
+				return ""
+			}
+
+			return id
+		default:
+			resErr = errors.NewInternalServerError(fmt.Sprintf("unsupported modifier %s in authorize directive", modifier))
+
+			return ""
+		}
+	})
+
+	// Check error
+	if resErr != nil {
+		return "", resErr
+	}
+
+	return res, nil
+}
+
+func stringifyArgument(val any) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case *string:
+		// Check nil
+		if v == nil {
+			return ""
+		}
+
+		return *v
+	case string:
+		return v
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
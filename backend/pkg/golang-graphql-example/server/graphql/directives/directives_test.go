@@ -0,0 +1,130 @@
+//go:build unit
+
+package directives
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/directives/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+)
+
+func nextResolver(called *bool) graphql.Resolver {
+	return func(_ context.Context) (any, error) {
+		*called = true
+
+		return "ok", nil
+	}
+}
+
+func TestAuthenticated(t *testing.T) {
+	called := false
+
+	_, err := Authenticated(context.TODO(), nil, nextResolver(&called))
+	require.Error(t, err)
+	assert.False(t, called)
+
+	var gErr *errors.GenericError
+	require.ErrorAs(t, err, &gErr)
+	assert.Equal(t, errors.UnauthorizedErrorCode, gErr.Code())
+
+	ctx := authentication.SetAuthenticatedUserToContext(context.TODO(), &models.OIDCUser{PreferredUsername: "user"})
+
+	res, err := Authenticated(ctx, nil, nextResolver(&called))
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Equal(t, "ok", res)
+}
+
+func TestNewAuthorize(t *testing.T) {
+	relayID := utils.ToIDRelay("todos", "uuid-1")
+
+	tests := []struct {
+		name             string
+		args             map[string]any
+		resource         *string
+		expectedResource string
+		authErr          error
+		wantErr          bool
+		noAuthCall       bool
+	}{
+		{
+			name:             "without resource",
+			expectedResource: "",
+		},
+		{
+			name:             "raw argument",
+			args:             map[string]any{"id": "1"},
+			resource:         lo.ToPtr("todo:{id}"),
+			expectedResource: "todo:1",
+		},
+		{
+			name:             "relay argument",
+			args:             map[string]any{"id": relayID},
+			resource:         lo.ToPtr("todo:{id:relay}"),
+			expectedResource: "todo:uuid-1",
+		},
+		{
+			name:             "multiple arguments",
+			args:             map[string]any{"a": "x", "b": 12},
+			resource:         lo.ToPtr("r:{a}:{b}"),
+			expectedResource: "r:x:12",
+		},
+		{
+			name:       "missing argument",
+			args:       map[string]any{},
+			resource:   lo.ToPtr("todo:{id}"),
+			wantErr:    true,
+			noAuthCall: true,
+		},
+		{
+			name:       "invalid relay argument",
+			args:       map[string]any{"id": "not-relay"},
+			resource:   lo.ToPtr("todo:{id:relay}"),
+			wantErr:    true,
+			noAuthCall: true,
+		},
+		{
+			name:             "forbidden",
+			resource:         lo.ToPtr("todo"),
+			expectedResource: "todo",
+			authErr:          errors.NewForbiddenError("forbidden"),
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authSvc := mocks.NewMockAuthorizationService(ctrl)
+
+			ctx := graphql.WithFieldContext(context.TODO(), &graphql.FieldContext{Args: tt.args})
+
+			if !tt.noAuthCall {
+				authSvc.EXPECT().CheckAuthorized(gomock.Any(), "todo:Get", tt.expectedResource).Return(tt.authErr)
+			}
+
+			called := false
+
+			_, err := NewAuthorize(authSvc)(ctx, nil, nextResolver(&called), "todo:Get", tt.resource)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.False(t, called)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, called)
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package directives
+
+// This package will manage GraphQL schema directives.
@@ -0,0 +1,36 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.84
+
+import (
+	"context"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/dataloaders"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/generated"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/mappers"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+)
+
+// FindTodoByID is the resolver for the findTodoByID field.
+func (r *entityResolver) FindTodoByID(ctx context.Context, id string) (*models.Todo, error) {
+	uuid, err := utils.FromIDRelay(id, mappers.TodoIDPrefix)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Representations are loaded in batch with the entities dataloader
+	// that checks "todo:Get" authorization for each of them
+	dl := dataloaders.GetDataloadersFromContext(ctx)
+
+	return dl.Todos.EntitiesLoader.Load(ctx, uuid)()
+}
+
+// Entity returns generated.EntityResolver implementation.
+func (r *Resolver) Entity() generated.EntityResolver { return &entityResolver{r} }
+
+type entityResolver struct{ *Resolver }
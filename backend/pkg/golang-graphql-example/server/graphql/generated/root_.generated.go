@@ -35,8 +35,12 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	APIToken() APITokenResolver
+	Entity() EntityResolver
+	ImpersonationSession() ImpersonationSessionResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
+	Session() SessionResolver
 	Todo() TodoResolver
 	BooleanFilter() BooleanFilterResolver
 	DateFilter() DateFilterResolver
@@ -45,13 +49,52 @@ type ResolverRoot interface {
 }
 
 type DirectiveRoot struct {
+	Authenticated func(ctx context.Context, obj any, next graphql.Resolver) (res any, err error)
+	Authorize     func(ctx context.Context, obj any, next graphql.Resolver, action string, resource *string) (res any, err error)
 }
 
 type ComplexityRoot struct {
+	APIToken struct {
+		CreatedAt   func(childComplexity int, format *utils.DateFormat) int
+		ExpiresAt   func(childComplexity int, format *utils.DateFormat) int
+		ID          func(childComplexity int) int
+		LastUsedAt  func(childComplexity int, format *utils.DateFormat) int
+		Name        func(childComplexity int) int
+		RevokedAt   func(childComplexity int, format *utils.DateFormat) int
+		Scopes      func(childComplexity int) int
+		TokenPrefix func(childComplexity int) int
+		UpdatedAt   func(childComplexity int, format *utils.DateFormat) int
+	}
+
+	CreatedAPIToken struct {
+		APIToken func(childComplexity int) int
+		Token    func(childComplexity int) int
+	}
+
+	Entity struct {
+		FindTodoByID func(childComplexity int, id string) int
+	}
+
+	ImpersonationSession struct {
+		Actor     func(childComplexity int) int
+		CreatedAt func(childComplexity int, format *utils.DateFormat) int
+		EndedAt   func(childComplexity int, format *utils.DateFormat) int
+		ExpiresAt func(childComplexity int, format *utils.DateFormat) int
+		ID        func(childComplexity int) int
+		Reason    func(childComplexity int) int
+		Target    func(childComplexity int) int
+	}
+
 	Mutation struct {
-		CloseTodo  func(childComplexity int, todoID string) int
-		CreateTodo func(childComplexity int, input model.NewTodo) int
-		UpdateTodo func(childComplexity int, input *model.UpdateTodo) int
+		CloseTodo             func(childComplexity int, todoID string) int
+		CreateAPIToken        func(childComplexity int, input model.NewAPIToken) int
+		CreateTodo            func(childComplexity int, input model.NewTodo) int
+		RevokeAPIToken        func(childComplexity int, id string) int
+		RevokeAllUserSessions func(childComplexity int, owner string) int
+		RevokeSession         func(childComplexity int, id string) int
+		StartImpersonation    func(childComplexity int, input model.StartImpersonationInput) int
+		StopImpersonation     func(childComplexity int, id string) int
+		UpdateTodo            func(childComplexity int, input *model.UpdateTodo) int
 	}
 
 	PageInfo struct {
@@ -62,8 +105,23 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		Todo  func(childComplexity int, id string) int
-		Todos func(childComplexity int, after *string, before *string, first *int, last *int, sort *models.SortOrder, sorts []*models.SortOrder, filter *models.Filter) int
+		APITokens          func(childComplexity int) int
+		Me                 func(childComplexity int) int
+		Sessions           func(childComplexity int) int
+		Todo               func(childComplexity int, id string) int
+		Todos              func(childComplexity int, after *string, before *string, first *int, last *int, sort *models.SortOrder, sorts []*models.SortOrder, filter *models.Filter) int
+		__resolve__service func(childComplexity int) int
+		__resolve_entities func(childComplexity int, representations []map[string]any) int
+	}
+
+	Session struct {
+		CreatedAt func(childComplexity int, format *utils.DateFormat) int
+		Current   func(childComplexity int) int
+		ExpiresAt func(childComplexity int, format *utils.DateFormat) int
+		ID        func(childComplexity int) int
+		IPAddress func(childComplexity int) int
+		UpdatedAt func(childComplexity int, format *utils.DateFormat) int
+		UserAgent func(childComplexity int) int
 	}
 
 	Todo struct {
@@ -83,6 +141,28 @@ type ComplexityRoot struct {
 		Cursor func(childComplexity int) int
 		Node   func(childComplexity int) int
 	}
+
+	User struct {
+		ClientID          func(childComplexity int) int
+		Email             func(childComplexity int) int
+		EmailVerified     func(childComplexity int) int
+		ExtraClaims       func(childComplexity int) int
+		FamilyName        func(childComplexity int) int
+		GetIdentifier     func(childComplexity int) int
+		GivenName         func(childComplexity int) int
+		Groups            func(childComplexity int) int
+		Issuer            func(childComplexity int) int
+		Name              func(childComplexity int) int
+		PreferredUsername func(childComplexity int) int
+		PrincipalType     func(childComplexity int) int
+		Roles             func(childComplexity int) int
+		Subject           func(childComplexity int) int
+		Tenant            func(childComplexity int) int
+	}
+
+	_Service struct {
+		SDL func(childComplexity int) int
+	}
 }
 
 type executableSchema struct {
@@ -104,6 +184,184 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "APIToken.createdAt":
+		if e.complexity.APIToken.CreatedAt == nil {
+			break
+		}
+
+		args, err := ec.field_APIToken_createdAt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.APIToken.CreatedAt(childComplexity, args["format"].(*utils.DateFormat)), true
+
+	case "APIToken.expiresAt":
+		if e.complexity.APIToken.ExpiresAt == nil {
+			break
+		}
+
+		args, err := ec.field_APIToken_expiresAt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.APIToken.ExpiresAt(childComplexity, args["format"].(*utils.DateFormat)), true
+
+	case "APIToken.id":
+		if e.complexity.APIToken.ID == nil {
+			break
+		}
+
+		return e.complexity.APIToken.ID(childComplexity), true
+
+	case "APIToken.lastUsedAt":
+		if e.complexity.APIToken.LastUsedAt == nil {
+			break
+		}
+
+		args, err := ec.field_APIToken_lastUsedAt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.APIToken.LastUsedAt(childComplexity, args["format"].(*utils.DateFormat)), true
+
+	case "APIToken.name":
+		if e.complexity.APIToken.Name == nil {
+			break
+		}
+
+		return e.complexity.APIToken.Name(childComplexity), true
+
+	case "APIToken.revokedAt":
+		if e.complexity.APIToken.RevokedAt == nil {
+			break
+		}
+
+		args, err := ec.field_APIToken_revokedAt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.APIToken.RevokedAt(childComplexity, args["format"].(*utils.DateFormat)), true
+
+	case "APIToken.scopes":
+		if e.complexity.APIToken.Scopes == nil {
+			break
+		}
+
+		return e.complexity.APIToken.Scopes(childComplexity), true
+
+	case "APIToken.tokenPrefix":
+		if e.complexity.APIToken.TokenPrefix == nil {
+			break
+		}
+
+		return e.complexity.APIToken.TokenPrefix(childComplexity), true
+
+	case "APIToken.updatedAt":
+		if e.complexity.APIToken.UpdatedAt == nil {
+			break
+		}
+
+		args, err := ec.field_APIToken_updatedAt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.APIToken.UpdatedAt(childComplexity, args["format"].(*utils.DateFormat)), true
+
+	case "CreatedAPIToken.apiToken":
+		if e.complexity.CreatedAPIToken.APIToken == nil {
+			break
+		}
+
+		return e.complexity.CreatedAPIToken.APIToken(childComplexity), true
+
+	case "CreatedAPIToken.token":
+		if e.complexity.CreatedAPIToken.Token == nil {
+			break
+		}
+
+		return e.complexity.CreatedAPIToken.Token(childComplexity), true
+
+	case "Entity.findTodoByID":
+		if e.complexity.Entity.FindTodoByID == nil {
+			break
+		}
+
+		args, err := ec.field_Entity_findTodoByID_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Entity.FindTodoByID(childComplexity, args["id"].(string)), true
+
+	case "ImpersonationSession.actor":
+		if e.complexity.ImpersonationSession.Actor == nil {
+			break
+		}
+
+		return e.complexity.ImpersonationSession.Actor(childComplexity), true
+
+	case "ImpersonationSession.createdAt":
+		if e.complexity.ImpersonationSession.CreatedAt == nil {
+			break
+		}
+
+		args, err := ec.field_ImpersonationSession_createdAt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.ImpersonationSession.CreatedAt(childComplexity, args["format"].(*utils.DateFormat)), true
+
+	case "ImpersonationSession.endedAt":
+		if e.complexity.ImpersonationSession.EndedAt == nil {
+			break
+		}
+
+		args, err := ec.field_ImpersonationSession_endedAt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.ImpersonationSession.EndedAt(childComplexity, args["format"].(*utils.DateFormat)), true
+
+	case "ImpersonationSession.expiresAt":
+		if e.complexity.ImpersonationSession.ExpiresAt == nil {
+			break
+		}
+
+		args, err := ec.field_ImpersonationSession_expiresAt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.ImpersonationSession.ExpiresAt(childComplexity, args["format"].(*utils.DateFormat)), true
+
+	case "ImpersonationSession.id":
+		if e.complexity.ImpersonationSession.ID == nil {
+			break
+		}
+
+		return e.complexity.ImpersonationSession.ID(childComplexity), true
+
+	case "ImpersonationSession.reason":
+		if e.complexity.ImpersonationSession.Reason == nil {
+			break
+		}
+
+		return e.complexity.ImpersonationSession.Reason(childComplexity), true
+
+	case "ImpersonationSession.target":
+		if e.complexity.ImpersonationSession.Target == nil {
+			break
+		}
+
+		return e.complexity.ImpersonationSession.Target(childComplexity), true
+
 	case "Mutation.closeTodo":
 		if e.complexity.Mutation.CloseTodo == nil {
 			break
@@ -116,6 +374,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.CloseTodo(childComplexity, args["todoId"].(string)), true
 
+	case "Mutation.createAPIToken":
+		if e.complexity.Mutation.CreateAPIToken == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createAPIToken_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAPIToken(childComplexity, args["input"].(model.NewAPIToken)), true
+
 	case "Mutation.createTodo":
 		if e.complexity.Mutation.CreateTodo == nil {
 			break
@@ -128,6 +398,66 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.CreateTodo(childComplexity, args["input"].(model.NewTodo)), true
 
+	case "Mutation.revokeAPIToken":
+		if e.complexity.Mutation.RevokeAPIToken == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeAPIToken_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeAPIToken(childComplexity, args["id"].(string)), true
+
+	case "Mutation.revokeAllUserSessions":
+		if e.complexity.Mutation.RevokeAllUserSessions == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeAllUserSessions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeAllUserSessions(childComplexity, args["owner"].(string)), true
+
+	case "Mutation.revokeSession":
+		if e.complexity.Mutation.RevokeSession == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeSession_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeSession(childComplexity, args["id"].(string)), true
+
+	case "Mutation.startImpersonation":
+		if e.complexity.Mutation.StartImpersonation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_startImpersonation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.StartImpersonation(childComplexity, args["input"].(model.StartImpersonationInput)), true
+
+	case "Mutation.stopImpersonation":
+		if e.complexity.Mutation.StopImpersonation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_stopImpersonation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.StopImpersonation(childComplexity, args["id"].(string)), true
+
 	case "Mutation.updateTodo":
 		if e.complexity.Mutation.UpdateTodo == nil {
 			break
@@ -168,6 +498,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.PageInfo.StartCursor(childComplexity), true
 
+	case "Query.apiTokens":
+		if e.complexity.Query.APITokens == nil {
+			break
+		}
+
+		return e.complexity.Query.APITokens(childComplexity), true
+
+	case "Query.me":
+		if e.complexity.Query.Me == nil {
+			break
+		}
+
+		return e.complexity.Query.Me(childComplexity), true
+
+	case "Query.sessions":
+		if e.complexity.Query.Sessions == nil {
+			break
+		}
+
+		return e.complexity.Query.Sessions(childComplexity), true
+
 	case "Query.todo":
 		if e.complexity.Query.Todo == nil {
 			break
@@ -192,6 +543,89 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Todos(childComplexity, args["after"].(*string), args["before"].(*string), args["first"].(*int), args["last"].(*int), args["sort"].(*models.SortOrder), args["sorts"].([]*models.SortOrder), args["filter"].(*models.Filter)), true
 
+	case "Query._service":
+		if e.complexity.Query.__resolve__service == nil {
+			break
+		}
+
+		return e.complexity.Query.__resolve__service(childComplexity), true
+
+	case "Query._entities":
+		if e.complexity.Query.__resolve_entities == nil {
+			break
+		}
+
+		args, err := ec.field_Query__entities_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.__resolve_entities(childComplexity, args["representations"].([]map[string]any)), true
+
+	case "Session.createdAt":
+		if e.complexity.Session.CreatedAt == nil {
+			break
+		}
+
+		args, err := ec.field_Session_createdAt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Session.CreatedAt(childComplexity, args["format"].(*utils.DateFormat)), true
+
+	case "Session.current":
+		if e.complexity.Session.Current == nil {
+			break
+		}
+
+		return e.complexity.Session.Current(childComplexity), true
+
+	case "Session.expiresAt":
+		if e.complexity.Session.ExpiresAt == nil {
+			break
+		}
+
+		args, err := ec.field_Session_expiresAt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Session.ExpiresAt(childComplexity, args["format"].(*utils.DateFormat)), true
+
+	case "Session.id":
+		if e.complexity.Session.ID == nil {
+			break
+		}
+
+		return e.complexity.Session.ID(childComplexity), true
+
+	case "Session.ipAddress":
+		if e.complexity.Session.IPAddress == nil {
+			break
+		}
+
+		return e.complexity.Session.IPAddress(childComplexity), true
+
+	case "Session.updatedAt":
+		if e.complexity.Session.UpdatedAt == nil {
+			break
+		}
+
+		args, err := ec.field_Session_updatedAt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Session.UpdatedAt(childComplexity, args["format"].(*utils.DateFormat)), true
+
+	case "Session.userAgent":
+		if e.complexity.Session.UserAgent == nil {
+			break
+		}
+
+		return e.complexity.Session.UserAgent(childComplexity), true
+
 	case "Todo.createdAt":
 		if e.complexity.Todo.CreatedAt == nil {
 			break
@@ -265,6 +699,118 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TodoEdge.Node(childComplexity), true
 
+	case "User.clientId":
+		if e.complexity.User.ClientID == nil {
+			break
+		}
+
+		return e.complexity.User.ClientID(childComplexity), true
+
+	case "User.email":
+		if e.complexity.User.Email == nil {
+			break
+		}
+
+		return e.complexity.User.Email(childComplexity), true
+
+	case "User.emailVerified":
+		if e.complexity.User.EmailVerified == nil {
+			break
+		}
+
+		return e.complexity.User.EmailVerified(childComplexity), true
+
+	case "User.extraClaims":
+		if e.complexity.User.ExtraClaims == nil {
+			break
+		}
+
+		return e.complexity.User.ExtraClaims(childComplexity), true
+
+	case "User.familyName":
+		if e.complexity.User.FamilyName == nil {
+			break
+		}
+
+		return e.complexity.User.FamilyName(childComplexity), true
+
+	case "User.identifier":
+		if e.complexity.User.GetIdentifier == nil {
+			break
+		}
+
+		return e.complexity.User.GetIdentifier(childComplexity), true
+
+	case "User.givenName":
+		if e.complexity.User.GivenName == nil {
+			break
+		}
+
+		return e.complexity.User.GivenName(childComplexity), true
+
+	case "User.groups":
+		if e.complexity.User.Groups == nil {
+			break
+		}
+
+		return e.complexity.User.Groups(childComplexity), true
+
+	case "User.issuer":
+		if e.complexity.User.Issuer == nil {
+			break
+		}
+
+		return e.complexity.User.Issuer(childComplexity), true
+
+	case "User.name":
+		if e.complexity.User.Name == nil {
+			break
+		}
+
+		return e.complexity.User.Name(childComplexity), true
+
+	case "User.username":
+		if e.complexity.User.PreferredUsername == nil {
+			break
+		}
+
+		return e.complexity.User.PreferredUsername(childComplexity), true
+
+	case "User.principalType":
+		if e.complexity.User.PrincipalType == nil {
+			break
+		}
+
+		return e.complexity.User.PrincipalType(childComplexity), true
+
+	case "User.roles":
+		if e.complexity.User.Roles == nil {
+			break
+		}
+
+		return e.complexity.User.Roles(childComplexity), true
+
+	case "User.subject":
+		if e.complexity.User.Subject == nil {
+			break
+		}
+
+		return e.complexity.User.Subject(childComplexity), true
+
+	case "User.tenant":
+		if e.complexity.User.Tenant == nil {
+			break
+		}
+
+		return e.complexity.User.Tenant(childComplexity), true
+
+	case "_Service.sdl":
+		if e.complexity._Service.SDL == nil {
+			break
+		}
+
+		return e.complexity._Service.SDL(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -276,7 +822,9 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputBooleanFilter,
 		ec.unmarshalInputDateFilter,
 		ec.unmarshalInputIntFilter,
+		ec.unmarshalInputNewAPIToken,
 		ec.unmarshalInputNewTodo,
+		ec.unmarshalInputStartImpersonationInput,
 		ec.unmarshalInputStringFilter,
 		ec.unmarshalInputTodoFilter,
 		ec.unmarshalInputTodoSortOrder,
@@ -378,6 +926,146 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 var sources = []*ast.Source{
+	{Name: "../../../../../graphql/apitoken.graphql", Input: `"""
+This represents a personal access token
+"""
+type APIToken {
+  id: ID!
+  createdAt(format: DateFormat): String!
+  updatedAt(format: DateFormat): String!
+  """
+  Expiration date (null only for tokens created before expiration date was required)
+  """
+  expiresAt(format: DateFormat): String
+  lastUsedAt(format: DateFormat): String
+  revokedAt(format: DateFormat): String
+  name: String!
+  """
+  First characters of the token to help identifying it
+  """
+  tokenPrefix: String!
+  """
+  Allowed actions (e.g. "*", "todo:*" or "todo:Create")
+  """
+  scopes: [String!]!
+}
+
+"""
+This represents a newly created personal access token
+"""
+type CreatedAPIToken {
+  """
+  Plain text token, only available at creation
+  """
+  token: String!
+  apiToken: APIToken!
+}
+
+input NewAPIToken {
+  name: String!
+  scopes: [String!]!
+  """
+  Expiration date in RFC3339 format (defaults to and is limited by the configured maximum duration)
+  """
+  expiresAt: String
+}
+
+extend type Query {
+  apiTokens: [APIToken!]! @authenticated
+}
+
+extend type Mutation {
+  createAPIToken(input: NewAPIToken!): CreatedAPIToken! @authenticated @cost(weight: 10)
+  revokeAPIToken(id: ID!): APIToken! @authenticated @cost(weight: 10)
+}
+`, BuiltIn: false},
+	{Name: "../../../../../graphql/directives.graphql", Input: `"""
+Require an authenticated user before resolver runs
+"""
+directive @authenticated on FIELD_DEFINITION
+"""
+Check authorization of an action on a resource before resolver runs.
+
+Resource can reference field arguments with placeholders:
+- "{argName}" is replaced by the argument value
+- "{argName:relay}" is replaced by the identifier contained in the relay ID argument
+
+Example: @authorize(action: "todo:Get", resource: "todo:{id:relay}")
+"""
+directive @authorize(action: String!, resource: String) on FIELD_DEFINITION
+"""
+Cost of the field used to compute operation complexity.
+
+Field cost is weight + children cost * multiplier.
+Multiplier is the greatest value of the listed arguments (default page size when none is set) or 1 when no multipliers are listed.
+
+Example: @cost(weight: 2, multipliers: ["first", "last"])
+"""
+directive @cost(weight: Int!, multipliers: [String!]) on FIELD_DEFINITION
+"""
+Cache scope of a response
+"""
+enum CacheControlScope {
+  """
+  Response can be shared between users
+  """
+  PUBLIC
+  """
+  Response is specific to the authenticated user
+  """
+  PRIVATE
+}
+"""
+Cache hint of a field or of an object type.
+
+Response max age is the lowest max age of all requested fields and scope is PRIVATE when at least one field is private.
+Root fields without hint aren't cacheable, other fields without hint inherit their parent max age.
+
+Example: @cacheControl(maxAge: 30, scope: PRIVATE)
+"""
+directive @cacheControl(maxAge: Int, scope: CacheControlScope) on FIELD_DEFINITION | OBJECT
+`, BuiltIn: false},
+	{Name: "../../../../../graphql/impersonation.graphql", Input: `"""
+This represents an audited impersonation of a user
+"""
+type ImpersonationSession {
+  id: ID!
+  createdAt(format: DateFormat): String!
+  """
+  Date after which impersonation cannot be used anymore
+  """
+  expiresAt(format: DateFormat): String!
+  endedAt(format: DateFormat): String
+  """
+  Identifier of the user impersonating
+  """
+  actor: String!
+  """
+  Username of the impersonated user
+  """
+  target: String!
+  reason: String!
+}
+
+input StartImpersonationInput {
+  """
+  Username of the user to impersonate
+  """
+  username: String!
+  """
+  Reason kept in audit log
+  """
+  reason: String!
+}
+
+extend type Mutation {
+  """
+  Start an impersonation. Requests must then be sent with the X-Impersonate-User header.
+  """
+  startImpersonation(input: StartImpersonationInput!): ImpersonationSession! @authenticated @cost(weight: 10)
+  stopImpersonation(id: ID!): ImpersonationSession! @authenticated @cost(weight: 10)
+}
+`, BuiltIn: false},
 	{Name: "../../../../../graphql/schema.graphql", Input: `# GraphQL schema example
 #
 # https://gqlgen.com/getting-started/
@@ -420,20 +1108,59 @@ type Query {
     Filter
     """
     filter: TodoFilter
-  ): TodoConnection
+  ): TodoConnection @cost(weight: 2, multipliers: ["first", "last"]) @cacheControl(maxAge: 30, scope: PRIVATE)
   todo(id: String!): Todo
+    @authorize(action: "todo:Get", resource: "todo:{id:relay}")
+    @cacheControl(maxAge: 30, scope: PRIVATE)
 }
 
 type Mutation {
-  createTodo(input: NewTodo!): Todo!
-  closeTodo(todoId: ID!): Todo!
-  updateTodo(input: UpdateTodo): Todo!
+  createTodo(input: NewTodo!): Todo! @cost(weight: 10)
+  closeTodo(todoId: ID!): Todo! @cost(weight: 10)
+  updateTodo(input: UpdateTodo): Todo! @cost(weight: 10)
+}
+`, BuiltIn: false},
+	{Name: "../../../../../graphql/session.graphql", Input: `"""
+This represents a server-side authentication session
+"""
+type Session {
+  id: ID!
+  createdAt(format: DateFormat): String!
+  """
+  Last token refresh date
+  """
+  updatedAt(format: DateFormat): String!
+  """
+  Absolute expiration date of the session
+  """
+  expiresAt(format: DateFormat): String!
+  userAgent: String!
+  ipAddress: String!
+  """
+  True when session is the one used by the current request
+  """
+  current: Boolean!
+}
+
+extend type Query {
+  sessions: [Session!]! @authenticated
+}
+
+extend type Mutation {
+  revokeSession(id: ID!): Session! @authenticated @cost(weight: 10)
+  """
+  Revoke all sessions of a user (administration operation).
+  Returns the number of revoked sessions.
+  """
+  revokeAllUserSessions(owner: String!): Int! @authenticated @cost(weight: 10)
 }
 `, BuiltIn: false},
 	{Name: "../../../../../graphql/todo.graphql", Input: `"""
 This represents a Todo object
+
+It is a federation entity resolved by its relay ID.
 """
-type Todo {
+type Todo @key(fields: "id") {
   id: ID!
   createdAt(format: DateFormat): String!
   updatedAt(format: DateFormat): String!
@@ -475,6 +1202,45 @@ input TodoFilter {
   text: StringFilter
   done: BooleanFilter
 }
+`, BuiltIn: false},
+	{Name: "../../../../../graphql/user.graphql", Input: `scalar Map
+
+"""
+This represents the authenticated principal
+"""
+type User {
+  """
+  Identifier used in logs and authorization (username, email, client id or subject)
+  """
+  identifier: String!
+  """
+  Principal type (USER for human users or SERVICE for service principals)
+  """
+  principalType: String!
+  issuer: String!
+  subject: String!
+  clientId: String!
+  username: String!
+  name: String!
+  givenName: String!
+  familyName: String!
+  email: String!
+  emailVerified: Boolean!
+  tenant: String!
+  roles: [String!]!
+  groups: [String!]!
+  """
+  Claims extracted with configured extra claim mapping
+  """
+  extraClaims: Map
+}
+
+extend type Query {
+  """
+  Authenticated principal (null when authentication isn't enabled)
+  """
+  me: User
+}
 `, BuiltIn: false},
 	{Name: "../../../../../graphql/utils.graphql", Input: `"""
 Pagination information
@@ -716,5 +1482,32 @@ enum DateFormat {
   RFC3339Nano
 }
 `, BuiltIn: false},
+	{Name: "../../../../../federation/directives.graphql", Input: `
+	directive @key(fields: _FieldSet!) repeatable on OBJECT | INTERFACE
+	directive @requires(fields: _FieldSet!) on FIELD_DEFINITION
+	directive @provides(fields: _FieldSet!) on FIELD_DEFINITION
+	directive @extends on OBJECT | INTERFACE
+	directive @external on FIELD_DEFINITION
+	scalar _Any
+	scalar _FieldSet
+`, BuiltIn: true},
+	{Name: "../../../../../federation/entity.graphql", Input: `
+# a union of all types that use the @key directive
+union _Entity = Todo
+
+# fake type to build resolver interfaces for users to implement
+type Entity {
+	findTodoByID(id: ID!,): Todo!
+}
+
+type _Service {
+  sdl: String
+}
+
+extend type Query {
+  _entities(representations: [_Any!]!): [_Entity]!
+  _service: _Service!
+}
+`, BuiltIn: true},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
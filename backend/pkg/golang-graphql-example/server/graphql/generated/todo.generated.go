@@ -555,7 +555,7 @@ func (ec *executionContext) unmarshalInputUpdateTodo(ctx context.Context, obj an
 
 // region    **************************** object.gotpl ****************************
 
-var todoImplementors = []string{"Todo"}
+var todoImplementors = []string{"Todo", "_Entity"}
 
 func (ec *executionContext) _Todo(ctx context.Context, sel ast.SelectionSet, obj *models.Todo) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, todoImplementors)
@@ -805,7 +805,7 @@ func (ec *executionContext) marshalNTodo2githubᚗcomᚋoxynoᚑzetaᚋgolangᚑ
 func (ec *executionContext) marshalNTodo2ᚖgithubᚗcomᚋoxynoᚑzetaᚋgolangᚑgraphqlᚑexampleᚋpkgᚋgolangᚑgraphqlᚑexampleᚋbusinessᚋtodosᚋmodelsᚐTodo(ctx context.Context, sel ast.SelectionSet, v *models.Todo) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
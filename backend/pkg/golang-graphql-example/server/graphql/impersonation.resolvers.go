@@ -0,0 +1,63 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.84
+
+import (
+	"context"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/impersonations/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/generated"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/mappers"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/model"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+)
+
+// ID is the resolver for the id field.
+func (r *impersonationSessionResolver) ID(ctx context.Context, obj *models.ImpersonationSession) (string, error) {
+	return utils.ToIDRelay(mappers.ImpersonationSessionIDPrefix, obj.ID), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *impersonationSessionResolver) CreatedAt(ctx context.Context, obj *models.ImpersonationSession, format *utils.DateFormat) (string, error) {
+	return utils.FormatTime(format, obj.CreatedAt), nil
+}
+
+// ExpiresAt is the resolver for the expiresAt field.
+func (r *impersonationSessionResolver) ExpiresAt(ctx context.Context, obj *models.ImpersonationSession, format *utils.DateFormat) (string, error) {
+	return utils.FormatTime(format, obj.ExpiresAt), nil
+}
+
+// EndedAt is the resolver for the endedAt field.
+func (r *impersonationSessionResolver) EndedAt(ctx context.Context, obj *models.ImpersonationSession, format *utils.DateFormat) (*string, error) {
+	return utils.FormatOptionalTime(format, obj.EndedAt), nil
+}
+
+// StartImpersonation is the resolver for the startImpersonation field.
+func (r *mutationResolver) StartImpersonation(ctx context.Context, input model.StartImpersonationInput) (*models.ImpersonationSession, error) {
+	return r.BusiServices.ImpersonationSvc.Start(ctx, &impersonations.InputStartImpersonation{
+		Username: input.Username,
+		Reason:   input.Reason,
+	})
+}
+
+// StopImpersonation is the resolver for the stopImpersonation field.
+func (r *mutationResolver) StopImpersonation(ctx context.Context, id string) (*models.ImpersonationSession, error) {
+	// Manage relay id
+	bid, err := utils.FromIDRelay(id, mappers.ImpersonationSessionIDPrefix)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.BusiServices.ImpersonationSvc.Stop(ctx, bid)
+}
+
+// ImpersonationSession returns generated.ImpersonationSessionResolver implementation.
+func (r *Resolver) ImpersonationSession() generated.ImpersonationSessionResolver {
+	return &impersonationSessionResolver{r}
+}
+
+type impersonationSessionResolver struct{ *Resolver }
@@ -1,3 +1,8 @@
 package mappers
 
-const TodoIDPrefix = "todos"
+const (
+	TodoIDPrefix                 = "todos"
+	APITokenIDPrefix             = "apitokens"
+	SessionIDPrefix              = "sessions"
+	ImpersonationSessionIDPrefix = "impersonations"
+)
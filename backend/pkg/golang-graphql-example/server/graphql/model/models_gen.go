@@ -3,13 +3,33 @@
 package model
 
 import (
-	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/apitokens/models"
+	models1 "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
 )
 
+// This represents a newly created personal access token
+type CreatedAPIToken struct {
+	// Plain text token, only available at creation
+	Token    string           `json:"token"`
+	APIToken *models.APIToken `json:"apiToken"`
+}
+
 type Mutation struct {
 }
 
+type NewAPIToken struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+	// Expiration date in RFC3339 format (defaults to and is limited by the configured maximum duration)
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+}
+
 type NewTodo struct {
 	Text string `json:"text"`
 }
@@ -17,17 +37,82 @@ type NewTodo struct {
 type Query struct {
 }
 
+type StartImpersonationInput struct {
+	// Username of the user to impersonate
+	Username string `json:"username"`
+	// Reason kept in audit log
+	Reason string `json:"reason"`
+}
+
 type TodoConnection struct {
 	Edges    []*TodoEdge     `json:"edges,omitempty"`
 	PageInfo *utils.PageInfo `json:"pageInfo"`
 }
 
 type TodoEdge struct {
-	Cursor string       `json:"cursor"`
-	Node   *models.Todo `json:"node,omitempty"`
+	Cursor string        `json:"cursor"`
+	Node   *models1.Todo `json:"node,omitempty"`
 }
 
 type UpdateTodo struct {
 	ID   string `json:"id"`
 	Text string `json:"text"`
 }
+
+// Cache scope of a response
+type CacheControlScope string
+
+const (
+	// Response can be shared between users
+	CacheControlScopePublic CacheControlScope = "PUBLIC"
+	// Response is specific to the authenticated user
+	CacheControlScopePrivate CacheControlScope = "PRIVATE"
+)
+
+var AllCacheControlScope = []CacheControlScope{
+	CacheControlScopePublic,
+	CacheControlScopePrivate,
+}
+
+func (e CacheControlScope) IsValid() bool {
+	switch e {
+	case CacheControlScopePublic, CacheControlScopePrivate:
+		return true
+	}
+	return false
+}
+
+func (e CacheControlScope) String() string {
+	return string(e)
+}
+
+func (e *CacheControlScope) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CacheControlScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CacheControlScope", str)
+	}
+	return nil
+}
+
+func (e CacheControlScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CacheControlScope) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CacheControlScope) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
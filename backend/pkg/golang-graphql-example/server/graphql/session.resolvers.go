@@ -0,0 +1,81 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.84
+
+import (
+	"context"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/sessions/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/generated"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/mappers"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+)
+
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (*models.Session, error) {
+	// Manage relay id
+	bid, err := utils.FromIDRelay(id, mappers.SessionIDPrefix)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.BusiServices.SessionSvc.Revoke(ctx, bid)
+}
+
+// RevokeAllUserSessions is the resolver for the revokeAllUserSessions field.
+func (r *mutationResolver) RevokeAllUserSessions(ctx context.Context, owner string) (int, error) {
+	return r.BusiServices.SessionSvc.RevokeAllForUser(ctx, owner)
+}
+
+// Sessions is the resolver for the sessions field.
+func (r *queryResolver) Sessions(ctx context.Context) ([]*models.Session, error) {
+	// Get projection
+	proj := &models.Projection{}
+	err := utils.ManageSimpleProjection(ctx, proj)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Id is always needed to compute current flag
+	proj.ID = true
+
+	return r.BusiServices.SessionSvc.FindForCurrentUser(ctx, proj)
+}
+
+// ID is the resolver for the id field.
+func (r *sessionResolver) ID(ctx context.Context, obj *models.Session) (string, error) {
+	return utils.ToIDRelay(mappers.SessionIDPrefix, obj.ID), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *sessionResolver) CreatedAt(ctx context.Context, obj *models.Session, format *utils.DateFormat) (string, error) {
+	return utils.FormatTime(format, obj.CreatedAt), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *sessionResolver) UpdatedAt(ctx context.Context, obj *models.Session, format *utils.DateFormat) (string, error) {
+	return utils.FormatTime(format, obj.UpdatedAt), nil
+}
+
+// ExpiresAt is the resolver for the expiresAt field.
+func (r *sessionResolver) ExpiresAt(ctx context.Context, obj *models.Session, format *utils.DateFormat) (string, error) {
+	return utils.FormatTime(format, obj.ExpiresAt), nil
+}
+
+// Current is the resolver for the current field.
+func (r *sessionResolver) Current(ctx context.Context, obj *models.Session) (bool, error) {
+	// Get user from context
+	user := authentication.GetAuthenticatedUserFromContext(ctx)
+
+	return user != nil && user.SessionID != "" && user.SessionID == obj.ID, nil
+}
+
+// Session returns generated.SessionResolver implementation.
+func (r *Resolver) Session() generated.SessionResolver { return &sessionResolver{r} }
+
+type sessionResolver struct{ *Resolver }
@@ -49,3 +49,30 @@ func CalculateQueryConnectionComplexity(
 	// Result
 	return childComplexity*size + baseQueryConnectionComplexity
 }
+
+// CalculateCostComplexity will calculate a field complexity from its cost directive.
+// Multiplier is the greatest set value of multipliers, default page size when none is set
+// or 1 when there isn't any multiplier.
+func CalculateCostComplexity(childComplexity, weight int, multipliers []*int) int {
+	// Initialize multiplier
+	multiplier := 1
+
+	// Check if multipliers are declared
+	if len(multipliers) != 0 {
+		multiplier = 0
+
+		for _, it := range multipliers {
+			if it != nil && *it > multiplier {
+				multiplier = *it
+			}
+		}
+
+		// Check if any multiplier is set
+		if multiplier == 0 {
+			multiplier = defaultDefaultPageSize
+		}
+	}
+
+	// Result
+	return weight + childComplexity*multiplier
+}
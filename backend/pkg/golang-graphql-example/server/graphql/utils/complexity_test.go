@@ -184,3 +184,60 @@ func TestCalculateQueryConnectionComplexity(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateCostComplexity(t *testing.T) {
+	intStar := func(s int) *int { return &s }
+	type args struct {
+		childComplexity int
+		weight          int
+		multipliers     []*int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "no multipliers",
+			args: args{
+				childComplexity: 5,
+				weight:          10,
+			},
+			want: 15,
+		},
+		{
+			name: "multipliers not set",
+			args: args{
+				childComplexity: 5,
+				weight:          2,
+				multipliers:     []*int{nil, nil},
+			},
+			want: 52,
+		},
+		{
+			name: "one multiplier set",
+			args: args{
+				childComplexity: 5,
+				weight:          2,
+				multipliers:     []*int{nil, intStar(5)},
+			},
+			want: 27,
+		},
+		{
+			name: "greatest multiplier",
+			args: args{
+				childComplexity: 5,
+				weight:          2,
+				multipliers:     []*int{intStar(25), intStar(5)},
+			},
+			want: 127,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CalculateCostComplexity(tt.args.childComplexity, tt.args.weight, tt.args.multipliers); got != tt.want {
+				t.Errorf("CalculateCostComplexity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
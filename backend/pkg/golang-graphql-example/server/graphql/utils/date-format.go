@@ -69,3 +69,15 @@ func FormatTime(format *DateFormat, ti time.Time) string {
 		return ti.UTC().Format(time.RFC3339)
 	}
 }
+
+// FormatOptionalTime will format a nullable time and return nil if not set.
+func FormatOptionalTime(format *DateFormat, ti *time.Time) *string {
+	// Check if it is null
+	if ti == nil {
+		return nil
+	}
+
+	res := FormatTime(format, *ti)
+
+	return &res
+}
@@ -36,6 +36,7 @@ func ManageDepthProjection(
 	// Get operation context
 	octx := graphql.GetOperationContext(ctx)
 	// Get graphql fields
+	// Fields of @defer fragments are collected too so they are loaded with the parent object
 	fields := graphql.CollectFieldsCtx(ctx, nil)
 
 	// Dive to get collected fields under chain
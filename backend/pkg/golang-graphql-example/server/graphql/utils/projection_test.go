@@ -407,6 +407,10 @@ func TestManageConnectionNodeProjection(t *testing.T) {
 	type Out2 struct {
 		Field1 bool `graphqlfield:"field1"`
 	}
+	type Out3 struct {
+		Field1 bool `graphqlfield:"field1"`
+		Field2 bool `graphqlfield:"field2"`
+	}
 	type args struct {
 		fctx          *graphql.FieldContext
 		projectionOut interface{}
@@ -508,6 +512,82 @@ func TestManageConnectionNodeProjection(t *testing.T) {
 				Field1: true,
 			},
 		},
+		{
+			name: "deferred fragment in node",
+			args: args{
+				projectionOut: &Out3{},
+				fctx: &graphql.FieldContext{
+					Field: graphql.CollectedField{
+						Selections: ast.SelectionSet{
+							&ast.Field{
+								Name: "edges",
+								SelectionSet: ast.SelectionSet{
+									&ast.Field{
+										Name: "node",
+										SelectionSet: ast.SelectionSet{
+											&ast.Field{Name: "field1"},
+											&ast.InlineFragment{
+												Directives: ast.DirectiveList{{Name: "defer"}},
+												SelectionSet: ast.SelectionSet{
+													&ast.Field{Name: "field2"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &Out3{
+				Field1: true,
+				Field2: true,
+			},
+		},
+		{
+			name: "deferred fragment containing edges merged with not deferred edges",
+			args: args{
+				projectionOut: &Out3{},
+				fctx: &graphql.FieldContext{
+					Field: graphql.CollectedField{
+						Selections: ast.SelectionSet{
+							&ast.Field{
+								Name: "edges",
+								SelectionSet: ast.SelectionSet{
+									&ast.Field{
+										Name: "node",
+										SelectionSet: ast.SelectionSet{
+											&ast.Field{Name: "field1"},
+										},
+									},
+								},
+							},
+							&ast.InlineFragment{
+								Directives: ast.DirectiveList{{Name: "defer"}},
+								SelectionSet: ast.SelectionSet{
+									&ast.Field{
+										Name: "edges",
+										SelectionSet: ast.SelectionSet{
+											&ast.Field{
+												Name: "node",
+												SelectionSet: ast.SelectionSet{
+													&ast.Field{Name: "field2"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &Out3{
+				Field1: true,
+				Field2: true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
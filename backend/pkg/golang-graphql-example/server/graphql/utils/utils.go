@@ -25,30 +25,42 @@ func ToIDRelay(prefix, id string) string {
 }
 
 func FromIDRelay(relayID, prefix string) (string, error) {
+	// Decode
+	idPrefix, id, err := DecodeIDRelay(relayID)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+	// Check that first item of split is a good
+	if idPrefix != prefix {
+		return "", errors.NewInvalidInputError("invalid relay prefix")
+	}
+
+	return id, nil
+}
+
+// DecodeIDRelay will decode a relay ID and return its prefix and identifier.
+func DecodeIDRelay(relayID string) (prefix, id string, err error) {
 	// Base64 decode
 	idBb, err := base64.StdEncoding.DecodeString(relayID)
 	// Check error
 	if err != nil {
-		return "", errors.NewInvalidInputErrorWithError(err)
+		return "", "", errors.NewInvalidInputErrorWithError(err)
 	}
 
 	// Validate utf8
 	if !utf8.Valid(idBb) {
-		return "", errors.NewInvalidInputError("not utf8 compatible")
+		return "", "", errors.NewInvalidInputError("not utf8 compatible")
 	}
 
 	idContent := string(idBb)
 	// Split
 	sp := strings.Split(idContent, ":")
 	if len(sp) != relayIDSplitSize {
-		return "", errors.NewInvalidInputError("format error on relay token")
-	}
-	// Check that first item of split is a good
-	if sp[0] != prefix {
-		return "", errors.NewInvalidInputError("invalid relay prefix")
+		return "", "", errors.NewInvalidInputError("format error on relay token")
 	}
 
-	return sp[1], nil
+	return sp[0], sp[1], nil
 }
 
 func GetPaginateCursor(tableIndex, skip int) string {
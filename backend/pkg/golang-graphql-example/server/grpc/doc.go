@@ -0,0 +1,3 @@
+package grpc
+
+// This package will manage the gRPC server and the services generated from protobuf definitions (located in "proto/" folder).
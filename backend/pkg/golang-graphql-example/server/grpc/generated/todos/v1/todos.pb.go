@@ -0,0 +1,1594 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: todos/v1/todos.proto
+
+package todosv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Sort order direction.
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_UNSPECIFIED SortOrder = 0
+	SortOrder_SORT_ORDER_ASC         SortOrder = 1
+	SortOrder_SORT_ORDER_DESC        SortOrder = 2
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UNSPECIFIED",
+		1: "SORT_ORDER_ASC",
+		2: "SORT_ORDER_DESC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_UNSPECIFIED": 0,
+		"SORT_ORDER_ASC":         1,
+		"SORT_ORDER_DESC":        2,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_todos_v1_todos_proto_enumTypes[0].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_todos_v1_todos_proto_enumTypes[0]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{0}
+}
+
+// Todo object.
+type Todo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Relay ID (same as GraphQL).
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Done          bool                   `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Todo) Reset() {
+	*x = Todo{}
+	mi := &file_todos_v1_todos_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Todo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Todo) ProtoMessage() {}
+
+func (x *Todo) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Todo.ProtoReflect.Descriptor instead.
+func (*Todo) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Todo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Todo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Todo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Todo) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Todo) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+// Relay pagination input.
+type PageInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	First         *int32                 `protobuf:"varint,1,opt,name=first,proto3,oneof" json:"first,omitempty"`
+	Last          *int32                 `protobuf:"varint,2,opt,name=last,proto3,oneof" json:"last,omitempty"`
+	After         *string                `protobuf:"bytes,3,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	Before        *string                `protobuf:"bytes,4,opt,name=before,proto3,oneof" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageInput) Reset() {
+	*x = PageInput{}
+	mi := &file_todos_v1_todos_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageInput) ProtoMessage() {}
+
+func (x *PageInput) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageInput.ProtoReflect.Descriptor instead.
+func (*PageInput) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PageInput) GetFirst() int32 {
+	if x != nil && x.First != nil {
+		return *x.First
+	}
+	return 0
+}
+
+func (x *PageInput) GetLast() int32 {
+	if x != nil && x.Last != nil {
+		return *x.Last
+	}
+	return 0
+}
+
+func (x *PageInput) GetAfter() string {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return ""
+}
+
+func (x *PageInput) GetBefore() string {
+	if x != nil && x.Before != nil {
+		return *x.Before
+	}
+	return ""
+}
+
+// Relay pagination information.
+type PageInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StartCursor     *string                `protobuf:"bytes,1,opt,name=start_cursor,json=startCursor,proto3,oneof" json:"start_cursor,omitempty"`
+	EndCursor       *string                `protobuf:"bytes,2,opt,name=end_cursor,json=endCursor,proto3,oneof" json:"end_cursor,omitempty"`
+	HasNextPage     bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	HasPreviousPage bool                   `protobuf:"varint,4,opt,name=has_previous_page,json=hasPreviousPage,proto3" json:"has_previous_page,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PageInfo) Reset() {
+	*x = PageInfo{}
+	mi := &file_todos_v1_todos_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageInfo) ProtoMessage() {}
+
+func (x *PageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageInfo.ProtoReflect.Descriptor instead.
+func (*PageInfo) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PageInfo) GetStartCursor() string {
+	if x != nil && x.StartCursor != nil {
+		return *x.StartCursor
+	}
+	return ""
+}
+
+func (x *PageInfo) GetEndCursor() string {
+	if x != nil && x.EndCursor != nil {
+		return *x.EndCursor
+	}
+	return ""
+}
+
+func (x *PageInfo) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *PageInfo) GetHasPreviousPage() bool {
+	if x != nil {
+		return x.HasPreviousPage
+	}
+	return false
+}
+
+// String filter structure.
+type StringFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Allow to test equality to
+	Eq *string `protobuf:"bytes,1,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
+	// Allow to test non equality to
+	NotEq *string `protobuf:"bytes,2,opt,name=not_eq,json=notEq,proto3,oneof" json:"not_eq,omitempty"`
+	// Allow to test if a string contains another string.
+	Contains *string `protobuf:"bytes,3,opt,name=contains,proto3,oneof" json:"contains,omitempty"`
+	// Allow to test if a string isn't containing another string.
+	NotContains *string `protobuf:"bytes,4,opt,name=not_contains,json=notContains,proto3,oneof" json:"not_contains,omitempty"`
+	// Allow to test if a string starts with another string.
+	StartsWith *string `protobuf:"bytes,5,opt,name=starts_with,json=startsWith,proto3,oneof" json:"starts_with,omitempty"`
+	// Allow to test if a string isn't starting with another string.
+	NotStartsWith *string `protobuf:"bytes,6,opt,name=not_starts_with,json=notStartsWith,proto3,oneof" json:"not_starts_with,omitempty"`
+	// Allow to test if a string ends with another string.
+	EndsWith *string `protobuf:"bytes,7,opt,name=ends_with,json=endsWith,proto3,oneof" json:"ends_with,omitempty"`
+	// Allow to test if a string isn't ending with another string.
+	NotEndsWith *string `protobuf:"bytes,8,opt,name=not_ends_with,json=notEndsWith,proto3,oneof" json:"not_ends_with,omitempty"`
+	// Allow to test if value is in array
+	In []string `protobuf:"bytes,9,rep,name=in,proto3" json:"in,omitempty"`
+	// Allow to test if value isn't in array
+	NotIn []string `protobuf:"bytes,10,rep,name=not_in,json=notIn,proto3" json:"not_in,omitempty"`
+	// Allow to test if value is null
+	IsNull bool `protobuf:"varint,11,opt,name=is_null,json=isNull,proto3" json:"is_null,omitempty"`
+	// Allow to test if value is not null
+	IsNotNull bool `protobuf:"varint,12,opt,name=is_not_null,json=isNotNull,proto3" json:"is_not_null,omitempty"`
+	// Allow case insensitive search.
+	CaseInsensitive bool `protobuf:"varint,13,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StringFilter) Reset() {
+	*x = StringFilter{}
+	mi := &file_todos_v1_todos_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringFilter) ProtoMessage() {}
+
+func (x *StringFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringFilter.ProtoReflect.Descriptor instead.
+func (*StringFilter) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StringFilter) GetEq() string {
+	if x != nil && x.Eq != nil {
+		return *x.Eq
+	}
+	return ""
+}
+
+func (x *StringFilter) GetNotEq() string {
+	if x != nil && x.NotEq != nil {
+		return *x.NotEq
+	}
+	return ""
+}
+
+func (x *StringFilter) GetContains() string {
+	if x != nil && x.Contains != nil {
+		return *x.Contains
+	}
+	return ""
+}
+
+func (x *StringFilter) GetNotContains() string {
+	if x != nil && x.NotContains != nil {
+		return *x.NotContains
+	}
+	return ""
+}
+
+func (x *StringFilter) GetStartsWith() string {
+	if x != nil && x.StartsWith != nil {
+		return *x.StartsWith
+	}
+	return ""
+}
+
+func (x *StringFilter) GetNotStartsWith() string {
+	if x != nil && x.NotStartsWith != nil {
+		return *x.NotStartsWith
+	}
+	return ""
+}
+
+func (x *StringFilter) GetEndsWith() string {
+	if x != nil && x.EndsWith != nil {
+		return *x.EndsWith
+	}
+	return ""
+}
+
+func (x *StringFilter) GetNotEndsWith() string {
+	if x != nil && x.NotEndsWith != nil {
+		return *x.NotEndsWith
+	}
+	return ""
+}
+
+func (x *StringFilter) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *StringFilter) GetNotIn() []string {
+	if x != nil {
+		return x.NotIn
+	}
+	return nil
+}
+
+func (x *StringFilter) GetIsNull() bool {
+	if x != nil {
+		return x.IsNull
+	}
+	return false
+}
+
+func (x *StringFilter) GetIsNotNull() bool {
+	if x != nil {
+		return x.IsNotNull
+	}
+	return false
+}
+
+func (x *StringFilter) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+// Boolean filter structure.
+type BooleanFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Allow to test equality to
+	Eq *bool `protobuf:"varint,1,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
+	// Allow to test non equality to
+	NotEq         *bool `protobuf:"varint,2,opt,name=not_eq,json=notEq,proto3,oneof" json:"not_eq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BooleanFilter) Reset() {
+	*x = BooleanFilter{}
+	mi := &file_todos_v1_todos_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BooleanFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BooleanFilter) ProtoMessage() {}
+
+func (x *BooleanFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BooleanFilter.ProtoReflect.Descriptor instead.
+func (*BooleanFilter) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BooleanFilter) GetEq() bool {
+	if x != nil && x.Eq != nil {
+		return *x.Eq
+	}
+	return false
+}
+
+func (x *BooleanFilter) GetNotEq() bool {
+	if x != nil && x.NotEq != nil {
+		return *x.NotEq
+	}
+	return false
+}
+
+// Date filter structure.
+type DateFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Allow to test equality to
+	Eq *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=eq,proto3" json:"eq,omitempty"`
+	// Allow to test non equality to
+	NotEq *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=not_eq,json=notEq,proto3" json:"not_eq,omitempty"`
+	// Allow to test greater or equal than
+	Gte *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=gte,proto3" json:"gte,omitempty"`
+	// Allow to test not greater or equal than
+	NotGte *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=not_gte,json=notGte,proto3" json:"not_gte,omitempty"`
+	// Allow to test greater than
+	Gt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=gt,proto3" json:"gt,omitempty"`
+	// Allow to test not greater than
+	NotGt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=not_gt,json=notGt,proto3" json:"not_gt,omitempty"`
+	// Allow to test less or equal than
+	Lte *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=lte,proto3" json:"lte,omitempty"`
+	// Allow to test not less or equal than
+	NotLte *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=not_lte,json=notLte,proto3" json:"not_lte,omitempty"`
+	// Allow to test less than
+	Lt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=lt,proto3" json:"lt,omitempty"`
+	// Allow to test not less than
+	NotLt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=not_lt,json=notLt,proto3" json:"not_lt,omitempty"`
+	// Allow to test if value is in array
+	In []*timestamppb.Timestamp `protobuf:"bytes,11,rep,name=in,proto3" json:"in,omitempty"`
+	// Allow to test if value isn't in array
+	NotIn []*timestamppb.Timestamp `protobuf:"bytes,12,rep,name=not_in,json=notIn,proto3" json:"not_in,omitempty"`
+	// Allow to test if value is null
+	IsNull bool `protobuf:"varint,13,opt,name=is_null,json=isNull,proto3" json:"is_null,omitempty"`
+	// Allow to test if value is not null
+	IsNotNull     bool `protobuf:"varint,14,opt,name=is_not_null,json=isNotNull,proto3" json:"is_not_null,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateFilter) Reset() {
+	*x = DateFilter{}
+	mi := &file_todos_v1_todos_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateFilter) ProtoMessage() {}
+
+func (x *DateFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateFilter.ProtoReflect.Descriptor instead.
+func (*DateFilter) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DateFilter) GetEq() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *DateFilter) GetNotEq() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotEq
+	}
+	return nil
+}
+
+func (x *DateFilter) GetGte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *DateFilter) GetNotGte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotGte
+	}
+	return nil
+}
+
+func (x *DateFilter) GetGt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *DateFilter) GetNotGt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotGt
+	}
+	return nil
+}
+
+func (x *DateFilter) GetLte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+func (x *DateFilter) GetNotLte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotLte
+	}
+	return nil
+}
+
+func (x *DateFilter) GetLt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *DateFilter) GetNotLt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotLt
+	}
+	return nil
+}
+
+func (x *DateFilter) GetIn() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *DateFilter) GetNotIn() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.NotIn
+	}
+	return nil
+}
+
+func (x *DateFilter) GetIsNull() bool {
+	if x != nil {
+		return x.IsNull
+	}
+	return false
+}
+
+func (x *DateFilter) GetIsNotNull() bool {
+	if x != nil {
+		return x.IsNotNull
+	}
+	return false
+}
+
+// Todo sort order (only one field must be set per item).
+type TodoSortOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt     SortOrder              `protobuf:"varint,1,opt,name=created_at,json=createdAt,proto3,enum=todos.v1.SortOrder" json:"created_at,omitempty"`
+	UpdatedAt     SortOrder              `protobuf:"varint,2,opt,name=updated_at,json=updatedAt,proto3,enum=todos.v1.SortOrder" json:"updated_at,omitempty"`
+	Text          SortOrder              `protobuf:"varint,3,opt,name=text,proto3,enum=todos.v1.SortOrder" json:"text,omitempty"`
+	Done          SortOrder              `protobuf:"varint,4,opt,name=done,proto3,enum=todos.v1.SortOrder" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TodoSortOrder) Reset() {
+	*x = TodoSortOrder{}
+	mi := &file_todos_v1_todos_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TodoSortOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodoSortOrder) ProtoMessage() {}
+
+func (x *TodoSortOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodoSortOrder.ProtoReflect.Descriptor instead.
+func (*TodoSortOrder) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TodoSortOrder) GetCreatedAt() SortOrder {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *TodoSortOrder) GetUpdatedAt() SortOrder {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *TodoSortOrder) GetText() SortOrder {
+	if x != nil {
+		return x.Text
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *TodoSortOrder) GetDone() SortOrder {
+	if x != nil {
+		return x.Done
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+// Todo filter structure.
+type TodoFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	And           []*TodoFilter          `protobuf:"bytes,1,rep,name=and,proto3" json:"and,omitempty"`
+	Or            []*TodoFilter          `protobuf:"bytes,2,rep,name=or,proto3" json:"or,omitempty"`
+	CreatedAt     *DateFilter            `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *DateFilter            `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Text          *StringFilter          `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	Done          *BooleanFilter         `protobuf:"bytes,6,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TodoFilter) Reset() {
+	*x = TodoFilter{}
+	mi := &file_todos_v1_todos_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TodoFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodoFilter) ProtoMessage() {}
+
+func (x *TodoFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodoFilter.ProtoReflect.Descriptor instead.
+func (*TodoFilter) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TodoFilter) GetAnd() []*TodoFilter {
+	if x != nil {
+		return x.And
+	}
+	return nil
+}
+
+func (x *TodoFilter) GetOr() []*TodoFilter {
+	if x != nil {
+		return x.Or
+	}
+	return nil
+}
+
+func (x *TodoFilter) GetCreatedAt() *DateFilter {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TodoFilter) GetUpdatedAt() *DateFilter {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TodoFilter) GetText() *StringFilter {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *TodoFilter) GetDone() *BooleanFilter {
+	if x != nil {
+		return x.Done
+	}
+	return nil
+}
+
+type TodoEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Node          *Todo                  `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TodoEdge) Reset() {
+	*x = TodoEdge{}
+	mi := &file_todos_v1_todos_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TodoEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodoEdge) ProtoMessage() {}
+
+func (x *TodoEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodoEdge.ProtoReflect.Descriptor instead.
+func (*TodoEdge) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TodoEdge) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *TodoEdge) GetNode() *Todo {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type ListTodosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageInput             `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Sorts         []*TodoSortOrder       `protobuf:"bytes,2,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	Filter        *TodoFilter            `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTodosRequest) Reset() {
+	*x = ListTodosRequest{}
+	mi := &file_todos_v1_todos_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTodosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTodosRequest) ProtoMessage() {}
+
+func (x *ListTodosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTodosRequest.ProtoReflect.Descriptor instead.
+func (*ListTodosRequest) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTodosRequest) GetPage() *PageInput {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListTodosRequest) GetSorts() []*TodoSortOrder {
+	if x != nil {
+		return x.Sorts
+	}
+	return nil
+}
+
+func (x *ListTodosRequest) GetFilter() *TodoFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListTodosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edges         []*TodoEdge            `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	PageInfo      *PageInfo              `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTodosResponse) Reset() {
+	*x = ListTodosResponse{}
+	mi := &file_todos_v1_todos_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTodosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTodosResponse) ProtoMessage() {}
+
+func (x *ListTodosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTodosResponse.ProtoReflect.Descriptor instead.
+func (*ListTodosResponse) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListTodosResponse) GetEdges() []*TodoEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *ListTodosResponse) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type GetTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Relay ID
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTodoRequest) Reset() {
+	*x = GetTodoRequest{}
+	mi := &file_todos_v1_todos_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodoRequest) ProtoMessage() {}
+
+func (x *GetTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodoRequest.ProtoReflect.Descriptor instead.
+func (*GetTodoRequest) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTodoResponse) Reset() {
+	*x = GetTodoResponse{}
+	mi := &file_todos_v1_todos_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodoResponse) ProtoMessage() {}
+
+func (x *GetTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodoResponse.ProtoReflect.Descriptor instead.
+func (*GetTodoResponse) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTodoResponse) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+type CreateTodoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTodoRequest) Reset() {
+	*x = CreateTodoRequest{}
+	mi := &file_todos_v1_todos_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTodoRequest) ProtoMessage() {}
+
+func (x *CreateTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTodoRequest.ProtoReflect.Descriptor instead.
+func (*CreateTodoRequest) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateTodoRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type CreateTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTodoResponse) Reset() {
+	*x = CreateTodoResponse{}
+	mi := &file_todos_v1_todos_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTodoResponse) ProtoMessage() {}
+
+func (x *CreateTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTodoResponse.ProtoReflect.Descriptor instead.
+func (*CreateTodoResponse) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateTodoResponse) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+type UpdateTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Relay ID
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTodoRequest) Reset() {
+	*x = UpdateTodoRequest{}
+	mi := &file_todos_v1_todos_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTodoRequest) ProtoMessage() {}
+
+func (x *UpdateTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTodoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTodoRequest) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTodoRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type UpdateTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTodoResponse) Reset() {
+	*x = UpdateTodoResponse{}
+	mi := &file_todos_v1_todos_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTodoResponse) ProtoMessage() {}
+
+func (x *UpdateTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTodoResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTodoResponse) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateTodoResponse) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+type CloseTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Relay ID
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseTodoRequest) Reset() {
+	*x = CloseTodoRequest{}
+	mi := &file_todos_v1_todos_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseTodoRequest) ProtoMessage() {}
+
+func (x *CloseTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseTodoRequest.ProtoReflect.Descriptor instead.
+func (*CloseTodoRequest) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CloseTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CloseTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseTodoResponse) Reset() {
+	*x = CloseTodoResponse{}
+	mi := &file_todos_v1_todos_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseTodoResponse) ProtoMessage() {}
+
+func (x *CloseTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todos_v1_todos_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseTodoResponse.ProtoReflect.Descriptor instead.
+func (*CloseTodoResponse) Descriptor() ([]byte, []int) {
+	return file_todos_v1_todos_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CloseTodoResponse) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+var File_todos_v1_todos_proto protoreflect.FileDescriptor
+
+const file_todos_v1_todos_proto_rawDesc = "" +
+	"\n" +
+	"\x14todos/v1/todos.proto\x12\btodos.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x01\n" +
+	"\x04Todo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x12\n" +
+	"\x04done\x18\x05 \x01(\bR\x04done\"\x9f\x01\n" +
+	"\tPageInput\x12\x19\n" +
+	"\x05first\x18\x01 \x01(\x05H\x00R\x05first\x88\x01\x01\x12\x17\n" +
+	"\x04last\x18\x02 \x01(\x05H\x01R\x04last\x88\x01\x01\x12\x19\n" +
+	"\x05after\x18\x03 \x01(\tH\x02R\x05after\x88\x01\x01\x12\x1b\n" +
+	"\x06before\x18\x04 \x01(\tH\x03R\x06before\x88\x01\x01B\b\n" +
+	"\x06_firstB\a\n" +
+	"\x05_lastB\b\n" +
+	"\x06_afterB\t\n" +
+	"\a_before\"\xc6\x01\n" +
+	"\bPageInfo\x12&\n" +
+	"\fstart_cursor\x18\x01 \x01(\tH\x00R\vstartCursor\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"end_cursor\x18\x02 \x01(\tH\x01R\tendCursor\x88\x01\x01\x12\"\n" +
+	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12*\n" +
+	"\x11has_previous_page\x18\x04 \x01(\bR\x0fhasPreviousPageB\x0f\n" +
+	"\r_start_cursorB\r\n" +
+	"\v_end_cursor\"\xa5\x04\n" +
+	"\fStringFilter\x12\x13\n" +
+	"\x02eq\x18\x01 \x01(\tH\x00R\x02eq\x88\x01\x01\x12\x1a\n" +
+	"\x06not_eq\x18\x02 \x01(\tH\x01R\x05notEq\x88\x01\x01\x12\x1f\n" +
+	"\bcontains\x18\x03 \x01(\tH\x02R\bcontains\x88\x01\x01\x12&\n" +
+	"\fnot_contains\x18\x04 \x01(\tH\x03R\vnotContains\x88\x01\x01\x12$\n" +
+	"\vstarts_with\x18\x05 \x01(\tH\x04R\n" +
+	"startsWith\x88\x01\x01\x12+\n" +
+	"\x0fnot_starts_with\x18\x06 \x01(\tH\x05R\rnotStartsWith\x88\x01\x01\x12 \n" +
+	"\tends_with\x18\a \x01(\tH\x06R\bendsWith\x88\x01\x01\x12'\n" +
+	"\rnot_ends_with\x18\b \x01(\tH\aR\vnotEndsWith\x88\x01\x01\x12\x0e\n" +
+	"\x02in\x18\t \x03(\tR\x02in\x12\x15\n" +
+	"\x06not_in\x18\n" +
+	" \x03(\tR\x05notIn\x12\x17\n" +
+	"\ais_null\x18\v \x01(\bR\x06isNull\x12\x1e\n" +
+	"\vis_not_null\x18\f \x01(\bR\tisNotNull\x12)\n" +
+	"\x10case_insensitive\x18\r \x01(\bR\x0fcaseInsensitiveB\x05\n" +
+	"\x03_eqB\t\n" +
+	"\a_not_eqB\v\n" +
+	"\t_containsB\x0f\n" +
+	"\r_not_containsB\x0e\n" +
+	"\f_starts_withB\x12\n" +
+	"\x10_not_starts_withB\f\n" +
+	"\n" +
+	"_ends_withB\x10\n" +
+	"\x0e_not_ends_with\"R\n" +
+	"\rBooleanFilter\x12\x13\n" +
+	"\x02eq\x18\x01 \x01(\bH\x00R\x02eq\x88\x01\x01\x12\x1a\n" +
+	"\x06not_eq\x18\x02 \x01(\bH\x01R\x05notEq\x88\x01\x01B\x05\n" +
+	"\x03_eqB\t\n" +
+	"\a_not_eq\"\x87\x05\n" +
+	"\n" +
+	"DateFilter\x12*\n" +
+	"\x02eq\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x02eq\x121\n" +
+	"\x06not_eq\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05notEq\x12,\n" +
+	"\x03gte\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03gte\x123\n" +
+	"\anot_gte\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06notGte\x12*\n" +
+	"\x02gt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02gt\x121\n" +
+	"\x06not_gt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05notGt\x12,\n" +
+	"\x03lte\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x03lte\x123\n" +
+	"\anot_lte\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06notLte\x12*\n" +
+	"\x02lt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x02lt\x121\n" +
+	"\x06not_lt\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x05notLt\x12*\n" +
+	"\x02in\x18\v \x03(\v2\x1a.google.protobuf.TimestampR\x02in\x121\n" +
+	"\x06not_in\x18\f \x03(\v2\x1a.google.protobuf.TimestampR\x05notIn\x12\x17\n" +
+	"\ais_null\x18\r \x01(\bR\x06isNull\x12\x1e\n" +
+	"\vis_not_null\x18\x0e \x01(\bR\tisNotNull\"\xc9\x01\n" +
+	"\rTodoSortOrder\x122\n" +
+	"\n" +
+	"created_at\x18\x01 \x01(\x0e2\x13.todos.v1.SortOrderR\tcreatedAt\x122\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\x0e2\x13.todos.v1.SortOrderR\tupdatedAt\x12'\n" +
+	"\x04text\x18\x03 \x01(\x0e2\x13.todos.v1.SortOrderR\x04text\x12'\n" +
+	"\x04done\x18\x04 \x01(\x0e2\x13.todos.v1.SortOrderR\x04done\"\x9d\x02\n" +
+	"\n" +
+	"TodoFilter\x12&\n" +
+	"\x03and\x18\x01 \x03(\v2\x14.todos.v1.TodoFilterR\x03and\x12$\n" +
+	"\x02or\x18\x02 \x03(\v2\x14.todos.v1.TodoFilterR\x02or\x123\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x14.todos.v1.DateFilterR\tcreatedAt\x123\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x14.todos.v1.DateFilterR\tupdatedAt\x12*\n" +
+	"\x04text\x18\x05 \x01(\v2\x16.todos.v1.StringFilterR\x04text\x12+\n" +
+	"\x04done\x18\x06 \x01(\v2\x17.todos.v1.BooleanFilterR\x04done\"F\n" +
+	"\bTodoEdge\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\"\n" +
+	"\x04node\x18\x02 \x01(\v2\x0e.todos.v1.TodoR\x04node\"\x98\x01\n" +
+	"\x10ListTodosRequest\x12'\n" +
+	"\x04page\x18\x01 \x01(\v2\x13.todos.v1.PageInputR\x04page\x12-\n" +
+	"\x05sorts\x18\x02 \x03(\v2\x17.todos.v1.TodoSortOrderR\x05sorts\x12,\n" +
+	"\x06filter\x18\x03 \x01(\v2\x14.todos.v1.TodoFilterR\x06filter\"n\n" +
+	"\x11ListTodosResponse\x12(\n" +
+	"\x05edges\x18\x01 \x03(\v2\x12.todos.v1.TodoEdgeR\x05edges\x12/\n" +
+	"\tpage_info\x18\x02 \x01(\v2\x12.todos.v1.PageInfoR\bpageInfo\" \n" +
+	"\x0eGetTodoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x0fGetTodoResponse\x12\"\n" +
+	"\x04todo\x18\x01 \x01(\v2\x0e.todos.v1.TodoR\x04todo\"'\n" +
+	"\x11CreateTodoRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"8\n" +
+	"\x12CreateTodoResponse\x12\"\n" +
+	"\x04todo\x18\x01 \x01(\v2\x0e.todos.v1.TodoR\x04todo\"7\n" +
+	"\x11UpdateTodoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"8\n" +
+	"\x12UpdateTodoResponse\x12\"\n" +
+	"\x04todo\x18\x01 \x01(\v2\x0e.todos.v1.TodoR\x04todo\"\"\n" +
+	"\x10CloseTodoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x11CloseTodoResponse\x12\"\n" +
+	"\x04todo\x18\x01 \x01(\v2\x0e.todos.v1.TodoR\x04todo*P\n" +
+	"\tSortOrder\x12\x1a\n" +
+	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSORT_ORDER_ASC\x10\x01\x12\x13\n" +
+	"\x0fSORT_ORDER_DESC\x10\x022\xeb\x02\n" +
+	"\vTodoService\x12D\n" +
+	"\tListTodos\x12\x1a.todos.v1.ListTodosRequest\x1a\x1b.todos.v1.ListTodosResponse\x12>\n" +
+	"\aGetTodo\x12\x18.todos.v1.GetTodoRequest\x1a\x19.todos.v1.GetTodoResponse\x12G\n" +
+	"\n" +
+	"CreateTodo\x12\x1b.todos.v1.CreateTodoRequest\x1a\x1c.todos.v1.CreateTodoResponse\x12G\n" +
+	"\n" +
+	"UpdateTodo\x12\x1b.todos.v1.UpdateTodoRequest\x1a\x1c.todos.v1.UpdateTodoResponse\x12D\n" +
+	"\tCloseTodo\x12\x1a.todos.v1.CloseTodoRequest\x1a\x1b.todos.v1.CloseTodoResponseBpZngithub.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/grpc/generated/todos/v1;todosv1b\x06proto3"
+
+var (
+	file_todos_v1_todos_proto_rawDescOnce sync.Once
+	file_todos_v1_todos_proto_rawDescData []byte
+)
+
+func file_todos_v1_todos_proto_rawDescGZIP() []byte {
+	file_todos_v1_todos_proto_rawDescOnce.Do(func() {
+		file_todos_v1_todos_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_todos_v1_todos_proto_rawDesc), len(file_todos_v1_todos_proto_rawDesc)))
+	})
+	return file_todos_v1_todos_proto_rawDescData
+}
+
+var file_todos_v1_todos_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_todos_v1_todos_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_todos_v1_todos_proto_goTypes = []any{
+	(SortOrder)(0),                // 0: todos.v1.SortOrder
+	(*Todo)(nil),                  // 1: todos.v1.Todo
+	(*PageInput)(nil),             // 2: todos.v1.PageInput
+	(*PageInfo)(nil),              // 3: todos.v1.PageInfo
+	(*StringFilter)(nil),          // 4: todos.v1.StringFilter
+	(*BooleanFilter)(nil),         // 5: todos.v1.BooleanFilter
+	(*DateFilter)(nil),            // 6: todos.v1.DateFilter
+	(*TodoSortOrder)(nil),         // 7: todos.v1.TodoSortOrder
+	(*TodoFilter)(nil),            // 8: todos.v1.TodoFilter
+	(*TodoEdge)(nil),              // 9: todos.v1.TodoEdge
+	(*ListTodosRequest)(nil),      // 10: todos.v1.ListTodosRequest
+	(*ListTodosResponse)(nil),     // 11: todos.v1.ListTodosResponse
+	(*GetTodoRequest)(nil),        // 12: todos.v1.GetTodoRequest
+	(*GetTodoResponse)(nil),       // 13: todos.v1.GetTodoResponse
+	(*CreateTodoRequest)(nil),     // 14: todos.v1.CreateTodoRequest
+	(*CreateTodoResponse)(nil),    // 15: todos.v1.CreateTodoResponse
+	(*UpdateTodoRequest)(nil),     // 16: todos.v1.UpdateTodoRequest
+	(*UpdateTodoResponse)(nil),    // 17: todos.v1.UpdateTodoResponse
+	(*CloseTodoRequest)(nil),      // 18: todos.v1.CloseTodoRequest
+	(*CloseTodoResponse)(nil),     // 19: todos.v1.CloseTodoResponse
+	(*timestamppb.Timestamp)(nil), // 20: google.protobuf.Timestamp
+}
+var file_todos_v1_todos_proto_depIdxs = []int32{
+	20, // 0: todos.v1.Todo.created_at:type_name -> google.protobuf.Timestamp
+	20, // 1: todos.v1.Todo.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 2: todos.v1.DateFilter.eq:type_name -> google.protobuf.Timestamp
+	20, // 3: todos.v1.DateFilter.not_eq:type_name -> google.protobuf.Timestamp
+	20, // 4: todos.v1.DateFilter.gte:type_name -> google.protobuf.Timestamp
+	20, // 5: todos.v1.DateFilter.not_gte:type_name -> google.protobuf.Timestamp
+	20, // 6: todos.v1.DateFilter.gt:type_name -> google.protobuf.Timestamp
+	20, // 7: todos.v1.DateFilter.not_gt:type_name -> google.protobuf.Timestamp
+	20, // 8: todos.v1.DateFilter.lte:type_name -> google.protobuf.Timestamp
+	20, // 9: todos.v1.DateFilter.not_lte:type_name -> google.protobuf.Timestamp
+	20, // 10: todos.v1.DateFilter.lt:type_name -> google.protobuf.Timestamp
+	20, // 11: todos.v1.DateFilter.not_lt:type_name -> google.protobuf.Timestamp
+	20, // 12: todos.v1.DateFilter.in:type_name -> google.protobuf.Timestamp
+	20, // 13: todos.v1.DateFilter.not_in:type_name -> google.protobuf.Timestamp
+	0,  // 14: todos.v1.TodoSortOrder.created_at:type_name -> todos.v1.SortOrder
+	0,  // 15: todos.v1.TodoSortOrder.updated_at:type_name -> todos.v1.SortOrder
+	0,  // 16: todos.v1.TodoSortOrder.text:type_name -> todos.v1.SortOrder
+	0,  // 17: todos.v1.TodoSortOrder.done:type_name -> todos.v1.SortOrder
+	8,  // 18: todos.v1.TodoFilter.and:type_name -> todos.v1.TodoFilter
+	8,  // 19: todos.v1.TodoFilter.or:type_name -> todos.v1.TodoFilter
+	6,  // 20: todos.v1.TodoFilter.created_at:type_name -> todos.v1.DateFilter
+	6,  // 21: todos.v1.TodoFilter.updated_at:type_name -> todos.v1.DateFilter
+	4,  // 22: todos.v1.TodoFilter.text:type_name -> todos.v1.StringFilter
+	5,  // 23: todos.v1.TodoFilter.done:type_name -> todos.v1.BooleanFilter
+	1,  // 24: todos.v1.TodoEdge.node:type_name -> todos.v1.Todo
+	2,  // 25: todos.v1.ListTodosRequest.page:type_name -> todos.v1.PageInput
+	7,  // 26: todos.v1.ListTodosRequest.sorts:type_name -> todos.v1.TodoSortOrder
+	8,  // 27: todos.v1.ListTodosRequest.filter:type_name -> todos.v1.TodoFilter
+	9,  // 28: todos.v1.ListTodosResponse.edges:type_name -> todos.v1.TodoEdge
+	3,  // 29: todos.v1.ListTodosResponse.page_info:type_name -> todos.v1.PageInfo
+	1,  // 30: todos.v1.GetTodoResponse.todo:type_name -> todos.v1.Todo
+	1,  // 31: todos.v1.CreateTodoResponse.todo:type_name -> todos.v1.Todo
+	1,  // 32: todos.v1.UpdateTodoResponse.todo:type_name -> todos.v1.Todo
+	1,  // 33: todos.v1.CloseTodoResponse.todo:type_name -> todos.v1.Todo
+	10, // 34: todos.v1.TodoService.ListTodos:input_type -> todos.v1.ListTodosRequest
+	12, // 35: todos.v1.TodoService.GetTodo:input_type -> todos.v1.GetTodoRequest
+	14, // 36: todos.v1.TodoService.CreateTodo:input_type -> todos.v1.CreateTodoRequest
+	16, // 37: todos.v1.TodoService.UpdateTodo:input_type -> todos.v1.UpdateTodoRequest
+	18, // 38: todos.v1.TodoService.CloseTodo:input_type -> todos.v1.CloseTodoRequest
+	11, // 39: todos.v1.TodoService.ListTodos:output_type -> todos.v1.ListTodosResponse
+	13, // 40: todos.v1.TodoService.GetTodo:output_type -> todos.v1.GetTodoResponse
+	15, // 41: todos.v1.TodoService.CreateTodo:output_type -> todos.v1.CreateTodoResponse
+	17, // 42: todos.v1.TodoService.UpdateTodo:output_type -> todos.v1.UpdateTodoResponse
+	19, // 43: todos.v1.TodoService.CloseTodo:output_type -> todos.v1.CloseTodoResponse
+	39, // [39:44] is the sub-list for method output_type
+	34, // [34:39] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_todos_v1_todos_proto_init() }
+func file_todos_v1_todos_proto_init() {
+	if File_todos_v1_todos_proto != nil {
+		return
+	}
+	file_todos_v1_todos_proto_msgTypes[1].OneofWrappers = []any{}
+	file_todos_v1_todos_proto_msgTypes[2].OneofWrappers = []any{}
+	file_todos_v1_todos_proto_msgTypes[3].OneofWrappers = []any{}
+	file_todos_v1_todos_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_todos_v1_todos_proto_rawDesc), len(file_todos_v1_todos_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_todos_v1_todos_proto_goTypes,
+		DependencyIndexes: file_todos_v1_todos_proto_depIdxs,
+		EnumInfos:         file_todos_v1_todos_proto_enumTypes,
+		MessageInfos:      file_todos_v1_todos_proto_msgTypes,
+	}.Build()
+	File_todos_v1_todos_proto = out.File
+	file_todos_v1_todos_proto_goTypes = nil
+	file_todos_v1_todos_proto_depIdxs = nil
+}
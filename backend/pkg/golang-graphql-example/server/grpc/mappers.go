@@ -0,0 +1,265 @@
+package grpc
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/mappers"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/model"
+	gutils "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+	todosv1 "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/grpc/generated/todos/v1"
+)
+
+func toTodo(t *models.Todo) *todosv1.Todo {
+	return &todosv1.Todo{
+		Id:        gutils.ToIDRelay(mappers.TodoIDPrefix, t.ID),
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		UpdatedAt: timestamppb.New(t.UpdatedAt),
+		Text:      t.Text,
+		Done:      t.Done,
+	}
+}
+
+func toListTodosResponse(conn *model.TodoConnection) *todosv1.ListTodosResponse {
+	res := &todosv1.ListTodosResponse{
+		Edges: make([]*todosv1.TodoEdge, 0, len(conn.Edges)),
+		PageInfo: &todosv1.PageInfo{
+			StartCursor:     conn.PageInfo.StartCursor,
+			EndCursor:       conn.PageInfo.EndCursor,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+		},
+	}
+
+	for _, it := range conn.Edges {
+		res.Edges = append(res.Edges, &todosv1.TodoEdge{Cursor: it.Cursor, Node: toTodo(it.Node)})
+	}
+
+	return res
+}
+
+// fromTodoRelayID will decode a todo relay id.
+func fromTodoRelayID(relayID string) (string, error) {
+	return gutils.FromIDRelay(relayID, mappers.TodoIDPrefix)
+}
+
+// toPageInput will transform Relay pagination input.
+func toPageInput(in *todosv1.PageInput) (*pagination.PageInput, error) {
+	// Check nil to use default pagination
+	if in == nil {
+		in = &todosv1.PageInput{}
+	}
+
+	return gutils.GetPageInput(in.After, in.Before, toOptionalInt(in.First), toOptionalInt(in.Last))
+}
+
+func toTodoSorts(in []*todosv1.TodoSortOrder) ([]*models.SortOrder, error) {
+	res := make([]*models.SortOrder, 0, len(in))
+
+	for _, it := range in {
+		// Initialize sort
+		s := &models.SortOrder{}
+
+		// Map fields
+		var err error
+
+		s.CreatedAt, err = toSortOrderEnum("created_at", it.GetCreatedAt())
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		s.UpdatedAt, err = toSortOrderEnum("updated_at", it.GetUpdatedAt())
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		s.Text, err = toSortOrderEnum("text", it.GetText())
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		s.Done, err = toSortOrderEnum("done", it.GetDone())
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, s)
+	}
+
+	return res, nil
+}
+
+func toSortOrderEnum(field string, in todosv1.SortOrder) (*common.SortOrderEnum, error) {
+	var res common.SortOrderEnum
+
+	switch in {
+	case todosv1.SortOrder_SORT_ORDER_UNSPECIFIED:
+		return nil, nil //nolint:nilnil // Not set
+	case todosv1.SortOrder_SORT_ORDER_ASC:
+		res = common.SortOrderEnumAsc
+	case todosv1.SortOrder_SORT_ORDER_DESC:
+		res = common.SortOrderEnumDesc
+	default:
+		return nil, newInvalidFieldError("sorts."+field, fmt.Sprintf("invalid sort order %d", in))
+	}
+
+	return &res, nil
+}
+
+func toTodoFilter(f *todosv1.TodoFilter) *models.Filter {
+	// Check nil
+	if f == nil {
+		return nil
+	}
+
+	res := &models.Filter{
+		CreatedAt: toDateFilter(f.GetCreatedAt()),
+		UpdatedAt: toDateFilter(f.GetUpdatedAt()),
+		Text:      toStringFilter(f.GetText()),
+		Done:      toBooleanFilter(f.GetDone()),
+	}
+
+	for _, it := range f.GetAnd() {
+		res.AND = append(res.AND, toTodoFilter(it))
+	}
+
+	for _, it := range f.GetOr() {
+		res.OR = append(res.OR, toTodoFilter(it))
+	}
+
+	return res
+}
+
+func toStringFilter(f *todosv1.StringFilter) *common.GenericFilter {
+	// Check nil
+	if f == nil {
+		return nil
+	}
+
+	res := &common.GenericFilter{
+		IsNull:          f.GetIsNull(),
+		IsNotNull:       f.GetIsNotNull(),
+		CaseInsensitive: f.GetCaseInsensitive(),
+	}
+
+	// Only set values to keep nil interfaces for missing ones
+	setIfNotNil(&res.Eq, f.Eq)
+	setIfNotNil(&res.NotEq, f.NotEq)
+	setIfNotNil(&res.Contains, f.Contains)
+	setIfNotNil(&res.NotContains, f.NotContains)
+	setIfNotNil(&res.StartsWith, f.StartsWith)
+	setIfNotNil(&res.NotStartsWith, f.NotStartsWith)
+	setIfNotNil(&res.EndsWith, f.EndsWith)
+	setIfNotNil(&res.NotEndsWith, f.NotEndsWith)
+
+	if len(f.GetIn()) != 0 {
+		res.In = f.GetIn()
+	}
+
+	if len(f.GetNotIn()) != 0 {
+		res.NotIn = f.GetNotIn()
+	}
+
+	return res
+}
+
+func toBooleanFilter(f *todosv1.BooleanFilter) *common.GenericFilter {
+	// Check nil
+	if f == nil {
+		return nil
+	}
+
+	res := &common.GenericFilter{}
+
+	setIfNotNil(&res.Eq, f.Eq)
+	setIfNotNil(&res.NotEq, f.NotEq)
+
+	return res
+}
+
+func toDateFilter(f *todosv1.DateFilter) *common.DateFilter {
+	// Check nil
+	if f == nil {
+		return nil
+	}
+
+	res := &common.DateFilter{
+		IsNull:    f.GetIsNull(),
+		IsNotNull: f.GetIsNotNull(),
+	}
+
+	setIfNotNil(&res.Eq, toOptionalTime(f.GetEq()))
+	setIfNotNil(&res.NotEq, toOptionalTime(f.GetNotEq()))
+	setIfNotNil(&res.Gte, toOptionalTime(f.GetGte()))
+	setIfNotNil(&res.NotGte, toOptionalTime(f.GetNotGte()))
+	setIfNotNil(&res.Gt, toOptionalTime(f.GetGt()))
+	setIfNotNil(&res.NotGt, toOptionalTime(f.GetNotGt()))
+	setIfNotNil(&res.Lte, toOptionalTime(f.GetLte()))
+	setIfNotNil(&res.NotLte, toOptionalTime(f.GetNotLte()))
+	setIfNotNil(&res.Lt, toOptionalTime(f.GetLt()))
+	setIfNotNil(&res.NotLt, toOptionalTime(f.GetNotLt()))
+
+	if len(f.GetIn()) != 0 {
+		res.In = toTimes(f.GetIn())
+	}
+
+	if len(f.GetNotIn()) != 0 {
+		res.NotIn = toTimes(f.GetNotIn())
+	}
+
+	return res
+}
+
+func setIfNotNil[T any](out *any, v *T) {
+	if v != nil {
+		*out = v
+	}
+}
+
+func toOptionalInt(v *int32) *int {
+	// Check nil
+	if v == nil {
+		return nil
+	}
+
+	res := int(*v)
+
+	return &res
+}
+
+func toOptionalTime(v *timestamppb.Timestamp) *time.Time {
+	// Check nil
+	if v == nil {
+		return nil
+	}
+
+	res := v.AsTime()
+
+	return &res
+}
+
+func toTimes(in []*timestamppb.Timestamp) []time.Time {
+	res := make([]time.Time, 0, len(in))
+
+	for _, it := range in {
+		res = append(res, it.AsTime())
+	}
+
+	return res
+}
+
+func newInvalidFieldError(name, reason string) error {
+	msg := fmt.Sprintf("invalid %s field: %s", name, reason)
+
+	return errors.NewInvalidInputError(msg, errors.WithPublicErrorMessage(msg))
+}
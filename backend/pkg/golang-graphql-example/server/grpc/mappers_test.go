@@ -0,0 +1,97 @@
+//go:build unit
+
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+	todosv1 "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/grpc/generated/todos/v1"
+)
+
+func Test_toTodoFilter(t *testing.T) {
+	date := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		in   *todosv1.TodoFilter
+		want *models.Filter
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "filter",
+			in: &todosv1.TodoFilter{
+				Text: &todosv1.StringFilter{Contains: proto.String("fake"), CaseInsensitive: true, In: []string{"a"}},
+				Or: []*todosv1.TodoFilter{
+					{Done: &todosv1.BooleanFilter{Eq: proto.Bool(true)}},
+					{CreatedAt: &todosv1.DateFilter{Gte: timestamppb.New(date), NotIn: []*timestamppb.Timestamp{timestamppb.New(date)}}},
+				},
+			},
+			want: &models.Filter{
+				Text: &common.GenericFilter{Contains: proto.String("fake"), CaseInsensitive: true, In: []string{"a"}},
+				OR: []*models.Filter{
+					{Done: &common.GenericFilter{Eq: proto.Bool(true)}},
+					{CreatedAt: &common.DateFilter{Gte: &date, NotIn: []time.Time{date}}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, toTodoFilter(tt.in))
+		})
+	}
+}
+
+func Test_toTodoSorts(t *testing.T) {
+	asc, desc := common.SortOrderEnumAsc, common.SortOrderEnumDesc
+
+	tests := []struct {
+		name    string
+		in      []*todosv1.TodoSortOrder
+		want    []*models.SortOrder
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			want: []*models.SortOrder{},
+		},
+		{
+			name: "sorts",
+			in: []*todosv1.TodoSortOrder{
+				{CreatedAt: todosv1.SortOrder_SORT_ORDER_DESC},
+				{Text: todosv1.SortOrder_SORT_ORDER_ASC, Done: todosv1.SortOrder_SORT_ORDER_DESC},
+			},
+			want: []*models.SortOrder{
+				{CreatedAt: &desc},
+				{Text: &asc, Done: &desc},
+			},
+		},
+		{
+			name:    "invalid sort order",
+			in:      []*todosv1.TodoSortOrder{{UpdatedAt: todosv1.SortOrder(10)}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toTodoSorts(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
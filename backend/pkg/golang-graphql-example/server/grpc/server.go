@@ -0,0 +1,306 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"syscall"
+
+	"emperror.dev/errors"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/reflection"
+
+	gogrpc "google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authorization"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business"
+	correlationid "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/correlation-id"
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/metrics"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/ratelimit"
+	todosv1 "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/grpc/generated/todos/v1"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/signalhandler"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/tracing"
+)
+
+// Methods that don't need authentication and that aren't counted as active requests.
+var publicMethods = []string{
+	healthpb.Health_Check_FullMethodName,
+	healthpb.Health_List_FullMethodName,
+}
+
+type Server struct {
+	logger            log.Logger
+	cfgManager        config.Manager
+	metricsSvc        metrics.Service
+	tracingSvc        tracing.Service
+	busiServices      *business.Services
+	authenticationSvc authentication.Service
+	signalHandlerSvc  signalhandler.Service
+	server            *gogrpc.Server
+	healthSvr         *health.Server
+	rateLimitSvc      ratelimit.Service
+	rateLimiters      atomic.Pointer[rateLimitInterceptors]
+	addr              string
+}
+
+// rateLimitInterceptors are rebuilt on configuration change.
+type rateLimitInterceptors struct {
+	ip   gogrpc.UnaryServerInterceptor
+	user gogrpc.UnaryServerInterceptor
+}
+
+func NewServer(
+	logger log.Logger, cfgManager config.Manager, metricsSvc metrics.Service,
+	tracingSvc tracing.Service, busiServices *business.Services,
+	authenticationSvc authentication.Service, signalHandlerSvc signalhandler.Service,
+	rateLimitSvc ratelimit.Service,
+) *Server {
+	return &Server{
+		logger:            logger,
+		cfgManager:        cfgManager,
+		metricsSvc:        metricsSvc,
+		tracingSvc:        tracingSvc,
+		busiServices:      busiServices,
+		authenticationSvc: authenticationSvc,
+		signalHandlerSvc:  signalHandlerSvc,
+		rateLimitSvc:      rateLimitSvc,
+	}
+}
+
+// GenerateServer will create the gRPC server with all services.
+// Note: Listen address and authentication activation aren't reloaded with configuration.
+func (svr *Server) GenerateServer() error {
+	// Get configuration
+	cfg := svr.cfgManager.GetConfig()
+
+	// Load rate limits
+	err := svr.loadRateLimitInterceptors()
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Reload rate limits on configuration change
+	svr.cfgManager.AddOnChangeHook(&config.HookDefinition{
+		Hook: func() error {
+			// Load rate limits
+			err2 := svr.loadRateLimitInterceptors()
+			// Check error
+			if err2 != nil {
+				return err2
+			}
+
+			svr.logger.Info("gRPC rate limits reloaded")
+
+			return nil
+		},
+	})
+
+	// Create interceptors
+	interceptors := []gogrpc.UnaryServerInterceptor{
+		svr.recoveryInterceptor,
+		svr.signalHandlerSvc.ActiveRequestCounterGRPCInterceptor(publicMethods),
+		correlationid.GRPCUnaryInterceptor(svr.logger),
+		svr.tracingSvc.GRPCUnaryInterceptor(correlationid.GetFromContext),
+		log.GRPCUnaryInterceptor(svr.logger, correlationid.GetFromContext, tracing.GetTraceIDFromContext),
+		svr.metricsSvc.InstrumentGRPC("grpc"),
+		// Limit per peer ip before authentication
+		svr.ipRateLimitInterceptor,
+	}
+
+	// Create stream interceptors
+	// Those are used by health watch and reflection services
+	streamInterceptors := []gogrpc.StreamServerInterceptor{
+		log.GRPCStreamInterceptor(svr.logger, correlationid.GetFromContext, tracing.GetTraceIDFromContext),
+	}
+
+	// Add authentication interceptors if configuration exists
+	if cfg.OIDCAuthentication != nil {
+		interceptors = append(interceptors, svr.authenticationSvc.GRPCUnaryInterceptor(publicMethods))
+		streamInterceptors = append(streamInterceptors, svr.authenticationSvc.GRPCStreamInterceptor(publicMethods))
+	}
+
+	// Add rate limit per user
+	// This is done after authentication to limit per user
+	interceptors = append(interceptors, svr.rateLimitInterceptor)
+
+	// Add per request authorization decision cache
+	interceptors = append(interceptors, decisionCacheInterceptor)
+
+	// Create server
+	server := gogrpc.NewServer(
+		gogrpc.StatsHandler(svr.tracingSvc.GRPCServerHandler()),
+		gogrpc.ChainUnaryInterceptor(interceptors...),
+		gogrpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+
+	// Register services
+	todosv1.RegisterTodoServiceServer(server, &todoServer{todoSvc: svr.busiServices.TodoSvc})
+
+	// Register health service
+	healthSvr := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthSvr)
+	// Register reflection service if enabled
+	if cfg.GRPCServer.Reflection {
+		reflection.Register(server)
+	}
+
+	// Mark server as not serving when system is stopping
+	svr.signalHandlerSvc.OnSignal(syscall.SIGTERM, healthSvr.Shutdown)
+	svr.signalHandlerSvc.OnSignal(syscall.SIGINT, healthSvr.Shutdown)
+	// Stop server when all active requests are finished
+	// Stop is used instead of GracefulStop because health watch streams are never ending
+	svr.signalHandlerSvc.OnExit(server.Stop)
+
+	// Store
+	svr.server = server
+	svr.healthSvr = healthSvr
+	svr.addr = cfg.GRPCServer.ListenAddr + ":" + strconv.Itoa(cfg.GRPCServer.Port)
+
+	return nil
+}
+
+func (svr *Server) Listen() error {
+	// Listen on address
+	ln, err := net.Listen("tcp", svr.addr)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	svr.logger.Infof("gRPC server listening on %s", svr.addr)
+	err = svr.server.Serve(ln)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Default
+	return nil
+}
+
+// Recover any panic in calls.
+func (svr *Server) recoveryInterceptor(
+	ctx context.Context,
+	req any,
+	_ *gogrpc.UnaryServerInfo,
+	handler gogrpc.UnaryHandler,
+) (res any, err error) {
+	defer func() {
+		// Check if panic occurred
+		errI := recover()
+		if errI == nil {
+			return
+		}
+
+		// Cast err as error
+		err2, ok := errI.(error)
+		// Check if cast was ok to wrap it in basic error
+		// If not, stringify it
+		if ok {
+			err2 = cerrors.NewInternalServerErrorWithError(err2)
+		} else {
+			err2 = cerrors.NewInternalServerError(fmt.Sprintf("%+v", errI))
+		}
+
+		// Get logger
+		logger := log.GetLoggerFromContext(ctx)
+		if logger == nil {
+			logger = svr.logger
+		}
+		// Log
+		logger.Error(err2)
+
+		res, err = nil, utils.ToGRPCError(err2)
+	}()
+
+	return handler(ctx, req)
+}
+
+// loadRateLimitInterceptors will build rate limit interceptors from configuration.
+// Same rate limit configuration as HTTP server is used.
+func (svr *Server) loadRateLimitInterceptors() error {
+	// Get configuration
+	cfg := svr.cfgManager.GetConfig()
+
+	res := &rateLimitInterceptors{}
+
+	// Check if rate limit is configured
+	if cfg.Server != nil && cfg.Server.RateLimit != nil {
+		// Check if ip limit is configured
+		if cfg.Server.RateLimit.IP != nil {
+			ip, err := svr.rateLimitSvc.GRPCIPUnaryInterceptor(cfg.Server.RateLimit)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			res.ip = ip
+		}
+
+		user, err := svr.rateLimitSvc.GRPCUnaryInterceptor(cfg.Server.RateLimit)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		res.user = user
+	}
+
+	// Store
+	svr.rateLimiters.Store(res)
+
+	return nil
+}
+
+// Limit calls per peer ip with current configuration.
+func (svr *Server) ipRateLimitInterceptor(
+	ctx context.Context,
+	req any,
+	info *gogrpc.UnaryServerInfo,
+	handler gogrpc.UnaryHandler,
+) (any, error) {
+	// Get interceptors
+	rl := svr.rateLimiters.Load()
+	// Check if it is configured
+	if rl == nil || rl.ip == nil {
+		return handler(ctx, req)
+	}
+
+	return rl.ip(ctx, req, info, handler)
+}
+
+// Limit calls per user with current configuration.
+func (svr *Server) rateLimitInterceptor(
+	ctx context.Context,
+	req any,
+	info *gogrpc.UnaryServerInfo,
+	handler gogrpc.UnaryHandler,
+) (any, error) {
+	// Get interceptors
+	rl := svr.rateLimiters.Load()
+	// Check if it is configured
+	if rl == nil || rl.user == nil {
+		return handler(ctx, req)
+	}
+
+	return rl.user(ctx, req, info, handler)
+}
+
+// Add per request authorization decision cache.
+func decisionCacheInterceptor(
+	ctx context.Context,
+	req any,
+	_ *gogrpc.UnaryServerInfo,
+	handler gogrpc.UnaryHandler,
+) (any, error) {
+	return handler(authorization.SetRequestDecisionCacheToContext(ctx), req)
+}
@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/graphqlgenerated"
+	todosv1 "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/grpc/generated/todos/v1"
+)
+
+type todoServer struct {
+	todosv1.UnimplementedTodoServiceServer
+
+	todoSvc todos.Service
+}
+
+func (s *todoServer) ListTodos(ctx context.Context, req *todosv1.ListTodosRequest) (*todosv1.ListTodosResponse, error) {
+	// Get pagination
+	pageInput, err := toPageInput(req.GetPage())
+	// Check error
+	if err != nil {
+		return nil, answerWithError(ctx, err)
+	}
+
+	// Get sorts
+	sorts, err := toTodoSorts(req.GetSorts())
+	// Check error
+	if err != nil {
+		return nil, answerWithError(ctx, err)
+	}
+
+	// Call business
+	list, pageOut, err := s.todoSvc.GetAllPaginated(ctx, pageInput, sorts, toTodoFilter(req.GetFilter()), fullProjection())
+	// Check error
+	if err != nil {
+		return nil, answerWithError(ctx, err)
+	}
+
+	// Reuse GraphQL connection mapping to have the same cursors
+	conn, err := graphqlgenerated.MapTodoConnection(list, pageOut)
+	// Check error
+	if err != nil {
+		return nil, answerWithError(ctx, err)
+	}
+
+	return toListTodosResponse(conn), nil
+}
+
+func (s *todoServer) GetTodo(ctx context.Context, req *todosv1.GetTodoRequest) (*todosv1.GetTodoResponse, error) {
+	// Get id
+	id, err := fromTodoRelayID(req.GetId())
+	// Check error
+	if err != nil {
+		return nil, answerWithError(ctx, err)
+	}
+
+	// Call business
+	res, err := s.todoSvc.FindByID(ctx, id, fullProjection())
+	// Check error
+	if err != nil {
+		return nil, answerWithError(ctx, err)
+	}
+
+	// Check if todo exists
+	if res == nil {
+		return nil, answerWithError(ctx, errors.NewNotFoundError("todo not found"))
+	}
+
+	return &todosv1.GetTodoResponse{Todo: toTodo(res)}, nil
+}
+
+func (s *todoServer) CreateTodo(ctx context.Context, req *todosv1.CreateTodoRequest) (*todosv1.CreateTodoResponse, error) {
+	// Validate input
+	if req.GetText() == "" {
+		return nil, answerWithError(ctx, newInvalidFieldError("text", "is required"))
+	}
+
+	// Call business
+	res, err := s.todoSvc.Create(ctx, &todos.InputCreateTodo{Text: req.GetText()})
+	// Check error
+	if err != nil {
+		return nil, answerWithError(ctx, err)
+	}
+
+	return &todosv1.CreateTodoResponse{Todo: toTodo(res)}, nil
+}
+
+func (s *todoServer) UpdateTodo(ctx context.Context, req *todosv1.UpdateTodoRequest) (*todosv1.UpdateTodoResponse, error) {
+	// Get id
+	id, err := fromTodoRelayID(req.GetId())
+	// Check error
+	if err != nil {
+		return nil, answerWithError(ctx, err)
+	}
+
+	// Validate input
+	if req.GetText() == "" {
+		return nil, answerWithError(ctx, newInvalidFieldError("text", "is required"))
+	}
+
+	// Call business
+	res, err := s.todoSvc.Update(ctx, &todos.InputUpdateTodo{ID: id, Text: req.GetText()})
+	// Check error
+	if err != nil {
+		return nil, answerWithError(ctx, err)
+	}
+
+	return &todosv1.UpdateTodoResponse{Todo: toTodo(res)}, nil
+}
+
+func (s *todoServer) CloseTodo(ctx context.Context, req *todosv1.CloseTodoRequest) (*todosv1.CloseTodoResponse, error) {
+	// Get id
+	id, err := fromTodoRelayID(req.GetId())
+	// Check error
+	if err != nil {
+		return nil, answerWithError(ctx, err)
+	}
+
+	// Call business
+	res, err := s.todoSvc.Close(ctx, id, fullProjection())
+	// Check error
+	if err != nil {
+		return nil, answerWithError(ctx, err)
+	}
+
+	return &todosv1.CloseTodoResponse{Todo: toTodo(res)}, nil
+}
+
+// fullProjection will return projection with all fields as gRPC answers aren't partial.
+func fullProjection() *models.Projection {
+	return &models.Projection{ID: true, CreatedAt: true, UpdatedAt: true, Text: true, Done: true}
+}
+
+// answerWithError will log error and transform it into a gRPC status error.
+func answerWithError(ctx context.Context, err error) error {
+	// Get logger
+	logger := log.GetLoggerFromContext(ctx)
+	if logger != nil {
+		logger.Error(err)
+	}
+
+	return utils.ToGRPCError(err)
+}
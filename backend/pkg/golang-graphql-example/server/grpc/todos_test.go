@@ -0,0 +1,228 @@
+//go:build unit
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	gogrpc "google.golang.org/grpc"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/mappers"
+	gutils "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+	todosv1 "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/grpc/generated/todos/v1"
+)
+
+// newTestClient will start a gRPC server with todo service on an in memory listener.
+func newTestClient(t *testing.T, todoSvc todos.Service) todosv1.TodoServiceClient {
+	t.Helper()
+
+	ln := bufconn.Listen(1024 * 1024)
+
+	svr := &Server{logger: log.NewLogger()}
+	server := gogrpc.NewServer(gogrpc.ChainUnaryInterceptor(svr.recoveryInterceptor, decisionCacheInterceptor))
+	todosv1.RegisterTodoServiceServer(server, &todoServer{todoSvc: todoSvc})
+
+	go func() { _ = server.Serve(ln) }()
+
+	t.Cleanup(server.Stop)
+
+	conn, err := gogrpc.NewClient(
+		"passthrough:///bufnet",
+		gogrpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return ln.DialContext(ctx) }),
+		gogrpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return todosv1.NewTodoServiceClient(conn)
+}
+
+func TestTodoServer(t *testing.T) {
+	date := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	todo := &models.Todo{Base: database.Base{ID: "uuid", CreatedAt: date, UpdatedAt: date}, Text: "fake"}
+	todoID := gutils.ToIDRelay(mappers.TodoIDPrefix, "uuid")
+	todoProto := &todosv1.Todo{
+		Id:        todoID,
+		CreatedAt: timestamppb.New(date),
+		UpdatedAt: timestamppb.New(date),
+		Text:      "fake",
+	}
+	desc := common.SortOrderEnumDesc
+	cursor := "cGFnaW5hdGU6MQ=="
+
+	tests := []struct {
+		name         string
+		call         func(ctx context.Context, c todosv1.TodoServiceClient) (proto.Message, error)
+		mock         func(m *mocks.MockService)
+		expectedCode codes.Code
+		expectedRes  proto.Message
+	}{
+		{
+			name: "list",
+			call: func(ctx context.Context, c todosv1.TodoServiceClient) (proto.Message, error) {
+				return c.ListTodos(ctx, &todosv1.ListTodosRequest{
+					Page:  &todosv1.PageInput{First: proto.Int32(1)},
+					Sorts: []*todosv1.TodoSortOrder{{Text: todosv1.SortOrder_SORT_ORDER_DESC}},
+				})
+			},
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().
+					GetAllPaginated(
+						gomock.Any(),
+						&pagination.PageInput{Limit: 1},
+						[]*models.SortOrder{{Text: &desc}},
+						nil,
+						fullProjection(),
+					).
+					Return([]*models.Todo{todo}, &pagination.PageOutput{HasNext: true}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedRes: &todosv1.ListTodosResponse{
+				Edges: []*todosv1.TodoEdge{{Cursor: cursor, Node: todoProto}},
+				PageInfo: &todosv1.PageInfo{
+					StartCursor: &cursor,
+					EndCursor:   &cursor,
+					HasNextPage: true,
+				},
+			},
+		},
+		{
+			name: "list with invalid pagination",
+			call: func(ctx context.Context, c todosv1.TodoServiceClient) (proto.Message, error) {
+				return c.ListTodos(ctx, &todosv1.ListTodosRequest{Page: &todosv1.PageInput{Last: proto.Int32(1)}})
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "list forbidden",
+			call: func(ctx context.Context, c todosv1.TodoServiceClient) (proto.Message, error) {
+				return c.ListTodos(ctx, &todosv1.ListTodosRequest{})
+			},
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().GetAllPaginated(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.NewForbiddenError("forbidden"))
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "get",
+			call: func(ctx context.Context, c todosv1.TodoServiceClient) (proto.Message, error) {
+				return c.GetTodo(ctx, &todosv1.GetTodoRequest{Id: todoID})
+			},
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().FindByID(gomock.Any(), "uuid", fullProjection()).Return(todo, nil)
+			},
+			expectedCode: codes.OK,
+			expectedRes:  &todosv1.GetTodoResponse{Todo: todoProto},
+		},
+		{
+			name: "get not found",
+			call: func(ctx context.Context, c todosv1.TodoServiceClient) (proto.Message, error) {
+				return c.GetTodo(ctx, &todosv1.GetTodoRequest{Id: todoID})
+			},
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().FindByID(gomock.Any(), "uuid", fullProjection()).Return(nil, nil)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "get with invalid id",
+			call: func(ctx context.Context, c todosv1.TodoServiceClient) (proto.Message, error) {
+				return c.GetTodo(ctx, &todosv1.GetTodoRequest{Id: "uuid"})
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "create",
+			call: func(ctx context.Context, c todosv1.TodoServiceClient) (proto.Message, error) {
+				return c.CreateTodo(ctx, &todosv1.CreateTodoRequest{Text: "fake"})
+			},
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().Create(gomock.Any(), &todos.InputCreateTodo{Text: "fake"}).Return(todo, nil)
+			},
+			expectedCode: codes.OK,
+			expectedRes:  &todosv1.CreateTodoResponse{Todo: todoProto},
+		},
+		{
+			name: "create without text",
+			call: func(ctx context.Context, c todosv1.TodoServiceClient) (proto.Message, error) {
+				return c.CreateTodo(ctx, &todosv1.CreateTodoRequest{})
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "update",
+			call: func(ctx context.Context, c todosv1.TodoServiceClient) (proto.Message, error) {
+				return c.UpdateTodo(ctx, &todosv1.UpdateTodoRequest{Id: todoID, Text: "fake"})
+			},
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().Update(gomock.Any(), &todos.InputUpdateTodo{ID: "uuid", Text: "fake"}).Return(todo, nil)
+			},
+			expectedCode: codes.OK,
+			expectedRes:  &todosv1.UpdateTodoResponse{Todo: todoProto},
+		},
+		{
+			name: "close not found",
+			call: func(ctx context.Context, c todosv1.TodoServiceClient) (proto.Message, error) {
+				return c.CloseTodo(ctx, &todosv1.CloseTodoRequest{Id: todoID})
+			},
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().Close(gomock.Any(), "uuid", fullProjection()).Return(nil, errors.NewNotFoundError("todo not found"))
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "panic recovered",
+			call: func(ctx context.Context, c todosv1.TodoServiceClient) (proto.Message, error) {
+				return c.CloseTodo(ctx, &todosv1.CloseTodoRequest{Id: todoID})
+			},
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().Close(gomock.Any(), "uuid", fullProjection()).DoAndReturn(
+					func(context.Context, string, *models.Projection) (*models.Todo, error) { panic("fake") },
+				)
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svcMock := mocks.NewMockService(ctrl)
+			if tt.mock != nil {
+				tt.mock(svcMock)
+			}
+
+			res, err := tt.call(context.Background(), newTestClient(t, svcMock))
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+
+			if tt.expectedRes != nil {
+				assert.True(t, proto.Equal(tt.expectedRes, res), "expected %v, got %v", tt.expectedRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"net/http"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/email"
+)
+
+const (
+	emailPreviewFormatJSON = "json"
+	emailPreviewFormatHTML = "html"
+	emailPreviewFormatText = "text"
+	emailPreviewFormatRaw  = "raw"
+	emailPreviewRecipient  = "preview@example.com"
+)
+
+// Email templates list response object.
+type emailTemplatesResponse struct {
+	Templates []*email.TemplateDefinition `json:"templates"`
+}
+
+// Email preview response object.
+type emailPreviewResponse struct {
+	*email.RenderedTemplate
+	Raw string `json:"raw"`
+}
+
+// SetEmailService will set email service used for email templates preview.
+func (svr *InternalServer) SetEmailService(mailSvc email.Service) {
+	svr.mailSvc = mailSvc
+}
+
+func (svr *InternalServer) addEmailPreviewRoutes(router gin.IRouter) {
+	// Check if email service exists
+	if svr.mailSvc == nil {
+		return
+	}
+
+	grp := router.Group("/email-templates", svr.emailPreviewEnabledMiddleware)
+	grp.GET("", svr.listEmailTemplates)
+	grp.GET("/:name/preview", svr.previewEmailTemplate)
+	grp.POST("/:name/preview", svr.previewEmailTemplate)
+}
+
+// Preview is checked on each request to follow configuration reload.
+func (svr *InternalServer) emailPreviewEnabledMiddleware(c *gin.Context) {
+	// Get configuration
+	cfg := svr.cfgManager.GetConfig().EmailTemplates
+	// Check if preview is enabled
+	if cfg == nil || !cfg.PreviewEnabled {
+		utils.AnswerWithError(c, cerrors.NewNotFoundError("email preview not enabled"))
+
+		return
+	}
+
+	c.Next()
+}
+
+func (svr *InternalServer) listEmailTemplates(c *gin.Context) {
+	c.JSON(http.StatusOK, &emailTemplatesResponse{Templates: svr.mailSvc.ListTemplates()})
+}
+
+func (svr *InternalServer) previewEmailTemplate(c *gin.Context) {
+	// Get inputs
+	name := c.Param("name")
+	locale := c.Query("locale")
+	format := c.DefaultQuery("format", emailPreviewFormatJSON)
+
+	// Find template definition
+	var def *email.TemplateDefinition
+	// Loop over templates
+	for _, it := range svr.mailSvc.ListTemplates() {
+		if it.Name == name {
+			def = it
+
+			break
+		}
+	}
+	// Check if it has been found
+	if def == nil {
+		utils.AnswerWithError(c, cerrors.NewNotFoundError("email template "+name+" not found"))
+
+		return
+	}
+
+	// Default data are sample ones
+	data := def.SampleData
+	// Check if request contains data to override them
+	if c.Request.Method == http.MethodPost {
+		// Bind body
+		err := c.ShouldBindJSON(&data)
+		// Check error
+		if err != nil {
+			utils.AnswerWithError(c, cerrors.NewInvalidInputErrorWithError(err))
+
+			return
+		}
+	}
+
+	// Render template
+	rendered, err := svr.mailSvc.RenderTemplate(name, locale, data)
+	// Check error
+	if err != nil {
+		svr.answerEmailPreviewError(c, err)
+
+		return
+	}
+
+	switch format {
+	case emailPreviewFormatHTML:
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(rendered.HTMLBody))
+	case emailPreviewFormatText:
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(rendered.TextBody))
+	case emailPreviewFormatRaw, emailPreviewFormatJSON:
+		// Build email to get MIME message
+		em, err := svr.mailSvc.NewTemplatedEmail(name, locale, data)
+		// Check error
+		if err != nil {
+			svr.answerEmailPreviewError(c, err)
+
+			return
+		}
+		// Add fake recipient
+		em.AddTo(emailPreviewRecipient)
+
+		// Get email object
+		e := em.GetEmail()
+		// Check error
+		if e.Error != nil {
+			svr.answerEmailPreviewError(c, e.Error)
+
+			return
+		}
+
+		// Get raw message
+		raw := e.GetMessage()
+
+		// Check format
+		if format == emailPreviewFormatRaw {
+			c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(raw))
+
+			return
+		}
+
+		c.JSON(http.StatusOK, &emailPreviewResponse{RenderedTemplate: rendered, Raw: raw})
+	default:
+		utils.AnswerWithError(
+			c,
+			cerrors.NewInvalidInputError("format must be one of json, html, text or raw"),
+		)
+	}
+}
+
+func (svr *InternalServer) answerEmailPreviewError(c *gin.Context, err error) {
+	// Log error
+	svr.logger.WithError(err).Error(err)
+
+	// Try to cast as common error
+	var cerr cerrors.Error
+	// Check if it isn't a common error
+	if !errors.As(err, &cerr) {
+		// Rendering errors are mostly due to data, expose them to help designers
+		cerr = cerrors.NewInvalidInputErrorWithError(err)
+	}
+
+	// Answer
+	utils.AnswerWithError(c, cerr)
+}
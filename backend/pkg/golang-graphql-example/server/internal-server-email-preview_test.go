@@ -0,0 +1,146 @@
+//go:build unit
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/email"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+func TestInternalServer_emailPreview(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "welcome"), 0o755))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "welcome", "en.html.tmpl"),
+		[]byte(`{{ define "subject" }}Welcome {{ .name }}{{ end }}<p>Hello {{ .name }}</p>`),
+		0o600,
+	))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "welcome", "en.txt.tmpl"),
+		[]byte(`Hello {{ .name }}`),
+		0o600,
+	))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "welcome", "sample.json"),
+		[]byte(`{"name": "John"}`),
+		0o600,
+	))
+
+	tests := []struct {
+		name           string
+		previewEnabled bool
+		method         string
+		url            string
+		body           string
+		expectedCode   int
+		expectedBody   []string
+	}{
+		{
+			name:         "disabled",
+			method:       http.MethodGet,
+			url:          "/email-templates",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:           "list templates",
+			previewEnabled: true,
+			method:         http.MethodGet,
+			url:            "/email-templates",
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{`{"templates":[{"sampleData":{"name":"John"},"name":"welcome","locales":["en"]}]}`},
+		},
+		{
+			name:           "html preview with sample data",
+			previewEnabled: true,
+			method:         http.MethodGet,
+			url:            "/email-templates/welcome/preview?format=html",
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{"<p>Hello John</p>"},
+		},
+		{
+			name:           "text preview with custom data",
+			previewEnabled: true,
+			method:         http.MethodPost,
+			url:            "/email-templates/welcome/preview?format=text&locale=fr",
+			body:           `{"name": "Jane"}`,
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{"Hello Jane"},
+		},
+		{
+			name:           "raw preview",
+			previewEnabled: true,
+			method:         http.MethodGet,
+			url:            "/email-templates/welcome/preview?format=raw",
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{"Subject: Welcome John", "MIME-Version: 1.0", "Hello John"},
+		},
+		{
+			name:           "json preview",
+			previewEnabled: true,
+			method:         http.MethodGet,
+			url:            "/email-templates/welcome/preview",
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{`"subject":"Welcome John"`, `"raw":"`},
+		},
+		{
+			name:           "not found template",
+			previewEnabled: true,
+			method:         http.MethodGet,
+			url:            "/email-templates/fake/preview",
+			expectedCode:   http.StatusNotFound,
+		},
+		{
+			name:           "invalid format",
+			previewEnabled: true,
+			method:         http.MethodGet,
+			url:            "/email-templates/welcome/preview?format=pdf",
+			expectedCode:   http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cfgManagerMock := cmocks.NewMockManager(ctrl)
+			cfgManagerMock.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+				EmailTemplates: &config.EmailTemplatesConfig{
+					FolderPath:     dir,
+					DefaultLocale:  "en",
+					PreviewEnabled: tt.previewEnabled,
+				},
+			})
+
+			mailSvc := email.NewService(cfgManagerMock, log.NewLogger(), nil, nil, nil)
+			require.NoError(t, mailSvc.InitializeAndReload())
+
+			svr := &InternalServer{logger: log.NewLogger(), cfgManager: cfgManagerMock}
+			svr.SetEmailService(mailSvc)
+
+			router := gin.New()
+			svr.addEmailPreviewRoutes(router)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.url, strings.NewReader(tt.body))
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			for _, it := range tt.expectedBody {
+				assert.Contains(t, w.Body.String(), it)
+			}
+		})
+	}
+}
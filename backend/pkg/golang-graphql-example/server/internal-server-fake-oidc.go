@@ -0,0 +1,74 @@
+package server
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/fakeoidc"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+)
+
+// Fake provider is created once at router generation so tokens and keys stay valid
+// for the whole server lifetime. Configuration reload isn't followed.
+func (svr *InternalServer) addFakeOIDCProviderRoutes(router gin.IRouter) error {
+	// Get configuration
+	cfg := svr.cfgManager.GetConfig().FakeOIDCProvider
+	// Check if fake provider is enabled
+	if cfg == nil {
+		return nil
+	}
+
+	// Parse issuer url
+	issuerURL, err := url.Parse(cfg.IssuerURL)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// Check that issuer url is targeting mount path
+	if strings.TrimSuffix(issuerURL.Path, "/") != config.FakeOIDCProviderPath {
+		return errors.Errorf("fake oidc provider issuer url path must be %s", config.FakeOIDCProviderPath)
+	}
+
+	// Parse token duration
+	tokenDuration, err := time.ParseDuration(cfg.TokenDuration)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Create provider
+	provider, err := fakeoidc.NewProvider(&fakeoidc.Options{
+		IssuerURL:     cfg.IssuerURL,
+		TokenDuration: tokenDuration,
+		Users: lo.Map(cfg.Users, func(it *config.FakeOIDCProviderUserConfig, _ int) *fakeoidc.User {
+			return &fakeoidc.User{
+				Claims:   it.Claims,
+				Username: it.Username,
+				Password: it.Password,
+				Subject:  it.Subject,
+			}
+		}),
+		Clients: lo.Map(cfg.Clients, func(it *config.FakeOIDCProviderClientConfig, _ int) *fakeoidc.Client {
+			return &fakeoidc.Client{
+				ID:           it.ClientID,
+				Secret:       it.ClientSecret,
+				RedirectURLs: it.RedirectURLs,
+			}
+		}),
+	})
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	svr.logger.Warnf("Embedded fake OIDC provider enabled with issuer %s, it must never be used in production", provider.IssuerURL())
+
+	router.Any(config.FakeOIDCProviderPath+"/*any", gin.WrapH(provider.Handler()))
+
+	return nil
+}
@@ -0,0 +1,91 @@
+//go:build unit
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	cmocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+func TestInternalServer_fakeOIDCProvider(t *testing.T) {
+	tests := []struct {
+		name         string
+		cfg          *config.FakeOIDCProviderConfig
+		url          string
+		wantErr      bool
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "disabled",
+			url:          "/fake-oidc/.well-known/openid-configuration",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "wrong issuer path",
+			cfg: &config.FakeOIDCProviderConfig{
+				IssuerURL:     "http://localhost:9090/fake",
+				TokenDuration: "1h",
+			},
+			wantErr: true,
+		},
+		{
+			name: "discovery",
+			cfg: &config.FakeOIDCProviderConfig{
+				IssuerURL:     "http://localhost:9090/fake-oidc",
+				TokenDuration: "1h",
+				Users:         []*config.FakeOIDCProviderUserConfig{{Username: "user", Password: "password"}},
+			},
+			url:          "/fake-oidc/.well-known/openid-configuration",
+			expectedCode: http.StatusOK,
+			expectedBody: `"issuer":"http://localhost:9090/fake-oidc"`,
+		},
+		{
+			name: "login page",
+			cfg: &config.FakeOIDCProviderConfig{
+				IssuerURL:     "http://localhost:9090/fake-oidc",
+				TokenDuration: "1h",
+				Users:         []*config.FakeOIDCProviderUserConfig{{Username: "user", Password: "password"}},
+			},
+			url:          "/fake-oidc/authorize?response_type=code&client_id=client&redirect_uri=http%3A%2F%2Flocalhost%3A8080%2Fauth%2Foidc%2Fcallback",
+			expectedCode: http.StatusOK,
+			expectedBody: `<option value="user">`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cfgManagerMock := cmocks.NewMockManager(ctrl)
+			cfgManagerMock.EXPECT().GetConfig().AnyTimes().Return(&config.Config{FakeOIDCProvider: tt.cfg})
+
+			svr := &InternalServer{logger: log.NewLogger(), cfgManager: cfgManagerMock}
+
+			router := gin.New()
+			err := svr.addFakeOIDCProviderRoutes(router)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"net"
 	"net/http"
 	"strconv"
 	"time"
@@ -14,6 +15,7 @@ import (
 
 	correlationid "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/correlation-id"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/email"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/metrics"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/signalhandler"
@@ -29,7 +31,9 @@ type InternalServer struct {
 	cfgManager       config.Manager
 	metricsSvc       metrics.Service
 	signalHandlerSvc signalhandler.Service
+	mailSvc          email.Service
 	server           *http.Server
+	listener         net.Listener
 	checkers         []*CheckerInput
 }
 
@@ -75,6 +79,13 @@ func (svr *InternalServer) generateInternalRouter() (http.Handler, error) {
 	gin.SetMode(gin.ReleaseMode)
 	// Create router
 	router := gin.New()
+	// Set trusted proxies used to compute client ip from forwarded headers
+	// No proxy is trusted when list is empty
+	err := router.SetTrustedProxies(cfg.InternalServer.TrustedProxies)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 	// Add middlewares
 	router.Use(gin.Recovery())
 	router.Use(helmet.Default())
@@ -82,11 +93,13 @@ func (svr *InternalServer) generateInternalRouter() (http.Handler, error) {
 	router.Use(log.Middleware(svr.logger, correlationid.GetFromGin, tracing.GetTraceIDFromContext))
 	router.Use(svr.metricsSvc.Instrument("internal", true))
 	// Add cors if configured
-	err := manageCORS(router, cfg.InternalServer)
+	err = manageCORS(router, cfg.InternalServer)
 	// Check error
 	if err != nil {
 		return nil, err
 	}
+	// Add csrf protection if configured
+	manageCSRF(router, cfg.InternalServer)
 
 	// create a new health instance
 	h2 := gosundheit.New()
@@ -153,13 +166,47 @@ func (svr *InternalServer) generateInternalRouter() (http.Handler, error) {
 		// Answer
 		c.JSON(http.StatusOK, ans)
 	})
+	// Add email templates preview routes
+	svr.addEmailPreviewRoutes(router)
+	// Add fake oidc provider routes
+	err = svr.addFakeOIDCProviderRoutes(router)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
 
 	return router, nil
 }
 
+// Bind will open the internal server listener without serving requests.
+// Requests are queued until Listen is called. This allows to start the internal server
+// before other servers that depend on it (like the fake oidc provider).
+func (svr *InternalServer) Bind() error {
+	// Listen on address
+	ln, err := net.Listen("tcp", svr.server.Addr)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Store listener
+	svr.listener = ln
+
+	return nil
+}
+
 func (svr *InternalServer) Listen() error {
+	// Check if listener isn't already bound
+	if svr.listener == nil {
+		err := svr.Bind()
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
 	svr.logger.Infof("Internal server listening on %s", svr.server.Addr)
-	err := svr.server.ListenAndServe()
+	err := svr.server.Serve(svr.listener)
 	// Check error
 	if err != nil {
 		return errors.WithStack(err)
@@ -0,0 +1,104 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+const (
+	// BasePath is the REST API v1 base path.
+	BasePath = "/api/v1"
+	// DocumentPath is the OpenAPI document path.
+	DocumentPath = BasePath + "/openapi.json"
+)
+
+// route is a REST API route.
+// Routes are used to register handlers and to generate the OpenAPI document.
+type route struct {
+	method      string
+	path        string
+	operationID string
+	summary     string
+	tag         string
+	parameters  []*parameter
+	// Request body sample used to generate schema
+	requestBody any
+	// Response sample used to generate schema
+	response       any
+	responseStatus int
+	handler        gin.HandlerFunc
+}
+
+// parameter is a route parameter.
+type parameter struct {
+	name        string
+	in          string
+	description string
+	required    bool
+	// Sample used to generate schema
+	schema any
+	// Parameter value is a JSON document
+	json bool
+}
+
+// API is the REST API v1.
+type API struct {
+	routes []*route
+}
+
+// New will create the REST API.
+func New(todoSvc todos.Service) *API {
+	th := &todosHandlers{todoSvc: todoSvc}
+
+	return &API{routes: th.routes()}
+}
+
+// AddRoutes will add REST API routes in router.
+func (a *API) AddRoutes(router gin.IRouter) {
+	grp := router.Group(BasePath)
+
+	for _, r := range a.routes {
+		grp.Handle(r.method, r.path, r.handler)
+	}
+}
+
+// AddDocumentRoute will add the OpenAPI document route in router.
+// This must be added before authentication to allow consumers to discover the API.
+func (a *API) AddDocumentRoute(router gin.IRouter) {
+	// Generate document once
+	doc := a.Document()
+
+	router.GET(DocumentPath, func(c *gin.Context) {
+		c.JSON(http.StatusOK, doc)
+	})
+}
+
+// answerWithError will log error and answer it.
+func answerWithError(c *gin.Context, err error) {
+	// Get logger
+	logger := log.GetLoggerFromGin(c)
+	if logger != nil {
+		logger.Error(err)
+	}
+
+	utils.AnswerWithError(c, err)
+}
+
+// openAPIPath will transform gin path parameters to OpenAPI ones.
+func openAPIPath(p string) string {
+	sp := strings.Split(p, "/")
+
+	for i, it := range sp {
+		if strings.HasPrefix(it, ":") {
+			sp[i] = "{" + strings.TrimPrefix(it, ":") + "}"
+		}
+	}
+
+	return strings.Join(sp, "/")
+}
@@ -0,0 +1,3 @@
+package rest
+
+// This package will manage the versioned REST API and its generated OpenAPI document.
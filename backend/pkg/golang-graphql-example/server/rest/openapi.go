@@ -0,0 +1,297 @@
+package rest
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/authx/authentication"
+)
+
+const (
+	openAPIVersion  = "3.0.3"
+	documentTitle   = "Todos REST API"
+	documentVersion = "v1"
+
+	jsonContentType       = "application/json"
+	errorSchemaName       = "Error"
+	componentsSchemasPath = "#/components/schemas/"
+
+	bearerSecuritySchemeName = "bearerAuth"
+	apiKeySecuritySchemeName = "apiKeyAuth"
+)
+
+// Document is an OpenAPI 3 document.
+type Document struct {
+	OpenAPI    string                `json:"openapi"`
+	Info       *Info                 `json:"info"`
+	Servers    []*Server             `json:"servers"`
+	Paths      map[string]PathItem   `json:"paths"`
+	Components *Components           `json:"components"`
+	Security   []map[string][]string `json:"security"`
+	Tags       []*Tag                `json:"tags,omitempty"`
+}
+
+// Info is the OpenAPI document information.
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Server is an OpenAPI server.
+type Server struct {
+	URL string `json:"url"`
+}
+
+// Tag is an OpenAPI tag.
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// PathItem contains operations indexed by lowercase HTTP method.
+type PathItem map[string]*Operation
+
+// Operation is an OpenAPI operation.
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter is an OpenAPI parameter.
+type Parameter struct {
+	Name        string                `json:"name"`
+	In          string                `json:"in"`
+	Description string                `json:"description,omitempty"`
+	Required    bool                  `json:"required,omitempty"`
+	Schema      *Schema               `json:"schema,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// RequestBody is an OpenAPI request body.
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response is an OpenAPI response.
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType is an OpenAPI media type.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components contains OpenAPI reusable objects.
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes"`
+}
+
+// SecurityScheme is an OpenAPI security scheme.
+type SecurityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme,omitempty"`
+	In     string `json:"in,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+// Schema is an OpenAPI schema.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// Document will generate the OpenAPI document from routes.
+func (a *API) Document() *Document {
+	g := &schemaGenerator{schemas: map[string]*Schema{}}
+
+	doc := &Document{
+		OpenAPI: openAPIVersion,
+		Info:    &Info{Title: documentTitle, Version: documentVersion},
+		Servers: []*Server{{URL: BasePath}},
+		Paths:   map[string]PathItem{},
+		Components: &Components{
+			Schemas: g.schemas,
+			SecuritySchemes: map[string]*SecurityScheme{
+				bearerSecuritySchemeName: {Type: "http", Scheme: "bearer"},
+				apiKeySecuritySchemeName: {Type: "apiKey", In: "header", Name: authentication.APITokenHeaderName},
+			},
+		},
+		Security: []map[string][]string{
+			{bearerSecuritySchemeName: {}},
+			{apiKeySecuritySchemeName: {}},
+		},
+	}
+
+	// Add error schema
+	g.schemas[errorSchemaName] = &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"error":      {Type: "string"},
+			"extensions": {Type: "object", AdditionalProperties: &Schema{}},
+		},
+		Required: []string{"error"},
+	}
+
+	tags := map[string]bool{}
+
+	for _, r := range a.routes {
+		// Get path item
+		p := openAPIPath(r.path)
+		if doc.Paths[p] == nil {
+			doc.Paths[p] = PathItem{}
+		}
+
+		doc.Paths[p][strings.ToLower(r.method)] = g.operation(r)
+
+		// Save tag
+		if r.tag != "" && !tags[r.tag] {
+			tags[r.tag] = true
+
+			doc.Tags = append(doc.Tags, &Tag{Name: r.tag})
+		}
+	}
+
+	return doc
+}
+
+type schemaGenerator struct {
+	schemas map[string]*Schema
+}
+
+func (g *schemaGenerator) operation(r *route) *Operation {
+	op := &Operation{
+		OperationID: r.operationID,
+		Summary:     r.summary,
+		Responses: map[string]*Response{
+			strconv.Itoa(r.responseStatus): {
+				Description: http.StatusText(r.responseStatus),
+				Content:     jsonContent(g.schema(reflect.TypeOf(r.response))),
+			},
+			"default": {
+				Description: "Error",
+				Content:     jsonContent(&Schema{Ref: componentsSchemasPath + errorSchemaName}),
+			},
+		},
+	}
+
+	// Check tag
+	if r.tag != "" {
+		op.Tags = []string{r.tag}
+	}
+
+	for _, it := range r.parameters {
+		p := &Parameter{
+			Name:        it.name,
+			In:          it.in,
+			Description: it.description,
+			Required:    it.required,
+		}
+
+		// Check if parameter is a JSON document
+		if it.json {
+			p.Content = jsonContent(g.schema(reflect.TypeOf(it.schema)))
+		} else {
+			p.Schema = g.schema(reflect.TypeOf(it.schema))
+		}
+
+		op.Parameters = append(op.Parameters, p)
+	}
+
+	// Check if request body exists
+	if r.requestBody != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  jsonContent(g.schema(reflect.TypeOf(r.requestBody))),
+		}
+	}
+
+	return op
+}
+
+// schema will generate schema from type, structures are saved as components.
+func (g *schemaGenerator) schema(t reflect.Type) *Schema {
+	switch t.Kind() { //nolint:exhaustive // Only used kinds are managed
+	case reflect.Pointer:
+		return g.schema(t.Elem())
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}
+	case reflect.Slice:
+		return &Schema{Type: "array", Items: g.schema(t.Elem())}
+	case reflect.Struct:
+		name := schemaName(t)
+		// Check if schema is already generated (or in generation for recursive types)
+		if _, ok := g.schemas[name]; !ok {
+			s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+			g.schemas[name] = s
+
+			g.fillProperties(s, t)
+		}
+
+		return &Schema{Ref: componentsSchemasPath + name}
+	default:
+		return &Schema{}
+	}
+}
+
+func (g *schemaGenerator) fillProperties(s *Schema, t reflect.Type) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		// Get json name
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		// Ignore not exported fields
+		if name == "-" || !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		fs := g.schema(f.Type)
+		// Add details on non reference schemas
+		if fs.Ref == "" {
+			fs.Format = f.Tag.Get("format")
+			fs.Description = f.Tag.Get("description")
+			fs.Nullable = f.Type.Kind() == reflect.Pointer && !strings.Contains(opts, "omitempty")
+		}
+
+		s.Properties[name] = fs
+
+		// Check if field is required
+		if strings.Contains(f.Tag.Get("binding"), "required") ||
+			(f.Type.Kind() != reflect.Pointer && !strings.Contains(opts, "omitempty")) {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// schemaName will compute schema name from type name (todoResponse => TodoResponse).
+func schemaName(t reflect.Type) string {
+	n := t.Name()
+
+	return strings.ToUpper(n[:1]) + n[1:]
+}
+
+func jsonContent(s *Schema) map[string]*MediaType {
+	return map[string]*MediaType{jsonContentType: {Schema: s}}
+}
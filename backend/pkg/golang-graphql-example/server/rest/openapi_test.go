@@ -0,0 +1,83 @@
+//go:build unit
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_Document(t *testing.T) {
+	doc := New(nil).Document()
+
+	assert.Equal(t, "3.0.3", doc.OpenAPI)
+	assert.Equal(t, []*Server{{URL: "/api/v1"}}, doc.Servers)
+
+	// Paths
+	assert.Len(t, doc.Paths, 3)
+	assert.ElementsMatch(t, []string{"get", "post"}, keys(doc.Paths["/todos"]))
+	assert.ElementsMatch(t, []string{"get", "put"}, keys(doc.Paths["/todos/{id}"]))
+	assert.ElementsMatch(t, []string{"post"}, keys(doc.Paths["/todos/{id}/close"]))
+
+	// Operations
+	create := doc.Paths["/todos"]["post"]
+	assert.Equal(t, "createTodo", create.OperationID)
+	assert.Equal(t, "#/components/schemas/CreateTodoRequest", create.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/TodoResponse", create.Responses["201"].Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/Error", create.Responses["default"].Content["application/json"].Schema.Ref)
+
+	list := doc.Paths["/todos"]["get"]
+	assert.Equal(t, "filter", list.Parameters[5].Name)
+	assert.Equal(t, "#/components/schemas/TodoFilter", list.Parameters[5].Content["application/json"].Schema.Ref)
+	assert.Equal(t, &Schema{Type: "integer"}, list.Parameters[0].Schema)
+
+	// Schemas
+	assert.Equal(t, &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"id":        {Type: "string", Description: "Relay ID (same as GraphQL)"},
+			"createdAt": {Type: "string", Format: "date-time"},
+			"updatedAt": {Type: "string", Format: "date-time"},
+			"text":      {Type: "string"},
+			"done":      {Type: "boolean"},
+		},
+		Required: []string{"id", "createdAt", "updatedAt", "text", "done"},
+	}, doc.Components.Schemas["TodoResponse"])
+	assert.Equal(t, []string{"text"}, doc.Components.Schemas["CreateTodoRequest"].Required)
+	assert.Equal(t,
+		&Schema{Type: "array", Items: &Schema{Ref: "#/components/schemas/TodoFilter"}},
+		doc.Components.Schemas["TodoFilter"].Properties["AND"],
+	)
+	assert.Equal(t, &Schema{Type: "string", Nullable: true}, doc.Components.Schemas["PageInfo"].Properties["startCursor"])
+}
+
+func TestAPI_AddDocumentRoute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	New(nil).AddDocumentRoute(router)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var res map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	assert.Equal(t, "3.0.3", res["openapi"])
+}
+
+func keys(p PathItem) []string {
+	res := []string{}
+	for k := range p {
+		res = append(res, k)
+	}
+
+	return res
+}
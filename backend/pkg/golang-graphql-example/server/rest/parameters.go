@@ -0,0 +1,323 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
+	gutils "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+)
+
+const (
+	firstParameterName  = "first"
+	lastParameterName   = "last"
+	afterParameterName  = "after"
+	beforeParameterName = "before"
+	sortParameterName   = "sort"
+	filterParameterName = "filter"
+
+	sortSeparator          = ","
+	sortDirectionSeparator = ":"
+)
+
+// stringFilter is the REST version of the StringFilter GraphQL input.
+type stringFilter struct {
+	Eq              *string  `json:"eq,omitempty"`
+	NotEq           *string  `json:"notEq,omitempty"`
+	Contains        *string  `json:"contains,omitempty"`
+	NotContains     *string  `json:"notContains,omitempty"`
+	StartsWith      *string  `json:"startsWith,omitempty"`
+	NotStartsWith   *string  `json:"notStartsWith,omitempty"`
+	EndsWith        *string  `json:"endsWith,omitempty"`
+	NotEndsWith     *string  `json:"notEndsWith,omitempty"`
+	In              []string `json:"in,omitempty"`
+	NotIn           []string `json:"notIn,omitempty"`
+	IsNull          bool     `json:"isNull,omitempty"`
+	IsNotNull       bool     `json:"isNotNull,omitempty"`
+	CaseInsensitive bool     `json:"caseInsensitive,omitempty"`
+}
+
+// booleanFilter is the REST version of the BooleanFilter GraphQL input.
+type booleanFilter struct {
+	Eq    *bool `json:"eq,omitempty"`
+	NotEq *bool `json:"notEq,omitempty"`
+}
+
+// dateFilter is the REST version of the DateFilter GraphQL input.
+type dateFilter struct {
+	Eq        *string  `json:"eq,omitempty"     format:"date-time"`
+	NotEq     *string  `json:"notEq,omitempty"  format:"date-time"`
+	Gte       *string  `json:"gte,omitempty"    format:"date-time"`
+	NotGte    *string  `json:"notGte,omitempty" format:"date-time"`
+	Gt        *string  `json:"gt,omitempty"     format:"date-time"`
+	NotGt     *string  `json:"notGt,omitempty"  format:"date-time"`
+	Lte       *string  `json:"lte,omitempty"    format:"date-time"`
+	NotLte    *string  `json:"notLte,omitempty" format:"date-time"`
+	Lt        *string  `json:"lt,omitempty"     format:"date-time"`
+	NotLt     *string  `json:"notLt,omitempty"  format:"date-time"`
+	In        []string `json:"in,omitempty"`
+	NotIn     []string `json:"notIn,omitempty"`
+	IsNull    bool     `json:"isNull,omitempty"`
+	IsNotNull bool     `json:"isNotNull,omitempty"`
+}
+
+// todoFilter is the REST version of the TodoFilter GraphQL input.
+type todoFilter struct {
+	AND       []*todoFilter  `json:"AND,omitempty"`
+	OR        []*todoFilter  `json:"OR,omitempty"`
+	CreatedAt *dateFilter    `json:"createdAt,omitempty"`
+	UpdatedAt *dateFilter    `json:"updatedAt,omitempty"`
+	Text      *stringFilter  `json:"text,omitempty"`
+	Done      *booleanFilter `json:"done,omitempty"`
+}
+
+// getPageInput will parse Relay pagination query parameters.
+func getPageInput(c *gin.Context) (*pagination.PageInput, error) {
+	// Get integers
+	first, err := getOptionalIntQuery(c, firstParameterName)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	last, err := getOptionalIntQuery(c, lastParameterName)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return gutils.GetPageInput(
+		getOptionalStringQuery(c, afterParameterName),
+		getOptionalStringQuery(c, beforeParameterName),
+		first,
+		last,
+	)
+}
+
+// getTodoSorts will parse sort query parameters.
+// Format is "field:direction" with direction ASC (default) or DESC, separated by commas or repeated.
+func getTodoSorts(c *gin.Context) ([]*models.SortOrder, error) {
+	res := make([]*models.SortOrder, 0)
+
+	for _, v := range c.QueryArray(sortParameterName) {
+		for it := range strings.SplitSeq(v, sortSeparator) {
+			// Ignore empty values
+			if strings.TrimSpace(it) == "" {
+				continue
+			}
+
+			// Parse item
+			s, err := parseTodoSort(strings.TrimSpace(it))
+			// Check error
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, s)
+		}
+	}
+
+	return res, nil
+}
+
+func parseTodoSort(v string) (*models.SortOrder, error) {
+	// Split field and direction
+	field, dir, found := strings.Cut(v, sortDirectionSeparator)
+	// Initialize direction
+	direction := common.SortOrderEnumAsc
+	// Check if direction is set
+	if found {
+		direction = common.SortOrderEnum(strings.ToUpper(dir))
+		// Check if direction is valid
+		if !direction.IsValid() {
+			return nil, newInvalidParameterError(sortParameterName, fmt.Sprintf("invalid sort direction %s", dir))
+		}
+	}
+
+	res := &models.SortOrder{}
+
+	switch field {
+	case "createdAt":
+		res.CreatedAt = &direction
+	case "updatedAt":
+		res.UpdatedAt = &direction
+	case "text":
+		res.Text = &direction
+	case "done":
+		res.Done = &direction
+	default:
+		return nil, newInvalidParameterError(sortParameterName, fmt.Sprintf("invalid sort field %s", field))
+	}
+
+	return res, nil
+}
+
+// getTodoFilter will parse the JSON filter query parameter.
+func getTodoFilter(c *gin.Context) (*models.Filter, error) {
+	// Get value
+	v := c.Query(filterParameterName)
+	// Check if filter is set
+	if v == "" {
+		return nil, nil //nolint:nilnil // No filter
+	}
+
+	// Decode
+	var f todoFilter
+
+	dec := json.NewDecoder(bytes.NewBufferString(v))
+	dec.DisallowUnknownFields()
+
+	err := dec.Decode(&f)
+	// Check error
+	if err != nil {
+		return nil, newInvalidParameterError(filterParameterName, err.Error())
+	}
+
+	return f.toModel(), nil
+}
+
+func (f *todoFilter) toModel() *models.Filter {
+	res := &models.Filter{
+		CreatedAt: f.CreatedAt.toModel(),
+		UpdatedAt: f.UpdatedAt.toModel(),
+		Text:      f.Text.toModel(),
+		Done:      f.Done.toModel(),
+	}
+
+	for _, it := range f.AND {
+		res.AND = append(res.AND, it.toModel())
+	}
+
+	for _, it := range f.OR {
+		res.OR = append(res.OR, it.toModel())
+	}
+
+	return res
+}
+
+func (f *stringFilter) toModel() *common.GenericFilter {
+	// Check nil
+	if f == nil {
+		return nil
+	}
+
+	res := &common.GenericFilter{
+		IsNull:          f.IsNull,
+		IsNotNull:       f.IsNotNull,
+		CaseInsensitive: f.CaseInsensitive,
+	}
+
+	// Only set values to keep nil interfaces for missing ones
+	setIfNotNil(&res.Eq, f.Eq)
+	setIfNotNil(&res.NotEq, f.NotEq)
+	setIfNotNil(&res.Contains, f.Contains)
+	setIfNotNil(&res.NotContains, f.NotContains)
+	setIfNotNil(&res.StartsWith, f.StartsWith)
+	setIfNotNil(&res.NotStartsWith, f.NotStartsWith)
+	setIfNotNil(&res.EndsWith, f.EndsWith)
+	setIfNotNil(&res.NotEndsWith, f.NotEndsWith)
+
+	if f.In != nil {
+		res.In = f.In
+	}
+
+	if f.NotIn != nil {
+		res.NotIn = f.NotIn
+	}
+
+	return res
+}
+
+func (f *booleanFilter) toModel() *common.GenericFilter {
+	// Check nil
+	if f == nil {
+		return nil
+	}
+
+	res := &common.GenericFilter{}
+
+	setIfNotNil(&res.Eq, f.Eq)
+	setIfNotNil(&res.NotEq, f.NotEq)
+
+	return res
+}
+
+func (f *dateFilter) toModel() *common.DateFilter {
+	// Check nil
+	if f == nil {
+		return nil
+	}
+
+	res := &common.DateFilter{
+		IsNull:    f.IsNull,
+		IsNotNull: f.IsNotNull,
+	}
+
+	setIfNotNil(&res.Eq, f.Eq)
+	setIfNotNil(&res.NotEq, f.NotEq)
+	setIfNotNil(&res.Gte, f.Gte)
+	setIfNotNil(&res.NotGte, f.NotGte)
+	setIfNotNil(&res.Gt, f.Gt)
+	setIfNotNil(&res.NotGt, f.NotGt)
+	setIfNotNil(&res.Lte, f.Lte)
+	setIfNotNil(&res.NotLte, f.NotLte)
+	setIfNotNil(&res.Lt, f.Lt)
+	setIfNotNil(&res.NotLt, f.NotLt)
+
+	if f.In != nil {
+		res.In = f.In
+	}
+
+	if f.NotIn != nil {
+		res.NotIn = f.NotIn
+	}
+
+	return res
+}
+
+func setIfNotNil[T any](out *any, v *T) {
+	if v != nil {
+		*out = v
+	}
+}
+
+func getOptionalStringQuery(c *gin.Context, name string) *string {
+	// Check if query parameter exists
+	v, ok := c.GetQuery(name)
+	if !ok {
+		return nil
+	}
+
+	return &v
+}
+
+func getOptionalIntQuery(c *gin.Context, name string) (*int, error) {
+	// Check if query parameter exists
+	v, ok := c.GetQuery(name)
+	if !ok {
+		return nil, nil //nolint:nilnil // Not set
+	}
+
+	// Parse
+	i, err := strconv.Atoi(v)
+	// Check error
+	if err != nil {
+		return nil, newInvalidParameterError(name, "must be an integer")
+	}
+
+	return &i, nil
+}
+
+func newInvalidParameterError(name, reason string) error {
+	msg := fmt.Sprintf("invalid %s parameter: %s", name, reason)
+
+	return errors.NewInvalidInputError(msg, errors.WithPublicErrorMessage(msg))
+}
@@ -0,0 +1,172 @@
+//go:build unit
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/common"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
+)
+
+func newTestGinContext(query url.Values) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/?"+query.Encode(), nil)
+
+	return c
+}
+
+func Test_getTodoSorts(t *testing.T) {
+	asc, desc := common.SortOrderEnumAsc, common.SortOrderEnumDesc
+
+	tests := []struct {
+		name    string
+		query   url.Values
+		want    []*models.SortOrder
+		wantErr bool
+	}{
+		{
+			name:  "no sort",
+			query: url.Values{},
+			want:  []*models.SortOrder{},
+		},
+		{
+			name:  "comma separated and repeated",
+			query: url.Values{"sort": {"createdAt:desc,text", "done:ASC"}},
+			want: []*models.SortOrder{
+				{CreatedAt: &desc},
+				{Text: &asc},
+				{Done: &asc},
+			},
+		},
+		{
+			name:    "invalid field",
+			query:   url.Values{"sort": {"fake"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid direction",
+			query:   url.Values{"sort": {"text:up"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getTodoSorts(newTestGinContext(tt.query))
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_getTodoFilter(t *testing.T) {
+	starStr := func(s string) *string { return &s }
+	starBool := func(b bool) *bool { return &b }
+
+	tests := []struct {
+		name    string
+		filter  string
+		want    *models.Filter
+		wantErr bool
+	}{
+		{
+			name: "no filter",
+		},
+		{
+			name:   "filter",
+			filter: `{"text":{"contains":"fake","caseInsensitive":true},"OR":[{"done":{"eq":true}},{"createdAt":{"gte":"2020-01-01T00:00:00Z"}}]}`,
+			want: &models.Filter{
+				Text: &common.GenericFilter{Contains: starStr("fake"), CaseInsensitive: true},
+				OR: []*models.Filter{
+					{Done: &common.GenericFilter{Eq: starBool(true)}},
+					{CreatedAt: &common.DateFilter{Gte: starStr("2020-01-01T00:00:00Z")}},
+				},
+			},
+		},
+		{
+			name:    "unknown field",
+			filter:  `{"id":{"eq":"1"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			filter:  `{`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := url.Values{}
+			if tt.filter != "" {
+				query.Set("filter", tt.filter)
+			}
+
+			got, err := getTodoFilter(newTestGinContext(query))
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_getPageInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   url.Values
+		want    *pagination.PageInput
+		wantErr bool
+	}{
+		{
+			name:  "default",
+			query: url.Values{},
+			want:  &pagination.PageInput{Limit: 10},
+		},
+		{
+			name:  "first",
+			query: url.Values{"first": {"5"}},
+			want:  &pagination.PageInput{Limit: 5},
+		},
+		{
+			name:    "invalid first",
+			query:   url.Values{"first": {"fake"}},
+			wantErr: true,
+		},
+		{
+			name:    "last without before",
+			query:   url.Values{"last": {"5"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getPageInput(newTestGinContext(tt.query))
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/graphqlgenerated"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/mappers"
+	gutils "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+)
+
+const (
+	todosTag               = "todos"
+	todoIDParamName        = "id"
+	todosPath              = "/todos"
+	todoPath               = todosPath + "/:" + todoIDParamName
+	todoClosePath          = todoPath + "/close"
+	todoIDParamDescription = "Todo Relay ID (same as GraphQL)"
+)
+
+// Todo object.
+type todoResponse struct {
+	ID        string `json:"id"        description:"Relay ID (same as GraphQL)"`
+	CreatedAt string `json:"createdAt" format:"date-time"`
+	UpdatedAt string `json:"updatedAt" format:"date-time"`
+	Text      string `json:"text"`
+	Done      bool   `json:"done"`
+}
+
+// Todo edge object.
+type todoEdgeResponse struct {
+	Cursor string        `json:"cursor"`
+	Node   *todoResponse `json:"node"`
+}
+
+// Todo connection object (Relay compatible).
+type todoConnectionResponse struct {
+	Edges    []*todoEdgeResponse `json:"edges"`
+	PageInfo *gutils.PageInfo    `json:"pageInfo"`
+}
+
+// Create todo request object.
+type createTodoRequest struct {
+	Text string `json:"text" binding:"required"`
+}
+
+// Update todo request object.
+type updateTodoRequest struct {
+	Text string `json:"text" binding:"required"`
+}
+
+type todosHandlers struct {
+	todoSvc todos.Service
+}
+
+func (h *todosHandlers) routes() []*route {
+	idParam := &parameter{
+		name:        todoIDParamName,
+		in:          "path",
+		description: todoIDParamDescription,
+		required:    true,
+		schema:      "",
+	}
+
+	return []*route{
+		{
+			method:      http.MethodGet,
+			path:        todosPath,
+			operationID: "listTodos",
+			summary:     "List todos with Relay pagination",
+			tag:         todosTag,
+			parameters: []*parameter{
+				{name: firstParameterName, in: "query", description: "First elements (used with after)", schema: 0},
+				{name: afterParameterName, in: "query", description: "Cursor after which elements are wanted", schema: ""},
+				{name: lastParameterName, in: "query", description: "Last elements (used with before)", schema: 0},
+				{name: beforeParameterName, in: "query", description: "Cursor before which elements are wanted", schema: ""},
+				{
+					name:        sortParameterName,
+					in:          "query",
+					description: "Sorts as field:direction (fields: createdAt, updatedAt, text, done; directions: ASC (default), DESC), repeat parameter or separate with commas",
+					schema:      "",
+				},
+				{name: filterParameterName, in: "query", description: "Filter as JSON (same as GraphQL TodoFilter)", schema: &todoFilter{}, json: true},
+			},
+			response:       &todoConnectionResponse{},
+			responseStatus: http.StatusOK,
+			handler:        h.list,
+		},
+		{
+			method:         http.MethodPost,
+			path:           todosPath,
+			operationID:    "createTodo",
+			summary:        "Create a todo",
+			tag:            todosTag,
+			requestBody:    &createTodoRequest{},
+			response:       &todoResponse{},
+			responseStatus: http.StatusCreated,
+			handler:        h.create,
+		},
+		{
+			method:         http.MethodGet,
+			path:           todoPath,
+			operationID:    "getTodo",
+			summary:        "Get a todo",
+			tag:            todosTag,
+			parameters:     []*parameter{idParam},
+			response:       &todoResponse{},
+			responseStatus: http.StatusOK,
+			handler:        h.get,
+		},
+		{
+			method:         http.MethodPut,
+			path:           todoPath,
+			operationID:    "updateTodo",
+			summary:        "Update a todo",
+			tag:            todosTag,
+			parameters:     []*parameter{idParam},
+			requestBody:    &updateTodoRequest{},
+			response:       &todoResponse{},
+			responseStatus: http.StatusOK,
+			handler:        h.update,
+		},
+		{
+			method:         http.MethodPost,
+			path:           todoClosePath,
+			operationID:    "closeTodo",
+			summary:        "Close a todo",
+			tag:            todosTag,
+			parameters:     []*parameter{idParam},
+			response:       &todoResponse{},
+			responseStatus: http.StatusOK,
+			handler:        h.close,
+		},
+	}
+}
+
+func (h *todosHandlers) list(c *gin.Context) {
+	// Get pagination
+	pageInput, err := getPageInput(c)
+	// Check error
+	if err != nil {
+		answerWithError(c, err)
+
+		return
+	}
+
+	// Get sorts
+	sorts, err := getTodoSorts(c)
+	// Check error
+	if err != nil {
+		answerWithError(c, err)
+
+		return
+	}
+
+	// Get filter
+	filter, err := getTodoFilter(c)
+	// Check error
+	if err != nil {
+		answerWithError(c, err)
+
+		return
+	}
+
+	// Call business
+	list, pageOut, err := h.todoSvc.GetAllPaginated(c.Request.Context(), pageInput, sorts, filter, fullProjection())
+	// Check error
+	if err != nil {
+		answerWithError(c, err)
+
+		return
+	}
+
+	// Map connection
+	res, err := mapTodoConnection(list, pageOut)
+	// Check error
+	if err != nil {
+		answerWithError(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *todosHandlers) get(c *gin.Context) {
+	// Get id
+	id, err := getTodoID(c)
+	// Check error
+	if err != nil {
+		answerWithError(c, err)
+
+		return
+	}
+
+	// Call business
+	res, err := h.todoSvc.FindByID(c.Request.Context(), id, fullProjection())
+	// Check error
+	if err != nil {
+		answerWithError(c, err)
+
+		return
+	}
+	// Check if todo exists
+	if res == nil {
+		answerWithError(c, errors.NewNotFoundError("todo not found"))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, mapTodo(res))
+}
+
+func (h *todosHandlers) create(c *gin.Context) {
+	// Get body
+	var body createTodoRequest
+
+	err := c.ShouldBindJSON(&body)
+	// Check error
+	if err != nil {
+		answerWithError(c, errors.NewInvalidInputErrorWithError(err, errors.WithPublicErrorMessage(err.Error())))
+
+		return
+	}
+
+	// Call business
+	res, err := h.todoSvc.Create(c.Request.Context(), &todos.InputCreateTodo{Text: body.Text})
+	// Check error
+	if err != nil {
+		answerWithError(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapTodo(res))
+}
+
+func (h *todosHandlers) update(c *gin.Context) {
+	// Get id
+	id, err := getTodoID(c)
+	// Check error
+	if err != nil {
+		answerWithError(c, err)
+
+		return
+	}
+
+	// Get body
+	var body updateTodoRequest
+
+	err = c.ShouldBindJSON(&body)
+	// Check error
+	if err != nil {
+		answerWithError(c, errors.NewInvalidInputErrorWithError(err, errors.WithPublicErrorMessage(err.Error())))
+
+		return
+	}
+
+	// Call business
+	res, err := h.todoSvc.Update(c.Request.Context(), &todos.InputUpdateTodo{ID: id, Text: body.Text})
+	// Check error
+	if err != nil {
+		answerWithError(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, mapTodo(res))
+}
+
+func (h *todosHandlers) close(c *gin.Context) {
+	// Get id
+	id, err := getTodoID(c)
+	// Check error
+	if err != nil {
+		answerWithError(c, err)
+
+		return
+	}
+
+	// Call business
+	res, err := h.todoSvc.Close(c.Request.Context(), id, fullProjection())
+	// Check error
+	if err != nil {
+		answerWithError(c, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, mapTodo(res))
+}
+
+// getTodoID will decode the todo relay id path parameter.
+func getTodoID(c *gin.Context) (string, error) {
+	return gutils.FromIDRelay(c.Param(todoIDParamName), mappers.TodoIDPrefix)
+}
+
+// fullProjection will return projection with all fields as REST answers aren't partial.
+func fullProjection() *models.Projection {
+	return &models.Projection{ID: true, CreatedAt: true, UpdatedAt: true, Text: true, Done: true}
+}
+
+func mapTodo(t *models.Todo) *todoResponse {
+	return &todoResponse{
+		ID:        gutils.ToIDRelay(mappers.TodoIDPrefix, t.ID),
+		CreatedAt: gutils.FormatTime(nil, t.CreatedAt),
+		UpdatedAt: gutils.FormatTime(nil, t.UpdatedAt),
+		Text:      t.Text,
+		Done:      t.Done,
+	}
+}
+
+func mapTodoConnection(list []*models.Todo, pageOut *pagination.PageOutput) (*todoConnectionResponse, error) {
+	// Reuse GraphQL connection mapping to have the same cursors
+	conn, err := graphqlgenerated.MapTodoConnection(list, pageOut)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	res := &todoConnectionResponse{
+		Edges:    make([]*todoEdgeResponse, len(conn.Edges)),
+		PageInfo: conn.PageInfo,
+	}
+
+	for i, it := range conn.Edges {
+		res.Edges[i] = &todoEdgeResponse{Cursor: it.Cursor, Node: mapTodo(it.Node)}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,166 @@
+//go:build unit
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/models"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database/pagination"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/mappers"
+	gutils "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+)
+
+func TestTodosRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	date := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	todo := &models.Todo{Base: database.Base{ID: "uuid", CreatedAt: date, UpdatedAt: date}, Text: "fake"}
+	todoID := gutils.ToIDRelay(mappers.TodoIDPrefix, "uuid")
+	todoJSON := `{"id":"` + todoID + `","createdAt":"2020-01-02T03:04:05Z","updatedAt":"2020-01-02T03:04:05Z","text":"fake","done":false}`
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		mock           func(m *mocks.MockService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "list",
+			method: http.MethodGet,
+			path:   "/api/v1/todos?first=1&sort=text:desc",
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().
+					GetAllPaginated(gomock.Any(), &pagination.PageInput{Limit: 1}, gomock.Len(1), nil, fullProjection()).
+					Return([]*models.Todo{todo}, &pagination.PageOutput{HasNext: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"edges":[{"cursor":"cGFnaW5hdGU6MQ==","node":` + todoJSON + `}],` +
+				`"pageInfo":{"startCursor":"cGFnaW5hdGU6MQ==","endCursor":"cGFnaW5hdGU6MQ==","hasNextPage":true,"hasPreviousPage":false}}`,
+		},
+		{
+			name:           "list with invalid filter",
+			method:         http.MethodGet,
+			path:           "/api/v1/todos?filter=fake",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "list forbidden",
+			method: http.MethodGet,
+			path:   "/api/v1/todos",
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().GetAllPaginated(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.NewForbiddenError("forbidden"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "get",
+			method: http.MethodGet,
+			path:   "/api/v1/todos/" + todoID,
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().FindByID(gomock.Any(), "uuid", fullProjection()).Return(todo, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   todoJSON,
+		},
+		{
+			name:   "get not found",
+			method: http.MethodGet,
+			path:   "/api/v1/todos/" + todoID,
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().FindByID(gomock.Any(), "uuid", fullProjection()).Return(nil, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "get with invalid id",
+			method:         http.MethodGet,
+			path:           "/api/v1/todos/uuid",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "create",
+			method: http.MethodPost,
+			path:   "/api/v1/todos",
+			body:   `{"text":"fake"}`,
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().Create(gomock.Any(), &todos.InputCreateTodo{Text: "fake"}).Return(todo, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   todoJSON,
+		},
+		{
+			name:           "create without text",
+			method:         http.MethodPost,
+			path:           "/api/v1/todos",
+			body:           `{}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "update",
+			method: http.MethodPut,
+			path:   "/api/v1/todos/" + todoID,
+			body:   `{"text":"fake"}`,
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().Update(gomock.Any(), &todos.InputUpdateTodo{ID: "uuid", Text: "fake"}).Return(todo, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   todoJSON,
+		},
+		{
+			name:   "close not found",
+			method: http.MethodPost,
+			path:   "/api/v1/todos/" + todoID + "/close",
+			mock: func(m *mocks.MockService) {
+				m.EXPECT().Close(gomock.Any(), "uuid", fullProjection()).Return(nil, errors.NewNotFoundError("todo not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svcMock := mocks.NewMockService(ctrl)
+			if tt.mock != nil {
+				tt.mock(svcMock)
+			}
+
+			router := gin.New()
+			New(svcMock).AddRoutes(router)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			} else {
+				var res map[string]any
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+				assert.Contains(t, res, "error")
+			}
+		})
+	}
+}
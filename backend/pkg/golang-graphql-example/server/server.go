@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"regexp"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"emperror.dev/errors"
@@ -34,19 +35,24 @@ import (
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/metrics"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/ratelimit"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/cachecontrol"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/cost"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/dataloaders"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/directives"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/generated"
-	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/model"
-	gutils "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/utils"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/rest"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/signalhandler"
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/tracing"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/trusteddocuments"
 )
 
-const GraphqlComplexityLimit = 2000
-
 var StaticFiles = "static/*.html"
 
+// GraphQL types invalidated in response cache when todos are mutated with any API.
+var todoResponseCacheTags = []string{"Todo", "TodoConnection", "TodoEdge"}
+
 type Server struct {
 	logger            log.Logger
 	cfgManager        config.Manager
@@ -56,7 +62,10 @@ type Server struct {
 	authenticationSvc authentication.Service
 	authorizationSvc  authorization.Service
 	signalHandlerSvc  signalhandler.Service
+	rateLimitSvc      ratelimit.Service
+	trustedDocsSvc    trusteddocuments.Service
 	server            *http.Server
+	responseCache     atomic.Pointer[cachecontrol.Cache]
 
 	ugcPolicy    *bluemonday.Policy
 	strictPolicy *bluemonday.Policy
@@ -66,9 +75,10 @@ func NewServer(
 	logger log.Logger, cfgManager config.Manager, metricsSvc metrics.Service,
 	tracingSvc tracing.Service, busiServices *business.Services,
 	authenticationSvc authentication.Service, authoSvc authorization.Service,
-	signalHandlerSvc signalhandler.Service,
+	signalHandlerSvc signalhandler.Service, rateLimitSvc ratelimit.Service,
+	trustedDocsSvc trusteddocuments.Service,
 ) *Server {
-	return &Server{
+	svr := &Server{
 		logger:            logger,
 		cfgManager:        cfgManager,
 		metricsSvc:        metricsSvc,
@@ -77,9 +87,28 @@ func NewServer(
 		authenticationSvc: authenticationSvc,
 		authorizationSvc:  authoSvc,
 		signalHandlerSvc:  signalHandlerSvc,
+		rateLimitSvc:      rateLimitSvc,
+		trustedDocsSvc:    trustedDocsSvc,
 		ugcPolicy:         bluemonday.UGCPolicy(),
 		strictPolicy:      bluemonday.StrictPolicy(),
 	}
+
+	// Invalidate response cache on todo mutations done with REST or gRPC APIs too
+	busiServices.TodoSvc.AddMutationHook(svr.invalidateTodoResponseCache)
+
+	return svr
+}
+
+// invalidateTodoResponseCache will remove cached GraphQL responses containing todos.
+func (svr *Server) invalidateTodoResponseCache(_ context.Context) {
+	// Get current response cache
+	responseCache := svr.responseCache.Load()
+	// Check if response cache is enabled
+	if responseCache == nil {
+		return
+	}
+
+	responseCache.Invalidate(todoResponseCacheTags)
 }
 
 func (svr *Server) GenerateServer() error {
@@ -132,6 +161,13 @@ func (svr *Server) generateRouter() (http.Handler, error) {
 	gin.SetMode(gin.ReleaseMode)
 	// Create router
 	router := gin.New()
+	// Set trusted proxies used to compute client ip from forwarded headers
+	// No proxy is trusted when list is empty
+	err := router.SetTrustedProxies(cfg.Server.TrustedProxies)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 	// Manage no route
 	router.NoRoute(func(c *gin.Context) {
 		// Create not found error
@@ -175,15 +211,35 @@ func (svr *Server) generateRouter() (http.Handler, error) {
 	// Add helmet for security
 	router.Use(helmet.Default())
 	// Add cors if configured
-	err := manageCORS(router, cfg.Server)
+	err = manageCORS(router, cfg.Server)
 	// Check error
 	if err != nil {
 		return nil, err
 	}
+	// Add csrf protection if configured
+	manageCSRF(router, cfg.Server)
+
+	// Add per ip rate limit if configured
+	// This is done before authentication to limit requests rejected by authentication
+	if cfg.Server.RateLimit != nil && cfg.Server.RateLimit.IP != nil {
+		// Create middleware
+		ipRateLimitMiddleware, err := svr.rateLimitSvc.IPMiddleware(cfg.Server.RateLimit)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		router.Use(ipRateLimitMiddleware)
+	}
 
 	// Create api prefix path regexp
 	apiReg := regexp.MustCompile("^/api")
 
+	// Create REST API
+	restAPI := rest.New(svr.busiServices.TodoSvc)
+	// Add OpenAPI document route before authentication
+	restAPI.AddDocumentRoute(router)
+
 	// Add authentication middleware if configuration exists
 	if cfg.OIDCAuthentication != nil {
 		// Add endpoints
@@ -197,11 +253,36 @@ func (svr *Server) generateRouter() (http.Handler, error) {
 		router.Use(svr.authenticationSvc.Middleware([]*regexp.Regexp{apiReg}))
 	}
 
+	// Add per request authorization decision cache
+	router.Use(authorization.DecisionCacheMiddleware())
+	// Add rate limit if configured
+	// This is done after authentication to limit per user
+	if cfg.Server.RateLimit != nil {
+		// Create middleware
+		rateLimitMiddleware, err := svr.rateLimitSvc.HTTPMiddleware(cfg.Server.RateLimit)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		router.Use(rateLimitMiddleware)
+	}
 	// Integrate graphql dataloaders
 	router.Use(dataloaders.Middleware(svr.busiServices))
 	// Add graphql endpoints
-	router.POST("/api/graphql", svr.graphqlHandler())
-	router.GET("/api/graphql", gin.WrapH(gqlplayground.Handler("GraphQL", "/api/graphql")))
+	gqlHandler, err := svr.graphqlHandler()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	router.POST("/api/graphql", gqlHandler)
+	router.GET("/api/graphql", graphqlGETHandler(
+		gqlHandler,
+		gin.WrapH(gqlplayground.Handler("GraphQL", "/api/graphql")),
+	))
+	// Add REST API endpoints
+	restAPI.AddRoutes(router)
 
 	// Add gin html files for answer
 	router.LoadHTMLGlob(StaticFiles)
@@ -220,6 +301,26 @@ func (svr *Server) generateRouter() (http.Handler, error) {
 	return router, nil
 }
 
+// GET requests containing a GraphQL document are sent to GraphQL handler (only queries are allowed),
+// others are answered with the playground.
+func graphqlGETHandler(gqlHandler, playgroundHandler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Check if request contains a document or a persisted query
+		if c.Query("query") != "" || c.Query("extensions") != "" {
+			// Allow the Cache-Control header to be computed from GraphQL operation
+			c.Request = c.Request.WithContext(
+				cachecontrol.SetResponseHeaderToContext(c.Request.Context(), c.Writer.Header()),
+			)
+
+			gqlHandler(c)
+
+			return
+		}
+
+		playgroundHandler(c)
+	}
+}
+
 func (svr *Server) Listen() error {
 	svr.logger.Infof("Server listening on %s", svr.server.Addr)
 	err := svr.server.ListenAndServe()
@@ -233,38 +334,42 @@ func (svr *Server) Listen() error {
 }
 
 // Defining the Graphql handler.
-func (svr *Server) graphqlHandler() gin.HandlerFunc {
+func (svr *Server) graphqlHandler() (gin.HandlerFunc, error) {
+	// Get configuration
+	cfg := svr.cfgManager.GetConfig()
+
 	// NewExecutableSchema and Config are in the generated.go file
 	// Resolver is in the resolver.go file
-	h := handler.New(generated.NewExecutableSchema(generated.Config{
+	// Complexity is computed from @cost directives
+	es, err := cost.NewExecutableSchema(generated.NewExecutableSchema(generated.Config{
 		Resolvers: &graphql.Resolver{
 			BusiServices: svr.busiServices,
 			UGCPolicy:    svr.ugcPolicy,
 			StrictPolicy: svr.strictPolicy,
 		},
-		Complexity: generated.ComplexityRoot{
-			Mutation: struct {
-				CloseTodo  func(childComplexity int, todoID string) int
-				CreateTodo func(childComplexity int, input model.NewTodo) int
-				UpdateTodo func(childComplexity int, input *model.UpdateTodo) int
-			}{
-				CloseTodo: func(childComplexity int, _ string) int {
-					return gutils.CalculateMutationComplexity(childComplexity)
-				},
-				CreateTodo: func(childComplexity int, _ model.NewTodo) int {
-					return gutils.CalculateMutationComplexity(childComplexity)
-				},
-				UpdateTodo: func(childComplexity int, _ *model.UpdateTodo) int {
-					return gutils.CalculateMutationComplexity(childComplexity)
-				},
-			},
+		Directives: generated.DirectiveRoot{
+			Authenticated: directives.Authenticated,
+			Authorize:     directives.NewAuthorize(svr.authorizationSvc),
 		},
 	}))
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	h := handler.New(es)
 
 	h.AddTransport(transport.Websocket{
 		KeepAlivePingInterval: 10 * time.Second, //nolint:mnd
 	})
 	h.AddTransport(transport.Options{})
+	// Incremental delivery transports (@defer) must be declared before POST
+	// as they are selected from the Accept header on JSON POST requests
+	// @stream isn't available as gqlgen cannot send streamed list items
+	h.AddTransport(transport.SSE{
+		KeepAlivePingInterval: 10 * time.Second, //nolint:mnd
+	})
+	h.AddTransport(transport.MultipartMixed{})
 	h.AddTransport(transport.GET{})
 	h.AddTransport(transport.POST{})
 	h.AddTransport(transport.MultipartForm{})
@@ -272,12 +377,65 @@ func (svr *Server) graphqlHandler() gin.HandlerFunc {
 	h.SetQueryCache(lru.New[*ast.QueryDocument](1000)) //nolint:mnd
 
 	h.Use(extension.Introspection{})
-	h.Use(extension.AutomaticPersistedQuery{
-		Cache: lru.New[string](100), //nolint:mnd
-	})
+
+	// Check if only trusted documents are allowed
+	if cfg.Server.TrustedDocuments != nil {
+		// Create extension
+		// Manifest is loaded here to be reloaded with configuration
+		trustedDocsExt, err := svr.trustedDocsSvc.GraphqlMiddleware(context.Background(), cfg.Server.TrustedDocuments)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		h.Use(trustedDocsExt)
+	} else {
+		h.Use(extension.AutomaticPersistedQuery{
+			Cache: lru.New[string](100), //nolint:mnd
+		})
+	}
+
 	h.Use(svr.tracingSvc.GraphqlMiddleware())
 	h.Use(svr.metricsSvc.GraphqlMiddleware())
-	h.Use(extension.FixedComplexityLimit(GraphqlComplexityLimit))
+
+	// Get graphql limits configuration
+	limitsCfg := cfg.Server.GraphQLLimits
+	// Check if it isn't set to use default limits
+	if limitsCfg == nil {
+		// Extension uses default values for unset limits
+		limitsCfg = &config.ServerGraphQLLimitsConfig{}
+	}
+
+	h.Use(cost.NewExtension(limitsCfg, svr.metricsSvc))
+
+	// Add operation rate limit if configured
+	if cfg.Server.RateLimit != nil {
+		// Create extension
+		rateLimitExt, err := svr.rateLimitSvc.GraphqlMiddleware(cfg.Server.RateLimit)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		h.Use(rateLimitExt)
+	}
+
+	// Initialize response cache
+	var responseCache *cachecontrol.Cache
+	// Check if response cache is enabled
+	// Cache is in memory and recreated on configuration reload
+	if cfg.Server.ResponseCache != nil {
+		responseCache, err = cachecontrol.NewCache(cfg.Server.ResponseCache.MaxEntries)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Save response cache to invalidate it on mutations done outside GraphQL
+	svr.responseCache.Store(responseCache)
+
+	h.Use(cachecontrol.NewExtension(responseCache))
 
 	h.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
 		// Get logger
@@ -339,5 +497,5 @@ func (svr *Server) graphqlHandler() gin.HandlerFunc {
 		return err
 	})
 
-	return gin.WrapH(h)
+	return gin.WrapH(h), nil
 }
@@ -0,0 +1,78 @@
+//go:build unit
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos"
+	todomocks "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/business/todos/mocks"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/cachecontrol"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/server/graphql/generated"
+)
+
+func TestServer_invalidateTodoResponseCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	todoSvc := todomocks.NewMockService(ctrl)
+
+	// Keep hook added by server
+	var hook todos.MutationHook
+
+	todoSvc.EXPECT().AddMutationHook(gomock.Any()).Do(func(h todos.MutationHook) { hook = h })
+
+	svr := NewServer(nil, nil, nil, nil, &business.Services{TodoSvc: todoSvc}, nil, nil, nil, nil, nil)
+	require.NotNil(t, hook)
+
+	// Response cache disabled
+	hook(context.TODO())
+
+	cache, err := cachecontrol.NewCache(10)
+	require.NoError(t, err)
+
+	resp := &gqlgraphql.Response{Data: []byte(`{}`)}
+	cache.Set("todos", resp, time.Minute, []string{"Query", "Todo", "TodoConnection", "TodoEdge"})
+	cache.Set("pageInfo", resp, time.Minute, []string{"PageInfo", "Query", "TodoConnection"})
+	cache.Set("other", resp, time.Minute, []string{"Query", "Session"})
+
+	svr.responseCache.Store(cache)
+
+	// Todo mutation done with REST or gRPC API
+	hook(context.TODO())
+
+	assert.Nil(t, cache.Get("todos"))
+	assert.Nil(t, cache.Get("pageInfo"))
+	assert.NotNil(t, cache.Get("other"))
+}
+
+func TestGraphQLStreamDirectiveRejected(t *testing.T) {
+	h := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: &graphql.Resolver{}}))
+	h.AddTransport(transport.POST{})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/graphql",
+		strings.NewReader(`{"query":"{ todos { edges @stream(initialCount: 1) { cursor } } }"}`),
+	)
+	req.Header.Set("Content-Type", "application/json")
+
+	h.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `Unknown directive \"@stream\"`)
+}
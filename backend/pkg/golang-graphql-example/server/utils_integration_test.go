@@ -16,7 +16,7 @@ var integrationTestsCfg *config.Config = &config.Config{
 	Tracing: &config.TracingConfig{Enabled: false},
 	OIDCAuthentication: &config.OIDCAuthConfig{
 		ClientID:          "client-without-secret",
-		State:             "my-secret-state-key",
+		LoginStateSecret:  &config.CredentialConfig{Value: "my-secret-login-state-key-with-32-chars"},
 		IssuerURL:         "http://localhost:8088/auth/realms/integration",
 		RedirectURL:       "http://localhost:8080/",
 		LogoutRedirectURL: "http://localhost:8080/",
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/samber/lo"
+	"google.golang.org/grpc"
 
 	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
 )
@@ -24,6 +25,8 @@ type Service interface {
 	OnExit(hook func())
 	// Middleware to count active requests.
 	ActiveRequestCounterMiddleware(ignoredPathList []string) gin.HandlerFunc
+	// gRPC interceptor to count active requests.
+	ActiveRequestCounterGRPCInterceptor(ignoredMethodList []string) grpc.UnaryServerInterceptor
 	// Is stopping system will return true if the application is stopping.
 	IsStoppingSystem() bool
 	// IncreaseActiveRequestCounter will increase active request counter by one.
@@ -16,6 +16,7 @@ import (
 
 	gin "github.com/gin-gonic/gin"
 	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
 )
 
 // MockService is a mock of Service interface.
@@ -42,6 +43,20 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// ActiveRequestCounterGRPCInterceptor mocks base method.
+func (m *MockService) ActiveRequestCounterGRPCInterceptor(ignoredMethodList []string) grpc.UnaryServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveRequestCounterGRPCInterceptor", ignoredMethodList)
+	ret0, _ := ret[0].(grpc.UnaryServerInterceptor)
+	return ret0
+}
+
+// ActiveRequestCounterGRPCInterceptor indicates an expected call of ActiveRequestCounterGRPCInterceptor.
+func (mr *MockServiceMockRecorder) ActiveRequestCounterGRPCInterceptor(ignoredMethodList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveRequestCounterGRPCInterceptor", reflect.TypeOf((*MockService)(nil).ActiveRequestCounterGRPCInterceptor), ignoredMethodList)
+}
+
 // ActiveRequestCounterMiddleware mocks base method.
 func (m *MockService) ActiveRequestCounterMiddleware(ignoredPathList []string) gin.HandlerFunc {
 	m.ctrl.T.Helper()
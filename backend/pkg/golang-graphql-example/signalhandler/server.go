@@ -1,8 +1,11 @@
 package signalhandler
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
 	"github.com/samber/lo"
+	"google.golang.org/grpc"
 )
 
 func (s *service) initializeServerMode() {
@@ -41,3 +44,21 @@ func (s *service) ActiveRequestCounterMiddleware(ignoredPathList []string) gin.H
 		c.Next()
 	}
 }
+
+func (s *service) ActiveRequestCounterGRPCInterceptor(ignoredMethodList []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		// Check if server mode isn't enabled or if method is ignored
+		if !s.serverMode || lo.Contains(ignoredMethodList, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		// Send +1 to active request counter channel
+		s.activeRequestCounterChan <- 1
+
+		// Send -1 to active request counter channel when request is finished
+		defer func() { s.activeRequestCounterChan <- -1 }()
+
+		// Next
+		return handler(ctx, req)
+	}
+}
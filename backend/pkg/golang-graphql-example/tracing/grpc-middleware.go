@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+)
+
+// gRPC metadata keys are lower case headers.
+var traceIDMetadataKey = strings.ToLower(TraceIDHeaderName)
+
+func (*service) GRPCServerHandler() stats.Handler {
+	return otelgrpc.NewServerHandler()
+}
+
+func (*service) GRPCUnaryInterceptor(getRequestID func(ctx context.Context) string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		// Get trace
+		t := GetTraceFromContext(ctx)
+		// Add attributes
+		t.SetTag("rpc.request_id", getRequestID(ctx))
+
+		// Get trace id
+		traceID := t.GetTraceID()
+		// Check if it exists
+		if traceID != "" {
+			// Add trace id into response headers
+			_ = grpc.SetHeader(ctx, metadata.Pairs(traceIDMetadataKey, traceID))
+		}
+
+		return handler(ctx, req)
+	}
+}
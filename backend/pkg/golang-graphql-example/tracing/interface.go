@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
 	"gorm.io/gorm"
 
 	gqlgraphql "github.com/99designs/gqlgen/graphql"
@@ -33,6 +35,10 @@ type Service interface {
 	HTTPMiddlewareList(getRequestID func(ctx context.Context) string) []gin.HandlerFunc
 	// Graphql Middleware.
 	GraphqlMiddleware() gqlgraphql.HandlerExtension
+	// GRPCServerHandler will return the gRPC stats handler creating a trace per call.
+	GRPCServerHandler() stats.Handler
+	// GRPCUnaryInterceptor will add attributes on call trace and return trace id in response headers.
+	GRPCUnaryInterceptor(getRequestID func(ctx context.Context) string) grpc.UnaryServerInterceptor
 	// Get database middleware.
 	DatabaseMiddleware() gorm.Plugin
 	// StartSpan will return a new trace created.
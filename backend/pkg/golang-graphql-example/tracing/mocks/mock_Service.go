@@ -18,6 +18,8 @@ import (
 	tracing "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/tracing"
 	trace "go.opentelemetry.io/otel/trace"
 	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+	stats "google.golang.org/grpc/stats"
 	gorm "gorm.io/gorm"
 )
 
@@ -73,6 +75,34 @@ func (mr *MockServiceMockRecorder) DatabaseMiddleware() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseMiddleware", reflect.TypeOf((*MockService)(nil).DatabaseMiddleware))
 }
 
+// GRPCServerHandler mocks base method.
+func (m *MockService) GRPCServerHandler() stats.Handler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCServerHandler")
+	ret0, _ := ret[0].(stats.Handler)
+	return ret0
+}
+
+// GRPCServerHandler indicates an expected call of GRPCServerHandler.
+func (mr *MockServiceMockRecorder) GRPCServerHandler() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCServerHandler", reflect.TypeOf((*MockService)(nil).GRPCServerHandler))
+}
+
+// GRPCUnaryInterceptor mocks base method.
+func (m *MockService) GRPCUnaryInterceptor(getRequestID func(context.Context) string) grpc.UnaryServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCUnaryInterceptor", getRequestID)
+	ret0, _ := ret[0].(grpc.UnaryServerInterceptor)
+	return ret0
+}
+
+// GRPCUnaryInterceptor indicates an expected call of GRPCUnaryInterceptor.
+func (mr *MockServiceMockRecorder) GRPCUnaryInterceptor(getRequestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCUnaryInterceptor", reflect.TypeOf((*MockService)(nil).GRPCUnaryInterceptor), getRequestID)
+}
+
 // GraphqlMiddleware mocks base method.
 func (m *MockService) GraphqlMiddleware() graphql.HandlerExtension {
 	m.ctrl.T.Helper()
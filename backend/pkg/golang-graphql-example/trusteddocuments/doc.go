@@ -0,0 +1,3 @@
+package trusteddocuments
+
+// This package will manage trusted documents (persisted query allowlist) loaded from a manifest file or from database.
@@ -0,0 +1,14 @@
+package trusteddocuments
+
+import "time"
+
+// document is a trusted document saved in database.
+type document struct {
+	CreatedAt time.Time
+	Hash      string `gorm:"type:varchar(64);primaryKey"`
+	Query     string `gorm:"type:text"`
+}
+
+func (*document) TableName() string {
+	return "trusted_documents"
+}
@@ -0,0 +1,130 @@
+package trusteddocuments
+
+import (
+	"context"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/log"
+)
+
+// Size of the cache of computed hashes for queries sent in full.
+const hashCacheSize = 1000
+
+type graphqlExtension struct {
+	manifest Manifest
+	// hashes is a cache of computed hashes indexed by sent queries
+	hashes gqlgraphql.Cache[string]
+}
+
+var _ interface {
+	gqlgraphql.HandlerExtension
+	gqlgraphql.OperationParameterMutator
+} = &graphqlExtension{}
+
+func (s *service) GraphqlMiddleware(
+	ctx context.Context,
+	cfg *config.ServerTrustedDocumentsConfig,
+) (gqlgraphql.HandlerExtension, error) {
+	// Load manifest
+	m, err := s.LoadManifest(ctx, cfg)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return newGraphqlExtension(m), nil
+}
+
+func newGraphqlExtension(m Manifest) *graphqlExtension {
+	return &graphqlExtension{
+		manifest: m,
+		hashes:   lru.New[string](hashCacheSize),
+	}
+}
+
+func (*graphqlExtension) ExtensionName() string {
+	return "TrustedDocuments"
+}
+
+func (*graphqlExtension) Validate(_ gqlgraphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e *graphqlExtension) MutateOperationParameters(
+	ctx context.Context,
+	rawParams *gqlgraphql.RawParams,
+) *gqlerror.Error {
+	// Check if only document hash have been sent
+	if rawParams.Query == "" {
+		// Get hash from persisted query extension
+		var ext struct {
+			Sha256 string `mapstructure:"sha256Hash"`
+		}
+
+		err := mapstructure.Decode(rawParams.Extensions["persistedQuery"], &ext)
+		// Check error
+		if err != nil || ext.Sha256 == "" {
+			return e.reject(ctx, cerrors.NewInvalidInputError("query or persisted query hash must be set"))
+		}
+
+		// Get document
+		q, ok := e.manifest[ext.Sha256]
+		// Check if it exists
+		if !ok {
+			return e.reject(ctx, cerrors.NewNotFoundError(
+				"persisted query not found",
+				cerrors.WithCode(PersistedQueryNotFoundErrorCode),
+				cerrors.WithPublicErrorMessage("PersistedQueryNotFound"),
+			))
+		}
+
+		// Save
+		rawParams.Query = q
+
+		return nil
+	}
+
+	// Full query sent, check that it is a trusted one
+	h, ok := e.hashes.Get(ctx, rawParams.Query)
+	// Check if hash must be computed
+	if !ok {
+		var err error
+		// Compute hash
+		h, err = ComputeHash(rawParams.Query)
+		// Check error
+		if err != nil {
+			// Let executor report the parsing error
+			return nil
+		}
+
+		e.hashes.Add(ctx, rawParams.Query, h)
+	}
+
+	// Check if document is trusted
+	if _, ok = e.manifest[h]; !ok {
+		return e.reject(ctx, cerrors.NewForbiddenError(
+			"query isn't a trusted document",
+			cerrors.WithCode(TrustedDocumentRequiredErrorCode),
+			cerrors.WithPublicErrorMessage("only trusted documents are allowed"),
+		))
+	}
+
+	return nil
+}
+
+func (*graphqlExtension) reject(ctx context.Context, err cerrors.Error) *gqlerror.Error {
+	// Get logger
+	logger := log.GetLoggerFromContext(ctx)
+	if logger != nil {
+		logger.Warn(err)
+	}
+
+	return gqlerror.Wrap(err)
+}
@@ -0,0 +1,89 @@
+//go:build unit
+
+package trusteddocuments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	cerrors "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/common/errors"
+)
+
+func Test_graphqlExtension_MutateOperationParameters(t *testing.T) {
+	trustedQuery := "query todos { todos { id } }"
+
+	m, err := NewManifest([]string{trustedQuery})
+	require.NoError(t, err)
+
+	trustedHash, err := ComputeHash(trustedQuery)
+	require.NoError(t, err)
+
+	ext := newGraphqlExtension(m)
+
+	tests := []struct {
+		name          string
+		params        *gqlgraphql.RawParams
+		expectedQuery string
+		expectedCode  string
+	}{
+		{
+			name: "trusted hash only",
+			params: &gqlgraphql.RawParams{
+				Extensions: map[string]any{"persistedQuery": map[string]any{"version": 1, "sha256Hash": trustedHash}},
+			},
+			expectedQuery: m[trustedHash],
+		},
+		{
+			name: "unknown hash",
+			params: &gqlgraphql.RawParams{
+				Extensions: map[string]any{"persistedQuery": map[string]any{"version": 1, "sha256Hash": "unknown"}},
+			},
+			expectedCode: PersistedQueryNotFoundErrorCode,
+		},
+		{
+			name:         "no query and no hash",
+			params:       &gqlgraphql.RawParams{},
+			expectedCode: cerrors.InvalidInputErrorCode,
+		},
+		{
+			name:          "trusted query sent by apollo client",
+			params:        &gqlgraphql.RawParams{Query: "query todos {\n  todos {\n    id\n    __typename\n  }\n}"},
+			expectedQuery: "query todos {\n  todos {\n    id\n    __typename\n  }\n}",
+		},
+		{
+			name:         "arbitrary query",
+			params:       &gqlgraphql.RawParams{Query: "query todos { todos { id text } }"},
+			expectedCode: TrustedDocumentRequiredErrorCode,
+		},
+		{
+			name: "arbitrary query with trusted hash",
+			params: &gqlgraphql.RawParams{
+				Query:      "query todos { todos { id text } }",
+				Extensions: map[string]any{"persistedQuery": map[string]any{"version": 1, "sha256Hash": trustedHash}},
+			},
+			expectedCode: TrustedDocumentRequiredErrorCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gErr := ext.MutateOperationParameters(context.TODO(), tt.params)
+			if tt.expectedCode != "" {
+				require.NotNil(t, gErr)
+
+				var err2 *cerrors.GenericError
+				require.ErrorAs(t, gErr, &err2)
+				assert.Equal(t, tt.expectedCode, err2.Extensions()["code"])
+
+				return
+			}
+
+			require.Nil(t, gErr)
+			assert.Equal(t, tt.expectedQuery, tt.params.Query)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package trusteddocuments
+
+import (
+	"context"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+)
+
+const (
+	// TrustedDocumentRequiredErrorCode is the error code returned when a query isn't a trusted document.
+	TrustedDocumentRequiredErrorCode = "TRUSTED_DOCUMENT_REQUIRED"
+	// PersistedQueryNotFoundErrorCode is the error code returned when a document hash isn't in manifest.
+	// This is the same code as the one used by automatic persisted queries to stay compatible with clients.
+	PersistedQueryNotFoundErrorCode = "PERSISTED_QUERY_NOT_FOUND"
+)
+
+//go:generate mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/trusteddocuments Service
+type Service interface {
+	// LoadManifest will load manifest from configured source.
+	LoadManifest(ctx context.Context, cfg *config.ServerTrustedDocumentsConfig) (Manifest, error)
+	// ImportManifestFile will read manifest file and save its documents in database.
+	ImportManifestFile(ctx context.Context, path string) error
+	// GraphqlMiddleware will return a graphql extension rejecting queries that aren't in manifest.
+	// Manifest is loaded when extension is created, so a configuration reload will reload it.
+	GraphqlMiddleware(ctx context.Context, cfg *config.ServerTrustedDocumentsConfig) (gqlgraphql.HandlerExtension, error)
+}
+
+// Manifest is the allowed documents map indexed by their hash.
+// Hash is the sha256 hex digest of the normalized document.
+type Manifest map[string]string
+
+func NewService(db database.DB) Service {
+	return &service{db: db}
+}
@@ -0,0 +1,151 @@
+package trusteddocuments
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"sort"
+
+	"emperror.dev/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+const typenameField = "__typename"
+
+// Normalize will return a normalized version of the GraphQL document.
+// Normalization removes formatting differences and __typename fields added by clients like Apollo Client.
+// Definitions are sorted to keep the same result when fragments are concatenated in another order.
+func Normalize(query string) (string, error) {
+	// Parse document
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	// Check error
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	// Remove __typename fields
+	for _, op := range doc.Operations {
+		op.SelectionSet = removeTypenameFields(op.SelectionSet)
+	}
+
+	for _, f := range doc.Fragments {
+		f.SelectionSet = removeTypenameFields(f.SelectionSet)
+	}
+
+	// Sort definitions
+	sort.SliceStable(doc.Operations, func(i, j int) bool { return doc.Operations[i].Name < doc.Operations[j].Name })
+	sort.SliceStable(doc.Fragments, func(i, j int) bool { return doc.Fragments[i].Name < doc.Fragments[j].Name })
+
+	var buf bytes.Buffer
+	// Format
+	formatter.NewFormatter(&buf).FormatQueryDocument(doc)
+
+	return buf.String(), nil
+}
+
+// ComputeHash will return the hash of the normalized GraphQL document.
+func ComputeHash(query string) (string, error) {
+	// Normalize
+	n, err := Normalize(query)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	return hashDocument(n), nil
+}
+
+// NewManifest will build a manifest from GraphQL documents.
+func NewManifest(queries []string) (Manifest, error) {
+	m := make(Manifest, len(queries))
+
+	for _, q := range queries {
+		// Normalize
+		n, err := Normalize(q)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		m[hashDocument(n)] = n
+	}
+
+	return m, nil
+}
+
+// ReadManifestFile will read a JSON manifest file and check that hashes are matching documents.
+func ReadManifestFile(path string) (Manifest, error) {
+	// Read file
+	b, err := os.ReadFile(path)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	m := Manifest{}
+	// Parse
+	err = json.Unmarshal(b, &m)
+	// Check error
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot parse trusted documents manifest %s", path)
+	}
+
+	// Validate
+	err = m.validate()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m Manifest) validate() error {
+	for h, q := range m {
+		// Compute hash
+		h2, err := ComputeHash(q)
+		// Check error
+		if err != nil {
+			return errors.WithMessagef(err, "invalid trusted document %s", h)
+		}
+
+		// Check hash
+		if h != h2 {
+			return errors.Errorf("trusted document hash %s doesn't match document hash %s", h, h2)
+		}
+	}
+
+	return nil
+}
+
+func hashDocument(n string) string {
+	b := sha256.Sum256([]byte(n))
+
+	return hex.EncodeToString(b[:])
+}
+
+func removeTypenameFields(set ast.SelectionSet) ast.SelectionSet {
+	res := make(ast.SelectionSet, 0, len(set))
+
+	for _, it := range set {
+		switch s := it.(type) {
+		case *ast.Field:
+			// Ignore __typename fields
+			if s.Name == typenameField && s.Alias == typenameField {
+				continue
+			}
+
+			s.SelectionSet = removeTypenameFields(s.SelectionSet)
+		case *ast.InlineFragment:
+			s.SelectionSet = removeTypenameFields(s.SelectionSet)
+		}
+
+		res = append(res, it)
+	}
+
+	return res
+}
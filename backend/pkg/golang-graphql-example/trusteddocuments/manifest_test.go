@@ -0,0 +1,91 @@
+//go:build unit
+
+package trusteddocuments
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeHash(t *testing.T) {
+	ref, err := ComputeHash("query A { todos { ...F } } fragment F on Todo { id }")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		query   string
+		same    bool
+		wantErr bool
+	}{
+		{
+			name:  "formatting differences",
+			query: "query A {\n  todos {\n    ...F\n  }\n}\n\nfragment F on Todo {\n  id\n}\n",
+			same:  true,
+		},
+		{
+			name:  "typename fields added",
+			query: "query A { todos { __typename ...F } } fragment F on Todo { __typename id }",
+			same:  true,
+		},
+		{
+			name:  "fragments order",
+			query: "fragment F on Todo { id } query A { todos { ...F } }",
+			same:  true,
+		},
+		{
+			name:  "aliased typename is kept",
+			query: "query A { todos { t: __typename ...F } } fragment F on Todo { id }",
+		},
+		{
+			name:  "other field",
+			query: "query A { todos { ...F } } fragment F on Todo { id text }",
+		},
+		{
+			name:    "invalid document",
+			query:   "query A {",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ComputeHash(tt.query)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.same, got == ref)
+		})
+	}
+}
+
+func TestReadManifestFile(t *testing.T) {
+	m, err := NewManifest([]string{"query A { todos { id } }"})
+	require.NoError(t, err)
+	require.Len(t, m, 1)
+
+	for h := range m {
+		dir := t.TempDir()
+
+		// Valid manifest
+		p := filepath.Join(dir, "valid.json")
+		require.NoError(t, os.WriteFile(p, []byte(`{"`+h+`": "query A {\n todos { id }\n}"}`), 0o600))
+
+		got, err := ReadManifestFile(p)
+		require.NoError(t, err)
+		assert.Contains(t, got, h)
+
+		// Hash not matching document
+		p = filepath.Join(dir, "invalid.json")
+		require.NoError(t, os.WriteFile(p, []byte(`{"`+h+`": "query B { todos { id } }"}`), 0o600))
+
+		_, err = ReadManifestFile(p)
+		assert.ErrorContains(t, err, "doesn't match document hash")
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/trusteddocuments (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/trusteddocuments Service
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	graphql "github.com/99designs/gqlgen/graphql"
+	config "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	trusteddocuments "github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/trusteddocuments"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// GraphqlMiddleware mocks base method.
+func (m *MockService) GraphqlMiddleware(ctx context.Context, cfg *config.ServerTrustedDocumentsConfig) (graphql.HandlerExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GraphqlMiddleware", ctx, cfg)
+	ret0, _ := ret[0].(graphql.HandlerExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GraphqlMiddleware indicates an expected call of GraphqlMiddleware.
+func (mr *MockServiceMockRecorder) GraphqlMiddleware(ctx, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GraphqlMiddleware", reflect.TypeOf((*MockService)(nil).GraphqlMiddleware), ctx, cfg)
+}
+
+// ImportManifestFile mocks base method.
+func (m *MockService) ImportManifestFile(ctx context.Context, path string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportManifestFile", ctx, path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportManifestFile indicates an expected call of ImportManifestFile.
+func (mr *MockServiceMockRecorder) ImportManifestFile(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportManifestFile", reflect.TypeOf((*MockService)(nil).ImportManifestFile), ctx, path)
+}
+
+// LoadManifest mocks base method.
+func (m *MockService) LoadManifest(ctx context.Context, cfg *config.ServerTrustedDocumentsConfig) (trusteddocuments.Manifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadManifest", ctx, cfg)
+	ret0, _ := ret[0].(trusteddocuments.Manifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadManifest indicates an expected call of LoadManifest.
+func (mr *MockServiceMockRecorder) LoadManifest(ctx, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadManifest", reflect.TypeOf((*MockService)(nil).LoadManifest), ctx, cfg)
+}
@@ -0,0 +1,68 @@
+package trusteddocuments
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"gorm.io/gorm/clause"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/config"
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/database"
+)
+
+type service struct {
+	db database.DB
+}
+
+func (s *service) LoadManifest(ctx context.Context, cfg *config.ServerTrustedDocumentsConfig) (Manifest, error) {
+	// Check if manifest must be read from file
+	if cfg.Source != config.ServerTrustedDocumentsSourceDatabase {
+		return ReadManifestFile(cfg.ManifestPath)
+	}
+
+	var list []*document
+	// Find all documents
+	err := s.db.GetGormDB().WithContext(ctx).Find(&list).Error
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	m := make(Manifest, len(list))
+	for _, it := range list {
+		m[it.Hash] = it.Query
+	}
+
+	// Validate
+	err = m.validate()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (s *service) ImportManifestFile(ctx context.Context, path string) error {
+	// Read manifest
+	m, err := ReadManifestFile(path)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check if there is something to import
+	if len(m) == 0 {
+		return nil
+	}
+
+	list := make([]*document, 0, len(m))
+	for h, q := range m {
+		list = append(list, &document{Hash: h, Query: q})
+	}
+
+	// Save documents, existing ones are kept as hash is a digest of the query
+	err = s.db.GetGormDB().WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&list).Error
+
+	return errors.WithStack(err)
+}
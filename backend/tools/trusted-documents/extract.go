@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+var (
+	graphqlExtensions = []string{".graphql", ".gql"}
+	scriptExtensions  = []string{".js", ".mjs", ".cjs", ".jsx", ".ts", ".tsx"}
+	// Template literals and quoted strings, minified builds can transform gql template literals into strings
+	stringLiteralRegexp = regexp.MustCompile("`(?:[^`\\\\]|\\\\.)*`|\"(?:[^\"\\\\\\n]|\\\\.)*\"|'(?:[^'\\\\\\n]|\\\\.)*'")
+	// Template literal placeholders used to concatenate fragments
+	placeholderRegexp = regexp.MustCompile(`\$\{[^}]*\}`)
+	documentRegexp    = regexp.MustCompile(`^\s*(query|mutation|subscription|fragment)\b`)
+)
+
+// extract will return one document per operation found in sources with all fragments it uses.
+func extract(sources []string, exclude *regexp.Regexp) ([]string, error) {
+	operations := ast.OperationList{}
+	fragments := map[string]*ast.FragmentDefinition{}
+
+	for _, src := range sources {
+		err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+			// Check error
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			// Ignore directories and excluded files
+			if d.IsDir() || exclude.MatchString(path) {
+				return nil
+			}
+
+			// Get documents in file
+			docs, err := readDocuments(path)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			for _, doc := range docs {
+				operations = append(operations, doc.Operations...)
+
+				for _, f := range doc.Fragments {
+					// Check duplicates
+					if f2, ok := fragments[f.Name]; ok && formatFragment(f2) != formatFragment(f) {
+						return errors.Errorf("fragment %s is defined multiple times with different content", f.Name)
+					}
+
+					fragments[f.Name] = f
+				}
+			}
+
+			return nil
+		})
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := make([]string, 0, len(operations))
+
+	for _, op := range operations {
+		// Build document with operation and needed fragments
+		doc := &ast.QueryDocument{Operations: ast.OperationList{op}}
+
+		err := addFragments(doc, op.SelectionSet, fragments)
+		// Check error
+		if err != nil {
+			return nil, errors.WithMessagef(err, "operation %s", op.Name)
+		}
+
+		var buf bytes.Buffer
+		// Format
+		formatter.NewFormatter(&buf).FormatQueryDocument(doc)
+
+		res = append(res, buf.String())
+	}
+
+	return res, nil
+}
+
+func readDocuments(path string) ([]*ast.QueryDocument, error) {
+	ext := filepath.Ext(path)
+	// Check if file must be read
+	if !slices.Contains(graphqlExtensions, ext) && !slices.Contains(scriptExtensions, ext) {
+		return nil, nil
+	}
+
+	// Read file
+	b, err := os.ReadFile(path)
+	// Check error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Check if it is a graphql file
+	if slices.Contains(graphqlExtensions, ext) {
+		doc, err := parser.ParseQuery(&ast.Source{Name: path, Input: string(b)})
+		// Check error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		return []*ast.QueryDocument{doc}, nil
+	}
+
+	res := []*ast.QueryDocument{}
+
+	for _, lit := range stringLiteralRegexp.FindAllString(string(b), -1) {
+		// Get string value
+		s := unquote(lit)
+		// Check if it looks like a graphql document
+		if !documentRegexp.MatchString(s) {
+			continue
+		}
+
+		// Parse, strings that aren't graphql documents are ignored
+		doc, err := parser.ParseQuery(&ast.Source{Name: path, Input: placeholderRegexp.ReplaceAllString(s, "")})
+		if err != nil {
+			continue
+		}
+
+		res = append(res, doc)
+	}
+
+	return res, nil
+}
+
+func unquote(lit string) string {
+	// Template literals only need escaped characters to be managed
+	if strings.HasPrefix(lit, "`") {
+		return strings.NewReplacer("\\`", "`", "\\\\", "\\", "\\$", "$", "\\n", "\n").Replace(lit[1 : len(lit)-1])
+	}
+
+	// Single quoted strings are transformed in double quoted ones
+	if strings.HasPrefix(lit, "'") {
+		lit = `"` + strings.ReplaceAll(strings.ReplaceAll(lit[1:len(lit)-1], `\'`, `'`), `"`, `\"`) + `"`
+	}
+
+	s, err := strconv.Unquote(lit)
+	// Check error
+	if err != nil {
+		return ""
+	}
+
+	return s
+}
+
+func addFragments(
+	doc *ast.QueryDocument,
+	set ast.SelectionSet,
+	fragments map[string]*ast.FragmentDefinition,
+) error {
+	for _, it := range set {
+		switch s := it.(type) {
+		case *ast.Field:
+			err := addFragments(doc, s.SelectionSet, fragments)
+			// Check error
+			if err != nil {
+				return err
+			}
+		case *ast.InlineFragment:
+			err := addFragments(doc, s.SelectionSet, fragments)
+			// Check error
+			if err != nil {
+				return err
+			}
+		case *ast.FragmentSpread:
+			// Check if already added
+			if doc.Fragments.ForName(s.Name) != nil {
+				continue
+			}
+
+			// Get fragment
+			f, ok := fragments[s.Name]
+			// Check if it exists
+			if !ok {
+				return errors.Errorf("fragment %s not found", s.Name)
+			}
+
+			doc.Fragments = append(doc.Fragments, f)
+
+			err := addFragments(doc, f.SelectionSet, fragments)
+			// Check error
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func formatFragment(f *ast.FragmentDefinition) string {
+	var buf bytes.Buffer
+	// Format
+	formatter.NewFormatter(&buf).FormatQueryDocument(&ast.QueryDocument{Fragments: ast.FragmentDefinitionList{f}})
+
+	return buf.String()
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/oxyno-zeta/golang-graphql-example/pkg/golang-graphql-example/trusteddocuments"
+)
+
+const outputFilePermissions = 0o644
+
+func main() {
+	var sources []string
+
+	var output, exclude string
+
+	rootCmd := &cobra.Command{
+		Use:   "trusted-documents",
+		Short: "used to extract graphql operations from frontend build into a trusted documents manifest",
+		Run: func(_ *cobra.Command, _ []string) {
+			// Run
+			err := run(sources, output, exclude)
+			// Check error
+			if err != nil {
+				hardExit(err)
+			}
+		},
+	}
+
+	rootCmd.Flags().StringSliceVar(&sources, "source", []string{"../frontend/dist"}, "Folders to scan for graphql documents")
+	rootCmd.Flags().StringVar(&output, "output", "trusted-documents.json", "Manifest output file path")
+	rootCmd.Flags().StringVar(
+		&exclude,
+		"exclude",
+		`(node_modules|__mocks__|\.test\.|-test\.|\.stories\.)`,
+		"Regexp of file paths to ignore",
+	)
+
+	if err := rootCmd.Execute(); err != nil {
+		hardExit(err)
+	}
+}
+
+func hardExit(err error) {
+	fmt.Println(err)
+	os.Exit(1)
+}
+
+func run(sources []string, output, exclude string) error {
+	// Compile exclude regexp
+	excludeRegexp, err := regexp.Compile(exclude)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Extract operations
+	queries, err := extract(sources, excludeRegexp)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Build manifest
+	m, err := trusteddocuments.NewManifest(queries)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Marshal
+	b, err := json.MarshalIndent(m, "", "  ")
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Write manifest
+	err = os.WriteFile(output, b, outputFilePermissions)
+	// Check error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Printf("%d trusted documents written in %s\n", len(m), output)
+
+	return nil
+}
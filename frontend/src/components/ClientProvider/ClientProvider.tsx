@@ -11,15 +11,25 @@ interface Props {
   readonly children: ReactNode;
 }
 
+// Get CSRF token from double submit cookie
+// When cookie doesn't exist, a static value is enough for header based CSRF protection
+function getCSRFToken() {
+  const cookie = document.cookie.split('; ').find((it) => it.startsWith('csrf_token='));
+
+  return cookie ? cookie.substring('csrf_token='.length) : '1';
+}
+
 function generateClient(cfg: ConfigModel) {
   // Create apollo link to force header injection
   // That force is done to ensure a 401 Unauthorized error when backend is protected by Oauth2-Proxy
   // Documentation: https://oauth2-proxy.github.io/oauth2-proxy/docs/behaviour/
+  // CSRF header is also added for cookie based authentication
   const forceHeaders = new ApolloLink((operation, forward) => {
     operation.setContext(({ headers = {} }) => ({
       headers: {
         ...headers,
         Accept: 'application/json',
+        'X-CSRF-Token': getCSRFToken(),
       },
     }));
 